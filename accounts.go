@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package awsbase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/aws-sdk-go-base/v2/diag"
+	"github.com/hashicorp/aws-sdk-go-base/v2/logging"
+	"github.com/hashicorp/aws-sdk-go-base/v2/validation"
+)
+
+// AccountIDPlaceholder is replaced with each account ID in the role ARN of the role template passed to GetAwsConfigForAccounts.
+const AccountIDPlaceholder = "{account_id}"
+
+// DefaultAccountParallelism is the number of accounts configured concurrently by GetAwsConfigForAccounts if no limit is given.
+const DefaultAccountParallelism = 10
+
+// AccountAwsConfig is the aws.Config for one account.
+type AccountAwsConfig struct {
+	AccountID string
+	AwsConfig aws.Config
+
+	// Diags contains the diagnostics specific to the account.
+	// If it contains errors, AwsConfig should not be used.
+	Diags diag.Diagnostics
+}
+
+// GetAwsConfigForAccounts resolves the source credentials once and then assumes the role in the role template
+// in each of the accounts, configuring up to parallelism accounts concurrently.
+// The role template's RoleARN must contain AccountIDPlaceholder. The role is assumed using the credentials
+// resolved from c, including any roles assumed in c.AssumeRole.
+//
+// The returned slice contains an entry for each account, in the order given.
+// Failing to assume the role in one account does not affect the other accounts.
+// The returned diagnostics apply to all of the accounts. If they contain errors, no aws.Configs are returned.
+func GetAwsConfigForAccounts(ctx context.Context, c *Config, roleTemplate AssumeRole, accountIDs []string, parallelism int) (context.Context, []AccountAwsConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !strings.Contains(roleTemplate.RoleARN, AccountIDPlaceholder) {
+		return ctx, nil, diags.AddError(
+			"Invalid Role Template",
+			fmt.Sprintf("The role ARN %q does not contain the account ID placeholder %q.", roleTemplate.RoleARN, AccountIDPlaceholder),
+		)
+	}
+
+	// The roles differ only in their account IDs, so the chain is validated once, using the first valid account ID.
+	// The role is validated as the last hop of the chain, so that limits on chained roles are applied.
+	if i := slices.IndexFunc(accountIDs, func(accountID string) bool {
+		return validation.AccountID(accountID) == nil
+	}); i >= 0 {
+		cc := *c
+		cc.AssumeRole = append(slices.Clone(c.AssumeRole), accountRole(roleTemplate, accountIDs[i]))
+		cc.ValidateAssumeRoles(&diags)
+		if diags.HasError() {
+			return ctx, nil, diags
+		}
+	}
+
+	ctx, awsConfig, d := GetAwsConfig(ctx, c)
+	diags = diags.Append(d...)
+	if diags.HasError() {
+		return ctx, nil, diags
+	}
+
+	if parallelism <= 0 {
+		parallelism = DefaultAccountParallelism
+	}
+
+	results := make([]AccountAwsConfig, len(accountIDs))
+	sem := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	for i, accountID := range accountIDs {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			results[i] = accountAwsConfig(ctx, c, awsConfig, roleTemplate, accountID)
+		}()
+	}
+	wg.Wait()
+
+	return ctx, results, diags
+}
+
+func accountAwsConfig(ctx context.Context, c *Config, awsConfig aws.Config, roleTemplate AssumeRole, accountID string) AccountAwsConfig {
+	result := AccountAwsConfig{
+		AccountID: accountID,
+	}
+
+	if err := validation.AccountID(accountID); err != nil {
+		result.Diags = result.Diags.AddError("Invalid Account ID", err.Error())
+		return result
+	}
+
+	ar := accountRole(roleTemplate, accountID)
+
+	logger := logging.RetrieveLogger(ctx)
+	logger.Debug(ctx, "Configuring account", map[string]any{
+		"tf_aws.account_id": accountID,
+	})
+
+	cc := *c
+	cc.AssumeRole = []AssumeRole{ar}
+	provider, _, d := assumeRoleCredentialsProvider(ctx, awsConfig, &cc)
+	result.Diags = result.Diags.Append(d...)
+	if result.Diags.HasError() {
+		return result
+	}
+
+	result.AwsConfig = awsConfig.Copy()
+	result.AwsConfig.Credentials = provider
+
+	return result
+}
+
+// accountRole returns the role template with the account ID placeholder replaced by the account ID.
+func accountRole(roleTemplate AssumeRole, accountID string) AssumeRole {
+	ar := roleTemplate
+	ar.RoleARN = strings.ReplaceAll(roleTemplate.RoleARN, AccountIDPlaceholder, accountID)
+	return ar
+}
@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package awsbase
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/aws-sdk-go-base/v2/diag"
+	"github.com/hashicorp/aws-sdk-go-base/v2/servicemocks"
+)
+
+func TestGetAwsConfigForAccounts(t *testing.T) {
+	servicemocks.InitSessionTestEnv(t)
+
+	ts := servicemocks.MockAwsApiServer("STS", []*servicemocks.MockEndpoint{
+		servicemocks.MockStsAssumeRoleValidEndpoint,
+		servicemocks.MockStsGetCallerIdentityValidEndpoint,
+	})
+	defer ts.Close()
+
+	config := &Config{
+		AccessKey:   servicemocks.MockStaticAccessKey,
+		SecretKey:   servicemocks.MockStaticSecretKey,
+		Region:      "us-east-1",
+		StsEndpoint: ts.URL,
+	}
+	roleTemplate := AssumeRole{
+		RoleARN:     "arn:aws:iam::" + AccountIDPlaceholder + ":role/AssumeRole",
+		SessionName: servicemocks.MockStsAssumeRoleSessionName,
+	}
+	accountIDs := []string{"555555555555", "666666666666", "12345"}
+
+	ctx, results, diags := GetAwsConfigForAccounts(t.Context(), config, roleTemplate, accountIDs, 2)
+	if diags.HasError() {
+		t.Fatalf("error in GetAwsConfigForAccounts(): %v", diags)
+	}
+
+	if a, e := len(results), len(accountIDs); a != e {
+		t.Fatalf("expected %d accounts, got: %d", e, a)
+	}
+	for i, result := range results {
+		if a, e := result.AccountID, accountIDs[i]; a != e {
+			t.Errorf("expected account ID %q, got: %q", e, a)
+		}
+	}
+
+	// The role can be assumed in the first account.
+	if results[0].Diags.HasError() {
+		t.Fatalf("unexpected error for account %q: %v", results[0].AccountID, results[0].Diags)
+	}
+	credentialsValue, err := results[0].AwsConfig.Credentials.Retrieve(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error retrieving credentials: %s", err)
+	}
+	if a, e := credentialsValue.AccessKeyID, servicemocks.MockStsAssumeRoleAccessKey; a != e {
+		t.Errorf("expected access key ID %q, got: %q", e, a)
+	}
+
+	// Assuming the role is denied in the second account.
+	if a := results[1].Diags; len(a) != 1 || !IsCannotAssumeRoleError(a[0]) {
+		t.Errorf("expected a cannot assume role error for account %q, got: %v", results[1].AccountID, a)
+	}
+	if results[1].AwsConfig.Credentials != nil {
+		t.Errorf("expected no credentials for account %q", results[1].AccountID)
+	}
+
+	// The third account ID is invalid.
+	expected := diag.Diagnostics{
+		diag.NewErrorDiagnostic("Invalid Account ID", "invalid AWS account ID (12345): must be 12 digits"),
+	}
+	if diff := cmp.Diff(results[2].Diags, expected); diff != "" {
+		t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+	}
+}
+
+func TestGetAwsConfigForAccounts_InvalidRoleTemplate(t *testing.T) {
+	servicemocks.InitSessionTestEnv(t)
+
+	config := &Config{
+		AccessKey:           servicemocks.MockStaticAccessKey,
+		SecretKey:           servicemocks.MockStaticSecretKey,
+		Region:              "us-east-1",
+		SkipCredsValidation: true,
+	}
+	roleTemplate := AssumeRole{
+		RoleARN: servicemocks.MockStsAssumeRoleArn,
+	}
+
+	_, results, diags := GetAwsConfigForAccounts(t.Context(), config, roleTemplate, []string{"555555555555"}, 0)
+
+	expected := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Invalid Role Template",
+			`The role ARN "arn:aws:iam::555555555555:role/AssumeRole" does not contain the account ID placeholder "{account_id}".`,
+		),
+	}
+	if diff := cmp.Diff(diags, expected); diff != "" {
+		t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+	}
+	if results != nil {
+		t.Errorf("expected no results, got: %v", results)
+	}
+}
+
+func TestGetAwsConfigForAccounts_InvalidRole(t *testing.T) {
+	servicemocks.InitSessionTestEnv(t)
+
+	config := &Config{
+		AccessKey:           servicemocks.MockStaticAccessKey,
+		SecretKey:           servicemocks.MockStaticSecretKey,
+		Region:              "us-east-1",
+		SkipCredsValidation: true,
+	}
+	roleTemplate := AssumeRole{
+		RoleARN:     "arn:aws:iam::" + AccountIDPlaceholder + ":role/AssumeRole",
+		SessionName: "invalid session name",
+	}
+
+	_, results, diags := GetAwsConfigForAccounts(t.Context(), config, roleTemplate, []string{"12345", "555555555555", "666666666666"}, 0)
+
+	expected := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Invalid Session Name",
+			"Session name in assume role 1 of 1 must be 2 to 64 characters and contain only alphanumeric characters and the characters +=,.@-",
+		),
+	}
+	if diff := cmp.Diff(diags, expected); diff != "" {
+		t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+	}
+	if results != nil {
+		t.Errorf("expected no results, got: %v", results)
+	}
+}
@@ -78,17 +78,30 @@ func GetAwsConfig(ctx context.Context, c *Config) (context.Context, aws.Config,
 	}
 
 	c.ValidateProxySettings(&diags)
+	c.ValidateHostOverrides(&diags)
+	c.ValidateEndpoints(&diags)
+	c.ValidateAssumeRoles(&diags)
+	c.ValidateIAMRolesAnywhere(&diags)
+	c.ValidateTimeouts(&diags)
+	c.ValidateConflicts(ctx, &diags)
 	if diags.HasError() {
 		return ctx, aws.Config{}, diags
 	}
 
+	if c.Timeouts.Total > 0 {
+		var cancel context.CancelFunc
+		baseCtx, cancel = context.WithTimeout(baseCtx, c.Timeouts.Total)
+		defer cancel()
+	}
+
 	logger.Debug(baseCtx, "Resolving credentials provider")
 	var (
 		credentialsProvider aws.CredentialsProvider
 		initialSource       string
 		staticCreds         bool
 	)
-	if c.AccessKey != "" || c.SecretKey != "" || c.Token != "" {
+	// IAM Roles Anywhere credentials take precedence over static credentials.
+	if (c.AccessKey != "" || c.SecretKey != "" || c.Token != "") && c.IAMRolesAnywhere == nil {
 		params := make([]string, 0, 3) //nolint:mnd
 		if c.AccessKey != "" {
 			params = append(params, "access key")
@@ -116,7 +129,14 @@ func GetAwsConfig(ctx context.Context, c *Config) (context.Context, aws.Config,
 			return ctx, aws.Config{}, diags.Append(d...)
 		}
 	}
-	creds, err := credentialsProvider.Retrieve(baseCtx)
+	var creds aws.Credentials
+	err := runPhase(baseCtx, phaseCredentialsRetrieval, c.Timeouts.CredentialsRetrieval, func(ctx context.Context) (err error) {
+		creds, err = credentialsProvider.Retrieve(ctx)
+		return err
+	})
+	if e, ok := asPhaseTimeoutError(err); ok {
+		return ctx, aws.Config{}, diags.Append(e)
+	}
 	if err != nil {
 		return ctx, aws.Config{}, diags.AddSimpleError(fmt.Errorf("retrieving credentials: %w", err))
 	}
@@ -158,26 +178,53 @@ func GetAwsConfig(ctx context.Context, c *Config) (context.Context, aws.Config,
 	}
 
 	logger.Debug(baseCtx, "Loading configuration")
-	awsConfig, err := config.LoadDefaultConfig(baseCtx, loadOptions...)
+	var awsConfig aws.Config
+	err = runPhase(baseCtx, phaseSharedConfigLoad, c.Timeouts.SharedConfigLoad, func(ctx context.Context) (err error) {
+		awsConfig, err = config.LoadDefaultConfig(ctx, loadOptions...)
+		return err
+	})
+	if e, ok := asPhaseTimeoutError(err); ok {
+		return ctx, aws.Config{}, diags.Append(e)
+	}
 	if err != nil {
 		return ctx, aws.Config{}, diags.AddSimpleError(fmt.Errorf("loading configuration: %w", err))
 	}
 
+	diags = diags.Append(validateSharedConfigServiceEndpoints(awsConfig.ConfigSources)...)
+	diags = diags.Append(validateEndpointVariants(c, awsConfig.Region)...)
+	if diags.HasError() {
+		return ctx, aws.Config{}, diags
+	}
+	withServiceEndpoints(baseCtx, c, &awsConfig)
+
 	if staticCreds {
 		if c.AssumeRole != nil {
-			provider, d := assumeRoleCredentialsProvider(baseCtx, awsConfig, c)
+			provider, assumed, d := assumeRoleCredentialsProvider(baseCtx, awsConfig, c)
 			diags = diags.Append(d...)
 			if diags.HasError() {
 				return ctx, aws.Config{}, diags
 			}
 			awsConfig.Credentials = provider
+			creds = assumed
 		}
 	}
 
 	resolveRetryer(baseCtx, c, &awsConfig)
 
+	ctx = withResolvedSettings(ctx, resolvedSettings{
+		credentials: creds,
+		imdsRegion:  initialSource == ec2rolecreds.ProviderName,
+	})
+
 	if !c.SkipCredsValidation {
-		if _, _, err := getAccountIDAndPartitionFromSTSGetCallerIdentity(baseCtx, stsClient(baseCtx, awsConfig, c)); err != nil {
+		err := runPhase(baseCtx, phaseIdentityValidation, c.Timeouts.IdentityValidation, func(ctx context.Context) error {
+			_, _, err := getAccountIDAndPartitionFromSTSGetCallerIdentity(ctx, stsClient(ctx, awsConfig, c))
+			return err
+		})
+		if e, ok := asPhaseTimeoutError(err); ok {
+			return ctx, awsConfig, diags.Append(e)
+		}
+		if err != nil {
 			return ctx, awsConfig, diags.AddSimpleError(fmt.Errorf("validating provider credentials: %w", err))
 		}
 	}
@@ -308,8 +355,14 @@ func GetAwsAccountIDAndPartition(ctx context.Context, awsConfig aws.Config, c *C
 	ctx = logging.RegisterLogger(ctx, logger)
 
 	if !c.SkipCredsValidation {
-		stsClient := stsClient(ctx, awsConfig, c)
-		accountID, partition, err := getAccountIDAndPartitionFromSTSGetCallerIdentity(ctx, stsClient)
+		var accountID, partition string
+		err := runPhase(ctx, phaseIdentityValidation, c.Timeouts.IdentityValidation, func(ctx context.Context) (err error) {
+			accountID, partition, err = getAccountIDAndPartitionFromSTSGetCallerIdentity(ctx, stsClient(ctx, awsConfig, c))
+			return err
+		})
+		if e, ok := asPhaseTimeoutError(err); ok {
+			return "", "", diags.Append(e)
+		}
 		if err != nil {
 			return "", "", diags.AddSimpleError(fmt.Errorf("validating provider credentials: %w", err))
 		}
@@ -337,7 +390,11 @@ func GetAwsAccountIDAndPartition(ctx context.Context, awsConfig aws.Config, c *C
 				"Errors: %w", err))
 	}
 
-	partition, _ := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), awsConfig.Region)
+	ps, err := c.Partitions()
+	if err != nil {
+		return "", "", diags.AddSimpleError(err)
+	}
+	partition, _ := endpoints.PartitionForRegion(ps, awsConfig.Region)
 
 	return "", partition.ID(), nil
 }
@@ -354,6 +411,12 @@ func commonLoadOptions(ctx context.Context, c *Config) ([]func(*config.LoadOptio
 		if err != nil {
 			return nil, err
 		}
+		if fields := proxyLogFields(c); len(fields) > 0 {
+			logger.Debug(ctx, "Setting HTTP proxy", fields)
+		}
+		if fields := hostOverrideLogFields(c); len(fields) > 0 {
+			logger.Debug(ctx, "Setting host overrides", fields)
+		}
 	} else {
 		logger.Debug(ctx, "Setting HTTP client", map[string]any{
 			"tf_aws.http_client.source": configSourceProviderConfig,
@@ -16,6 +16,7 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"testing"
@@ -968,6 +969,13 @@ aws_secret_access_key = DefaultSharedCredentialsSecretKey
 				Source:          sharedConfigCredentialsProvider,
 			},
 			ExpectedRegion: "us-east-1",
+			ValidateDiags: test.ExpectDiagValidator("Configuration conflict warning", func(d diag.Diagnostic) bool {
+				return d.Equal(diag.NewWarningDiagnostic(
+					"Configuration conflict detected",
+					`A Profile was specified along with the environment variables "AWS_ACCESS_KEY_ID" and "AWS_SECRET_ACCESS_KEY". `+
+						`The Profile is now used instead of the environment variable credentials. This may lead to unexpected behavior.`,
+				))
+			}),
 			MockStsEndpoints: []*servicemocks.MockEndpoint{
 				servicemocks.MockStsGetCallerIdentityValidEndpoint,
 			},
@@ -2863,6 +2871,39 @@ web_identity_token_file = no-such-file
 			},
 		},
 
+		"token command": {
+			Config: &Config{
+				AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+					RoleARN:     servicemocks.MockStsAssumeRoleWithWebIdentityArn,
+					SessionName: servicemocks.MockStsAssumeRoleWithWebIdentitySessionName,
+					WebIdentityTokenCommand: &WebIdentityTokenCommand{
+						Command: []string{"/bin/sh", "-c", `echo "$TOKEN"`},
+						Env:     []string{"TOKEN=" + servicemocks.MockWebIdentityToken},
+					},
+				},
+			},
+			ExpectedCredentialsValue: mockdata.MockStsAssumeRoleWithWebIdentityCredentials,
+			MockStsEndpoints: []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleWithWebIdentityValidEndpoint,
+			},
+		},
+
+		"token func": {
+			Config: &Config{
+				AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+					RoleARN:     servicemocks.MockStsAssumeRoleWithWebIdentityArn,
+					SessionName: servicemocks.MockStsAssumeRoleWithWebIdentitySessionName,
+					WebIdentityTokenFunc: func(context.Context) (string, error) {
+						return servicemocks.MockWebIdentityToken, nil
+					},
+				},
+			},
+			ExpectedCredentialsValue: mockdata.MockStsAssumeRoleWithWebIdentityCredentials,
+			MockStsEndpoints: []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleWithWebIdentityValidEndpoint,
+			},
+		},
+
 		"with duration": {
 			Config: &Config{
 				AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
@@ -2903,6 +2944,10 @@ web_identity_token_file = no-such-file
 					"Assume Role With Web Identity",
 					"Role ARN was not set",
 				),
+				diag.NewErrorDiagnostic(
+					"Assume Role With Web Identity",
+					"One of WebIdentityToken, WebIdentityTokenFile, WebIdentityTokenCommand, WebIdentityTokenCI, WebIdentityTokenFunc must be set",
+				),
 			},
 		},
 
@@ -2916,7 +2961,7 @@ web_identity_token_file = no-such-file
 			ExpectedDiags: diag.Diagnostics{
 				diag.NewErrorDiagnostic(
 					"Assume Role With Web Identity",
-					"One of WebIdentityToken, WebIdentityTokenFile must be set",
+					"One of WebIdentityToken, WebIdentityTokenFile, WebIdentityTokenCommand, WebIdentityTokenCI, WebIdentityTokenFunc must be set",
 				),
 			},
 		},
@@ -3023,6 +3068,212 @@ web_identity_token_file = no-such-file
 	}
 }
 
+func TestAssumeRoleWithWebIdentity_CI(t *testing.T) {
+	testCases := map[string]struct {
+		WebIdentityTokenCI       *WebIdentityTokenCI
+		MockCI                   func() func()
+		Audience                 string
+		ExpectedCredentialsValue aws.Credentials
+		ExpectedErr              string
+	}{
+		"GitHub Actions detected": {
+			WebIdentityTokenCI:       &WebIdentityTokenCI{},
+			MockCI:                   servicemocks.GitHubActionsIDTokenApiMock,
+			Audience:                 "sts.amazonaws.com",
+			ExpectedCredentialsValue: mockdata.MockStsAssumeRoleWithWebIdentityCredentials,
+		},
+
+		"GitHub Actions audience": {
+			WebIdentityTokenCI: &WebIdentityTokenCI{
+				Provider: CIProviderGitHubActions,
+				Audience: "custom-audience",
+			},
+			MockCI:                   servicemocks.GitHubActionsIDTokenApiMock,
+			Audience:                 "custom-audience",
+			ExpectedCredentialsValue: mockdata.MockStsAssumeRoleWithWebIdentityCredentials,
+		},
+
+		"GitHub Actions without id-token permission": {
+			WebIdentityTokenCI: &WebIdentityTokenCI{
+				Provider: CIProviderGitHubActions,
+			},
+			ExpectedErr: "ACTIONS_ID_TOKEN_REQUEST_URL and ACTIONS_ID_TOKEN_REQUEST_TOKEN are not set",
+		},
+
+		"GitLab detected": {
+			WebIdentityTokenCI: &WebIdentityTokenCI{
+				Audience: "sts.amazonaws.com",
+			},
+			MockCI: func() func() {
+				return servicemocks.GitLabIDTokenMock("CI_JOB_JWT_V2", "sts.amazonaws.com")
+			},
+			Audience:                 "sts.amazonaws.com",
+			ExpectedCredentialsValue: mockdata.MockStsAssumeRoleWithWebIdentityCredentials,
+		},
+
+		"GitLab env var": {
+			WebIdentityTokenCI: &WebIdentityTokenCI{
+				Provider: CIProviderGitLab,
+				EnvVar:   "AWS_ID_TOKEN",
+			},
+			MockCI: func() func() {
+				return servicemocks.GitLabIDTokenMock("AWS_ID_TOKEN", "https://gitlab.com")
+			},
+			Audience:                 "https://gitlab.com",
+			ExpectedCredentialsValue: mockdata.MockStsAssumeRoleWithWebIdentityCredentials,
+		},
+
+		"GitLab audience mismatch": {
+			WebIdentityTokenCI: &WebIdentityTokenCI{
+				Provider: CIProviderGitLab,
+				Audience: "sts.amazonaws.com",
+			},
+			MockCI: func() func() {
+				return servicemocks.GitLabIDTokenMock("CI_JOB_JWT_V2", "https://gitlab.com")
+			},
+			ExpectedErr: `token audience "https://gitlab.com" does not include "sts.amazonaws.com"`,
+		},
+
+		"Terraform Cloud detected": {
+			WebIdentityTokenCI: &WebIdentityTokenCI{
+				Audience: "aws.workload.identity",
+			},
+			MockCI: func() func() {
+				return servicemocks.TerraformCloudWorkloadIdentityTokenMock("TFC_WORKLOAD_IDENTITY_TOKEN", "aws.workload.identity")
+			},
+			Audience:                 "aws.workload.identity",
+			ExpectedCredentialsValue: mockdata.MockStsAssumeRoleWithWebIdentityCredentials,
+		},
+
+		"Terraform Cloud tagged token": {
+			WebIdentityTokenCI: &WebIdentityTokenCI{
+				Provider: CIProviderTerraformCloud,
+				EnvVar:   "TFC_WORKLOAD_IDENTITY_TOKEN_AWS",
+			},
+			MockCI: func() func() {
+				return servicemocks.TerraformCloudWorkloadIdentityTokenMock("TFC_WORKLOAD_IDENTITY_TOKEN_AWS", "aws.workload.identity")
+			},
+			Audience:                 "aws.workload.identity",
+			ExpectedCredentialsValue: mockdata.MockStsAssumeRoleWithWebIdentityCredentials,
+		},
+
+		"not detected": {
+			WebIdentityTokenCI: &WebIdentityTokenCI{},
+			ExpectedErr:        "no supported CI system detected",
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			ctx := t.Context()
+
+			servicemocks.InitSessionTestEnv(t)
+
+			if testCase.MockCI != nil {
+				closeCI := testCase.MockCI()
+				defer closeCI()
+			}
+
+			closeSts, _, stsEndpoint := mockdata.GetMockedAwsApiSession("STS", []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleWithWebIdentityValidWithOptions(map[string]string{
+					"WebIdentityToken": servicemocks.MockCIIDToken(testCase.Audience),
+				}),
+			})
+			defer closeSts()
+
+			config := &Config{
+				AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+					RoleARN:            servicemocks.MockStsAssumeRoleWithWebIdentityArn,
+					SessionName:        servicemocks.MockStsAssumeRoleWithWebIdentitySessionName,
+					WebIdentityTokenCI: testCase.WebIdentityTokenCI,
+				},
+				SkipCredsValidation: true,
+				StsEndpoint:         stsEndpoint,
+			}
+
+			ctx, awsConfig, diags := GetAwsConfig(ctx, config)
+
+			if testCase.ExpectedErr != "" {
+				if !diags.HasError() {
+					t.Fatalf("expected error containing %q, got none", testCase.ExpectedErr)
+				}
+				for _, d := range diags.Errors() {
+					if !strings.Contains(d.Detail(), testCase.ExpectedErr) {
+						t.Errorf("expected error containing %q, got: %s", testCase.ExpectedErr, d.Detail())
+					}
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			credentialsValue, err := awsConfig.Credentials.Retrieve(ctx)
+			if err != nil {
+				t.Fatalf("unexpected credentials Retrieve() error: %s", err)
+			}
+
+			if diff := cmp.Diff(credentialsValue, testCase.ExpectedCredentialsValue, cmpopts.IgnoreFields(aws.Credentials{}, "Expires")); diff != "" {
+				t.Fatalf("unexpected credentials: (- got, + expected)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAssumeRoleWithWebIdentity_CIHTTPClient(t *testing.T) {
+	ctx := t.Context()
+
+	servicemocks.InitSessionTestEnv(t)
+
+	closeCI := servicemocks.GitHubActionsIDTokenApiMock()
+	defer closeCI()
+
+	closeSts, _, stsEndpoint := mockdata.GetMockedAwsApiSession("STS", []*servicemocks.MockEndpoint{
+		servicemocks.MockStsAssumeRoleWithWebIdentityValidWithOptions(map[string]string{
+			"WebIdentityToken": servicemocks.MockCIIDToken("sts.amazonaws.com"),
+		}),
+	})
+	defer closeSts()
+
+	transport := &recordingTransport{
+		transport: http.DefaultTransport,
+	}
+
+	config := &Config{
+		AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+			RoleARN:     servicemocks.MockStsAssumeRoleWithWebIdentityArn,
+			SessionName: servicemocks.MockStsAssumeRoleWithWebIdentitySessionName,
+			WebIdentityTokenCI: &WebIdentityTokenCI{
+				Provider: CIProviderGitHubActions,
+			},
+		},
+		HTTPClient: &http.Client{
+			Transport: transport,
+		},
+		SkipCredsValidation: true,
+		StsEndpoint:         stsEndpoint,
+	}
+
+	_, _, diags := GetAwsConfig(ctx, config)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if !slices.Contains(transport.paths, "/token") {
+		t.Errorf("expected the GitHub Actions token request to use the configured HTTP client, got requests for %v", transport.paths)
+	}
+}
+
+type recordingTransport struct {
+	transport http.RoundTripper
+	paths     []string
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.paths = append(t.paths, req.URL.Path)
+	return t.transport.RoundTrip(req)
+}
+
 func TestStsEndpoint(t *testing.T) {
 	type settype int
 	const (
@@ -3337,6 +3588,394 @@ endpoint_url = %[2]s
 	}
 }
 
+func TestServiceEndpoints(t *testing.T) {
+	testcases := map[string]struct {
+		Config              Config
+		Endpoints           map[string]string
+		SetInvalidEnv       string
+		ConfigFile          string
+		ExpectedDiags       diag.Diagnostics
+		ExpectedCredentials aws.Credentials
+	}{
+		"config": {
+			Config: Config{
+				AccessKey: servicemocks.MockStaticAccessKey,
+				Region:    "us-east-1",
+				SecretKey: servicemocks.MockStaticSecretKey,
+			},
+			Endpoints: map[string]string{
+				"STS": "%[1]s",
+			},
+			ExpectedCredentials: mockdata.MockStaticCredentials,
+		},
+
+		"config normalized key": {
+			Config: Config{
+				AccessKey: servicemocks.MockStaticAccessKey,
+				Region:    "us-east-1",
+				SecretKey: servicemocks.MockStaticSecretKey,
+			},
+			Endpoints: map[string]string{
+				"sts": "%[1]s",
+			},
+			ExpectedCredentials: mockdata.MockStaticCredentials,
+		},
+
+		"config overrides service envvar": {
+			Config: Config{
+				AccessKey: servicemocks.MockStaticAccessKey,
+				Region:    "us-east-1",
+				SecretKey: servicemocks.MockStaticSecretKey,
+			},
+			Endpoints: map[string]string{
+				"STS": "%[1]s",
+			},
+			SetInvalidEnv:       "AWS_ENDPOINT_URL_STS",
+			ExpectedCredentials: mockdata.MockStaticCredentials,
+		},
+
+		"config overrides service config_file": {
+			Config: Config{
+				Profile: "default",
+			},
+			Endpoints: map[string]string{
+				"STS": "%[1]s",
+			},
+			ConfigFile: `
+[default]
+aws_access_key_id = DefaultSharedCredentialsAccessKey
+aws_secret_access_key = DefaultSharedCredentialsSecretKey
+services = sts-test
+
+[services sts-test]
+sts =
+	endpoint_url = %[2]s
+`,
+			ExpectedCredentials: aws.Credentials{
+				AccessKeyID:     "DefaultSharedCredentialsAccessKey",
+				SecretAccessKey: "DefaultSharedCredentialsSecretKey",
+				Source:          sharedConfigCredentialsProvider,
+			},
+		},
+
+		"StsEndpoint overrides config": {
+			Config: Config{
+				AccessKey:   servicemocks.MockStaticAccessKey,
+				Region:      "us-east-1",
+				SecretKey:   servicemocks.MockStaticSecretKey,
+				StsEndpoint: "%[1]s",
+			},
+			Endpoints: map[string]string{
+				"STS": "%[2]s",
+			},
+			ExpectedCredentials: mockdata.MockStaticCredentials,
+		},
+
+		"config assume role": {
+			Config: Config{
+				AccessKey: servicemocks.MockStaticAccessKey,
+				Region:    "us-east-1",
+				SecretKey: servicemocks.MockStaticSecretKey,
+				AssumeRole: []AssumeRole{{
+					RoleARN:     servicemocks.MockStsAssumeRoleArn,
+					SessionName: servicemocks.MockStsAssumeRoleSessionName,
+				}},
+			},
+			Endpoints: map[string]string{
+				"STS": "%[1]s",
+			},
+			ExpectedCredentials: mockdata.MockStsAssumeRoleCredentials,
+		},
+
+		"config invalid URL": {
+			Config: Config{
+				AccessKey: servicemocks.MockStaticAccessKey,
+				Region:    "us-east-1",
+				SecretKey: servicemocks.MockStaticSecretKey,
+			},
+			Endpoints: map[string]string{
+				"STS": "sts.example.com",
+			},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Service Endpoint",
+					`Endpoint for service "STS": URL "sts.example.com" must use the "http" or "https" scheme`,
+				),
+			},
+		},
+
+		"config_file invalid URL": {
+			Config: Config{
+				Profile: "default",
+			},
+			ConfigFile: `
+[default]
+aws_access_key_id = DefaultSharedCredentialsAccessKey
+aws_secret_access_key = DefaultSharedCredentialsSecretKey
+services = sts-test
+
+[services sts-test]
+sts =
+	endpoint_url = sts.example.com
+`,
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Service Endpoint",
+					`shared config services section "sts-test", service "sts": URL "sts.example.com" must use the "http" or "https" scheme`,
+				),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			servicemocks.InitSessionTestEnv(t)
+
+			ctx := t.Context()
+
+			ts := servicemocks.MockAwsApiServer("STS", []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleValidEndpoint,
+				servicemocks.MockStsGetCallerIdentityValidEndpoint,
+			})
+			defer ts.Close()
+			stsEndpoint := ts.URL
+
+			invalidTS := servicemocks.MockAwsApiServer("STS", []*servicemocks.MockEndpoint{
+				servicemocks.MockStsGetCallerIdentityInvalidEndpointAccessDenied,
+			})
+			defer invalidTS.Close()
+			stsInvalidEndpoint := invalidTS.URL
+
+			if testcase.Config.StsEndpoint != "" {
+				testcase.Config.StsEndpoint = fmt.Sprintf(testcase.Config.StsEndpoint, stsEndpoint, stsInvalidEndpoint)
+			}
+			if len(testcase.Endpoints) > 0 {
+				testcase.Config.Endpoints = make(map[string]string, len(testcase.Endpoints))
+				for k, v := range testcase.Endpoints {
+					if strings.Contains(v, "%[") {
+						v = fmt.Sprintf(v, stsEndpoint, stsInvalidEndpoint)
+					}
+					testcase.Config.Endpoints[k] = v
+				}
+			}
+			if testcase.SetInvalidEnv != "" {
+				t.Setenv(testcase.SetInvalidEnv, stsInvalidEndpoint)
+			}
+			if testcase.ConfigFile != "" {
+				tempDir := t.TempDir()
+				filename := writeSharedConfigFile(t, &testcase.Config, tempDir, fmt.Sprintf(testcase.ConfigFile, stsEndpoint, stsInvalidEndpoint))
+				testcase.ExpectedCredentials.Source = sharedConfigCredentialsSource(filename)
+			}
+
+			ctx, awsConfig, diags := GetAwsConfig(ctx, &testcase.Config)
+
+			if diff := cmp.Diff(diags, testcase.ExpectedDiags); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+			if diags.HasError() {
+				return
+			}
+
+			credentialsValue, err := awsConfig.Credentials.Retrieve(ctx)
+			if err != nil {
+				t.Fatalf("unexpected credentials Retrieve() error: %s", err)
+			}
+
+			if diff := cmp.Diff(credentialsValue, testcase.ExpectedCredentials, cmpopts.IgnoreFields(aws.Credentials{}, "Expires")); diff != "" {
+				t.Fatalf("unexpected credentials: (- got, + expected)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEndpointVariantsPartitionSupport(t *testing.T) {
+	const partitionsFile = `{
+  "partitions": [{
+    "partition": "aws-test",
+    "dnsSuffix": "test.example.com",
+    "regionRegex": "^xx\\-\\w+\\-\\d+$",
+    "defaults": {
+      "variants": [{
+        "hostname": "{service}-fips.{region}.{dnsSuffix}",
+        "tags": ["fips"]
+      }]
+    },
+    "regions": {
+      "xx-test-1": {"description": "Test Region"}
+    }
+  }]
+}`
+
+	testcases := map[string]struct {
+		Config        Config
+		ExpectedDiags diag.Diagnostics
+	}{
+		"FIPS supported": {
+			Config: Config{
+				Region:          "xx-test-1",
+				UseFIPSEndpoint: true,
+			},
+		},
+		"dual-stack not supported": {
+			Config: Config{
+				Region:               "xx-test-1",
+				UseDualStackEndpoint: true,
+			},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unsupported Dual-Stack Endpoints",
+					`Dual-stack endpoints are enabled, but the partition "aws-test" of Region "xx-test-1" does not support dual-stack endpoints.`,
+				),
+			},
+		},
+		"default partition": {
+			Config: Config{
+				Region:               "us-west-2",
+				UseDualStackEndpoint: true,
+				UseFIPSEndpoint:      true,
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			servicemocks.InitSessionTestEnv(t)
+
+			path := filepath.Join(t.TempDir(), "partitions.json")
+			if err := os.WriteFile(path, []byte(partitionsFile), 0600); err != nil {
+				t.Fatalf("writing partitions file: %s", err)
+			}
+
+			config := testcase.Config
+			config.AccessKey = servicemocks.MockStaticAccessKey
+			config.SecretKey = servicemocks.MockStaticSecretKey
+			config.PartitionsFile = path
+			config.SkipCredsValidation = true
+
+			_, _, diags := GetAwsConfig(t.Context(), &config)
+
+			if diff := cmp.Diff(diags, testcase.ExpectedDiags); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAssumeRoleARNValidation(t *testing.T) {
+	largePolicy := `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"*","Resource":"` + strings.Repeat("a", 2048) + `"}}`
+
+	testcases := map[string]struct {
+		Config        Config
+		ExpectedDiags diag.Diagnostics
+	}{
+		"invalid role ARN": {
+			Config: Config{
+				AssumeRole: []AssumeRole{{
+					RoleARN: "arn:aws:iam::555555555555:user/AssumeRole",
+				}},
+			},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid IAM Role ARN",
+					`IAM Role ARN in assume role 1 of 1: invalid ARN (arn:aws:iam::555555555555:user/AssumeRole): resource (user/AssumeRole) is not a valid IAM role, expected "role/[path/]name"`,
+				),
+			},
+		},
+		"unknown partition": {
+			Config: Config{
+				AssumeRole: []AssumeRole{
+					{
+						RoleARN: servicemocks.MockStsAssumeRoleArn,
+					},
+					{
+						RoleARN: "arn:aws-unknown:iam::555555555555:role/AssumeRole",
+					},
+				},
+			},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid IAM Role ARN",
+					"IAM Role ARN in assume role 2 of 2: invalid ARN (arn:aws-unknown:iam::555555555555:role/AssumeRole): unknown partition (aws-unknown)",
+				),
+			},
+		},
+		"invalid policy ARN": {
+			Config: Config{
+				AssumeRole: []AssumeRole{{
+					RoleARN:    servicemocks.MockStsAssumeRoleArn,
+					PolicyARNs: []string{"arn:aws:iam::5555:policy/AssumeRolePolicy1"},
+				}},
+			},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid IAM Policy ARN",
+					"IAM Policy ARN in assume role 1 of 1: invalid ARN (arn:aws:iam::5555:policy/AssumeRolePolicy1): account ID (5555) must be 12 digits",
+				),
+			},
+		},
+		"invalid web identity role ARN": {
+			Config: Config{
+				AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+					RoleARN:          "arn:aws:sts::666666666666:role/WebIdentityToken",
+					WebIdentityToken: servicemocks.MockWebIdentityToken,
+				},
+			},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid IAM Role ARN",
+					`IAM Role ARN in assume role with web identity: invalid ARN (arn:aws:sts::666666666666:role/WebIdentityToken): service (sts) must be "iam"`,
+				),
+			},
+		},
+		"session policy too large": {
+			Config: Config{
+				AssumeRole: []AssumeRole{{
+					RoleARN: servicemocks.MockStsAssumeRoleArn,
+					Policy:  largePolicy,
+				}},
+			},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Session Policy",
+					fmt.Sprintf("Session policy in assume role 1 of 1: session policy is %d characters after removing whitespace, maximum is 2048", len(largePolicy)),
+				),
+			},
+		},
+		"web identity session policy too large": {
+			Config: Config{
+				AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+					RoleARN:          servicemocks.MockStsAssumeRoleWithWebIdentityArn,
+					WebIdentityToken: servicemocks.MockWebIdentityToken,
+					Policy:           largePolicy,
+				},
+			},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Session Policy",
+					fmt.Sprintf("Session policy in assume role with web identity: session policy is %d characters after removing whitespace, maximum is 2048", len(largePolicy)),
+				),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			servicemocks.InitSessionTestEnv(t)
+
+			config := testcase.Config
+			config.AccessKey = servicemocks.MockStaticAccessKey
+			config.SecretKey = servicemocks.MockStaticSecretKey
+			config.Region = "us-east-1"
+
+			_, _, diags := GetAwsConfig(t.Context(), &config)
+
+			if diff := cmp.Diff(diags, testcase.ExpectedDiags); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 var _ configtesting.TestDriver = &testDriver{}
 
 type testDriver struct {
@@ -3357,6 +3996,7 @@ func (t testDriver) TestCase() configtesting.TestCaseDriver {
 }
 
 var _ configtesting.TestCaseDriver = &testCaseDriver{}
+var _ configtesting.TestCaseDriverWithDiags = &testCaseDriver{}
 
 type testCaseDriver struct {
 	mode   configtesting.TestMode
@@ -3374,10 +4014,15 @@ func (d testCaseDriver) Setup(_ *testing.T) {
 	// Noop
 }
 
-// TODO: Make work with expected diffs
 func (d testCaseDriver) Apply(ctx context.Context, t *testing.T) (context.Context, configtesting.Thing) {
 	t.Helper()
 
+	return d.ApplyWithDiags(ctx, t, nil)
+}
+
+func (d testCaseDriver) ApplyWithDiags(ctx context.Context, t *testing.T, expectedDiags diag.Diagnostics) (context.Context, configtesting.Thing) {
+	t.Helper()
+
 	if d.mode == configtesting.TestModeLocal {
 		d.config.setSkipCredsValidation(true)
 	}
@@ -3385,7 +4030,7 @@ func (d testCaseDriver) Apply(ctx context.Context, t *testing.T) (context.Contex
 	config := Config(d.config)
 	ctx, awsConfig, diags := GetAwsConfig(ctx, &config)
 
-	if diff := cmp.Diff(diags, diag.Diagnostics{}); diff != "" {
+	if diff := cmp.Diff(diags, expectedDiags, cmpopts.EquateEmpty()); diff != "" {
 		t.Errorf("unexpected diagnostics difference: %s", diff)
 	}
 
@@ -3467,6 +4112,7 @@ func TestGetAwsConfigWithAccountIDAndPartition(t *testing.T) {
 		expectedPartition string
 		expectError       bool
 		mockStsEndpoints  []*servicemocks.MockEndpoint
+		partitionsFile    string
 		ExpectedDiags     diag.Diagnostics
 	}{
 		{
@@ -3503,6 +4149,29 @@ func TestGetAwsConfigWithAccountIDAndPartition(t *testing.T) {
 			expectedAcctID: "", expectedPartition: "aws",
 			mockStsEndpoints: []*servicemocks.MockEndpoint{},
 		},
+		{
+			desc: "SkipRequestingAccountId_PartitionsFile",
+			config: &Config{
+				AccessKey:               "MockAccessKey",
+				SecretKey:               "MockSecretKey",
+				Region:                  "xx-test-1",
+				SkipCredsValidation:     true,
+				SkipRequestingAccountId: true},
+			partitionsFile: `{
+  "partitions": [{
+    "partition": "aws-test",
+    "partitionName": "AWS Test",
+    "dnsSuffix": "test.example.com",
+    "regionRegex": "^xx\\-\\w+\\-\\d+$",
+    "regions": {
+      "xx-test-1": {"description": "Test Region"}
+    },
+    "services": {}
+  }]
+}`,
+			expectedAcctID: "", expectedPartition: "aws-test",
+			mockStsEndpoints: []*servicemocks.MockEndpoint{},
+		},
 		{
 			desc: "WithAssumeRole",
 			config: &Config{
@@ -3528,6 +4197,19 @@ func TestGetAwsConfigWithAccountIDAndPartition(t *testing.T) {
 			defer ts.Close()
 			testCase.config.StsEndpoint = ts.URL
 
+			if testCase.partitionsFile != "" {
+				file, err := os.CreateTemp(t.TempDir(), "aws-sdk-go-base-partitions-file")
+				if err != nil {
+					t.Fatalf("unexpected error creating temporary partitions file: %s", err)
+				}
+				if _, err := file.WriteString(testCase.partitionsFile); err != nil {
+					t.Fatalf("unexpected error writing partitions file: %s", err)
+				}
+				file.Close()
+
+				testCase.config.PartitionsFile = file.Name()
+			}
+
 			ctx, awsConfig, diags := GetAwsConfig(t.Context(), testCase.config)
 			if diags.HasError() {
 				t.Fatalf("error in GetAwsConfig(): %v", diags)
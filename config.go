@@ -5,9 +5,10 @@ package awsbase
 
 import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/internal/config"
+	"github.com/hashicorp/aws-sdk-go-base/v2/internal/sharedconfig"
 )
 
-// Config, APNInfo, APNProduct, and AssumeRole are aliased to an internal package to break a dependency cycle
+// Config, APNInfo, APNProduct, AssumeRole, and the proxy types are aliased to an internal package to break a dependency cycle
 // in internal/httpclient.
 
 type Config = config.Config
@@ -18,6 +19,28 @@ type AssumeRole = config.AssumeRole
 
 type AssumeRoleWithWebIdentity = config.AssumeRoleWithWebIdentity
 
+type WebIdentityTokenCommand = config.WebIdentityTokenCommand
+
+type WebIdentityTokenCI = config.WebIdentityTokenCI
+
+type CIProvider = config.CIProvider
+
+type IAMRolesAnywhere = config.IAMRolesAnywhere
+
+type ProxyCredentials = config.ProxyCredentials
+
+type ProxyRule = config.ProxyRule
+
+type Timeouts = config.Timeouts
+
+type SharedConfigInspection = config.SharedConfigInspection
+
+type SharedConfigProfile = config.SharedConfigProfile
+
+type SharedConfigSSOSession = config.SharedConfigSSOSession
+
+type SharedConfigLocation = sharedconfig.Location
+
 type UserAgentProducts = config.UserAgentProducts
 
 type UserAgentProduct = config.UserAgentProduct
@@ -34,6 +57,17 @@ func EC2MetadataEndpointMode_Values() []string {
 	}
 }
 
+const (
+	CIProviderGitHubActions  = config.CIProviderGitHubActions
+	CIProviderGitLab         = config.CIProviderGitLab
+	CIProviderTerraformCloud = config.CIProviderTerraformCloud
+)
+
+// DetectCIProvider returns the CI system running the current process, if any, based on its environment variables.
+func DetectCIProvider() (CIProvider, bool) {
+	return config.DetectCIProvider()
+}
+
 const (
 	HTTPProxyModeLegacy   = config.HTTPProxyModeLegacy
 	HTTPProxyModeSeparate = config.HTTPProxyModeSeparate
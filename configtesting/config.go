@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/diag"
 	"github.com/hashicorp/aws-sdk-go-base/v2/mockdata"
 	"github.com/hashicorp/aws-sdk-go-base/v2/servicemocks"
 )
@@ -35,6 +36,22 @@ type TestCaseDriver interface {
 	Apply(ctx context.Context, t *testing.T) (context.Context, Thing)
 }
 
+// TestCaseDriverWithDiags is optionally implemented by a TestCaseDriver to check
+// the diagnostics returned when the configuration is applied.
+// Drivers that do not implement it are applied using Apply.
+type TestCaseDriverWithDiags interface {
+	ApplyWithDiags(ctx context.Context, t *testing.T, expectedDiags diag.Diagnostics) (context.Context, Thing)
+}
+
+func apply(ctx context.Context, t *testing.T, caseDriver TestCaseDriver, expectedDiags diag.Diagnostics) (context.Context, Thing) {
+	t.Helper()
+
+	if d, ok := caseDriver.(TestCaseDriverWithDiags); ok {
+		return d.ApplyWithDiags(ctx, t, expectedDiags)
+	}
+	return caseDriver.Apply(ctx, t)
+}
+
 type Configurer interface {
 	SetAccessKey(s string)
 	SetSecretKey(s string)
@@ -78,6 +95,7 @@ func SSO(t *testing.T, driver TestDriver) {
 		Configuration            []ConfigFunc
 		SharedConfigurationFile  string
 		ExpectedCredentialsValue aws.Credentials
+		ExpectedDiags            diag.Diagnostics
 	}{
 		"shared configuration file": {
 			SharedConfigurationFile: fmt.Sprintf(`
@@ -112,6 +130,13 @@ sso_start_url = https://d-123456789a.awsapps.com/start
 sso_registration_scopes = sso:account:access
 `, ssoSessionName),
 			ExpectedCredentialsValue: mockdata.MockSsoCredentials,
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Configuration conflict detected",
+					"FIPS endpoints were enabled in the provider configuration along with a custom endpoint in the SSO endpoint in the provider configuration. "+
+						"The custom endpoint is used, so requests may not use a FIPS endpoint.",
+				),
+			},
 		},
 	}
 
@@ -161,7 +186,7 @@ sso_registration_scopes = sso:account:access
 
 			caseDriver.Setup(t)
 
-			ctx, thing := caseDriver.Apply(ctx, t)
+			ctx, thing := apply(ctx, t, caseDriver, tc.ExpectedDiags)
 
 			credentials := thing.GetCredentials()
 			if credentials == nil {
@@ -191,6 +216,7 @@ func LegacySSO(t *testing.T, driver TestDriver) {
 		Configuration            []ConfigFunc
 		SharedConfigurationFile  string
 		ExpectedCredentialsValue aws.Credentials
+		ExpectedDiags            diag.Diagnostics
 	}{
 		"shared configuration file": {
 			SharedConfigurationFile: fmt.Sprintf(`
@@ -217,6 +243,13 @@ sso_role_name = testRole
 region = us-east-1
 `, ssoStartUrl),
 			ExpectedCredentialsValue: mockdata.MockSsoCredentials,
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Configuration conflict detected",
+					"FIPS endpoints were enabled in the provider configuration along with a custom endpoint in the SSO endpoint in the provider configuration. "+
+						"The custom endpoint is used, so requests may not use a FIPS endpoint.",
+				),
+			},
 		},
 	}
 
@@ -266,7 +299,7 @@ region = us-east-1
 
 			caseDriver.Setup(t)
 
-			ctx, thing := caseDriver.Apply(ctx, t)
+			ctx, thing := apply(ctx, t, caseDriver, tc.ExpectedDiags)
 
 			credentials := thing.GetCredentials()
 			if credentials == nil {
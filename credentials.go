@@ -44,12 +44,6 @@ func getCredentialsProvider(ctx context.Context, c *Config) (aws.CredentialsProv
 		return nil, "", diags.AddSimpleError(err)
 	}
 
-	if c.Profile != "" && os.Getenv("AWS_ACCESS_KEY_ID") != "" && os.Getenv("AWS_SECRET_ACCESS_KEY") != "" {
-		diags.AddWarning("Configuration conflict detected",
-			`A Profile was specified along with the environment variables "AWS_ACCESS_KEY_ID" and "AWS_SECRET_ACCESS_KEY". `+
-				`The Profile is now used instead of the environment variable credentials. This may lead to unexpected behavior.`)
-	}
-
 	if profile := c.Profile; profile != "" {
 		logger.Debug(ctx, "Setting profile", map[string]any{
 			"tf_aws.profile":        profile,
@@ -67,7 +61,14 @@ func getCredentialsProvider(ctx context.Context, c *Config) (aws.CredentialsProv
 	}
 
 	logger.Debug(ctx, "Loading configuration")
-	cfg, err := config.LoadDefaultConfig(ctx, loadOptions...)
+	var cfg aws.Config
+	err = runPhase(ctx, phaseSharedConfigLoad, c.Timeouts.SharedConfigLoad, func(ctx context.Context) (err error) {
+		cfg, err = config.LoadDefaultConfig(ctx, loadOptions...)
+		return err
+	})
+	if e, ok := asPhaseTimeoutError(err); ok {
+		return nil, "", diags.Append(e)
+	}
 	if err != nil {
 		return nil, "", diags.AddSimpleError(err)
 	}
@@ -75,12 +76,6 @@ func getCredentialsProvider(ctx context.Context, c *Config) (aws.CredentialsProv
 	// This can probably be configured directly in commonLoadOptions() once
 	// https://github.com/aws/aws-sdk-go-v2/pull/1682 is merged
 	if c.AssumeRoleWithWebIdentity != nil {
-		if c.AssumeRoleWithWebIdentity.RoleARN == "" {
-			return nil, "", diags.AddError("Assume Role With Web Identity", "Role ARN was not set")
-		}
-		if c.AssumeRoleWithWebIdentity.WebIdentityToken == "" && c.AssumeRoleWithWebIdentity.WebIdentityTokenFile == "" {
-			return nil, "", diags.AddError("Assume Role With Web Identity", "One of WebIdentityToken, WebIdentityTokenFile must be set")
-		}
 		provider, d := webIdentityCredentialsProvider(ctx, cfg, c)
 		diags = diags.Append(d...)
 		if diags.HasError() {
@@ -89,8 +84,24 @@ func getCredentialsProvider(ctx context.Context, c *Config) (aws.CredentialsProv
 		cfg.Credentials = provider
 	}
 
+	if c.IAMRolesAnywhere != nil {
+		provider, d := rolesAnywhereCredentialsProvider(ctx, cfg, c)
+		diags = diags.Append(d...)
+		if diags.HasError() {
+			return nil, "", diags
+		}
+		cfg.Credentials = provider
+	}
+
 	logger.Debug(ctx, "Retrieving credentials")
-	creds, err := cfg.Credentials.Retrieve(ctx)
+	var creds aws.Credentials
+	err = runPhase(ctx, phaseCredentialsRetrieval, c.Timeouts.CredentialsRetrieval, func(ctx context.Context) (err error) {
+		creds, err = cfg.Credentials.Retrieve(ctx)
+		return err
+	})
+	if e, ok := asPhaseTimeoutError(err); ok {
+		return nil, "", diags.Append(e)
+	}
 	if err != nil {
 		if c.Profile != "" && os.Getenv("AWS_ACCESS_KEY_ID") != "" && os.Getenv("AWS_SECRET_ACCESS_KEY") != "" {
 			err = fmt.Errorf(`A Profile was specified along with the environment variables "AWS_ACCESS_KEY_ID" and "AWS_SECRET_ACCESS_KEY". The Profile is now used instead of the environment variable credentials.
@@ -107,7 +118,7 @@ AWS Error: %w`, err)
 	logger.Info(ctx, "Retrieved initial credentials", map[string]any{
 		"tf_aws.credentials_source": creds.Source,
 	})
-	provider, d := assumeRoleCredentialsProvider(ctx, cfg, c)
+	provider, _, d := assumeRoleCredentialsProvider(ctx, cfg, c)
 	diags = diags.Append(d...)
 	if diags.HasError() {
 		return nil, "", diags
@@ -133,41 +144,38 @@ func webIdentityCredentialsProvider(ctx context.Context, awsConfig aws.Config, c
 	awsConfig.Credentials = nil
 	client := stsClient(ctx, awsConfig, c)
 
-	appCreds := stscreds.NewWebIdentityRoleProvider(client, ar.RoleARN, ar, func(opts *stscreds.WebIdentityRoleOptions) {
-		opts.RoleSessionName = ar.SessionName
-		opts.Duration = ar.Duration
-
-		if ar.Policy != "" {
-			opts.Policy = aws.String(ar.Policy)
-		}
+	appCreds := webIdentityRoleProvider{
+		client:     client,
+		httpClient: awsConfig.HTTPClient,
+		ar:         ar,
+	}
 
-		if len(ar.PolicyARNs) > 0 {
-			opts.PolicyARNs = getPolicyDescriptorTypes(ar.PolicyARNs)
-		}
+	err := runPhase(ctx, phaseAssumeRoleWithWebIdentity, c.Timeouts.AssumeRole, func(ctx context.Context) error {
+		_, err := appCreds.Retrieve(ctx)
+		return err
 	})
-
-	if _, err := appCreds.Retrieve(ctx); err != nil {
+	if e, ok := asPhaseTimeoutError(err); ok {
+		return nil, diags.Append(e)
+	}
+	if err != nil {
 		return nil, diags.Append(c.NewCannotAssumeRoleWithWebIdentityError(err))
 	}
 	return aws.NewCredentialsCache(appCreds), diags
 }
 
-func assumeRoleCredentialsProvider(ctx context.Context, awsConfig aws.Config, c *Config) (aws.CredentialsProvider, diag.Diagnostics) {
+// assumeRoleCredentialsProvider returns a credentials provider for the last role in the chain
+// and the credentials retrieved when assuming it.
+func assumeRoleCredentialsProvider(ctx context.Context, awsConfig aws.Config, c *Config) (aws.CredentialsProvider, aws.Credentials, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	logger := logging.RetrieveLogger(ctx)
 
-	var creds aws.CredentialsProvider
+	var (
+		creds   aws.CredentialsProvider
+		assumed aws.Credentials
+	)
 
-	total := len(c.AssumeRole)
 	for i, ar := range c.AssumeRole {
-		if ar.RoleARN == "" {
-			return nil, diags.AddError(
-				"Cannot assume IAM Role",
-				fmt.Sprintf("IAM Role ARN not set in assume role %d of %d", i+1, total),
-			)
-		}
-
 		logger.Info(ctx, "Assuming IAM Role", map[string]any{
 			"tf_aws.assume_role.index":           i,
 			"tf_aws.assume_role.role_arn":        ar.RoleARN,
@@ -216,14 +224,20 @@ func assumeRoleCredentialsProvider(ctx context.Context, awsConfig aws.Config, c
 				opts.SourceIdentity = aws.String(ar.SourceIdentity)
 			}
 		})
-		_, err := appCreds.Retrieve(ctx)
+		err := runPhase(ctx, phaseAssumeRole(i, len(c.AssumeRole)), c.Timeouts.AssumeRole, func(ctx context.Context) (err error) {
+			assumed, err = appCreds.Retrieve(ctx)
+			return err
+		})
+		if e, ok := asPhaseTimeoutError(err); ok {
+			return nil, aws.Credentials{}, diags.Append(e)
+		}
 		if err != nil {
-			return nil, diags.Append(newCannotAssumeRoleError(ar, err))
+			return nil, aws.Credentials{}, diags.Append(newCannotAssumeRoleError(ar, err))
 		}
 		creds = aws.NewCredentialsCache(appCreds)
 		awsConfig.Credentials = creds
 	}
-	return creds, nil
+	return creds, assumed, nil
 }
 
 func getPolicyDescriptorTypes(policyARNs []string) []types.PolicyDescriptorType {
@@ -237,3 +251,30 @@ func getPolicyDescriptorTypes(policyARNs []string) []types.PolicyDescriptorType
 	}
 	return policyDescriptorTypes
 }
+
+// webIdentityRoleProvider assumes an IAM Role With Web Identity, getting the web identity token
+// with the context of each credentials retrieval and the configured HTTP client.
+type webIdentityRoleProvider struct {
+	client     stscreds.AssumeRoleWithWebIdentityAPIClient
+	httpClient aws.HTTPClient
+	ar         *AssumeRoleWithWebIdentity
+}
+
+func (p webIdentityRoleProvider) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	ar := p.ar
+
+	provider := stscreds.NewWebIdentityRoleProvider(p.client, ar.RoleARN, ar.IdentityTokenRetriever(ctx, p.httpClient), func(opts *stscreds.WebIdentityRoleOptions) {
+		opts.RoleSessionName = ar.SessionName
+		opts.Duration = ar.Duration
+
+		if ar.Policy != "" {
+			opts.Policy = aws.String(ar.Policy)
+		}
+
+		if len(ar.PolicyARNs) > 0 {
+			opts.PolicyARNs = getPolicyDescriptorTypes(ar.PolicyARNs)
+		}
+	})
+
+	return provider.Retrieve(ctx)
+}
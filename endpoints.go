@@ -4,12 +4,20 @@
 package awsbase
 
 import (
+	"cmp"
 	"context"
+	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/sso"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/diag"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	internalconfig "github.com/hashicorp/aws-sdk-go-base/v2/internal/config"
 	"github.com/hashicorp/aws-sdk-go-base/v2/logging"
 )
 
@@ -20,9 +28,11 @@ func credentialsEndpointResolver(ctx context.Context, c *Config) aws.EndpointRes
 	logger := logging.RetrieveLogger(ctx)
 
 	resolver := func(service, region string, options ...any) (aws.Endpoint, error) {
+		serviceEndpoint, _ := c.ServiceEndpoint(service)
+
 		switch service {
 		case iam.ServiceID:
-			if endpoint := c.IamEndpoint; endpoint != "" {
+			if endpoint := cmp.Or(c.IamEndpoint, serviceEndpoint); endpoint != "" {
 				logger.Info(ctx, "Credentials resolution: setting custom IAM endpoint", map[string]any{
 					"tf_aws.iam_client.endpoint": endpoint,
 				})
@@ -33,7 +43,7 @@ func credentialsEndpointResolver(ctx context.Context, c *Config) aws.EndpointRes
 				}, nil
 			}
 		case sso.ServiceID:
-			if endpoint := c.SsoEndpoint; endpoint != "" {
+			if endpoint := cmp.Or(c.SsoEndpoint, serviceEndpoint); endpoint != "" {
 				logger.Info(ctx, "Credentials resolution: setting custom SSO endpoint", map[string]any{
 					"tf_aws.sso_client.endpoint": endpoint,
 				})
@@ -44,7 +54,7 @@ func credentialsEndpointResolver(ctx context.Context, c *Config) aws.EndpointRes
 				}, nil
 			}
 		case sts.ServiceID:
-			if endpoint := c.StsEndpoint; endpoint != "" {
+			if endpoint := cmp.Or(c.StsEndpoint, serviceEndpoint); endpoint != "" {
 				fields := map[string]any{
 					"tf_aws.sts_client.endpoint": endpoint,
 				}
@@ -59,6 +69,18 @@ func credentialsEndpointResolver(ctx context.Context, c *Config) aws.EndpointRes
 					SigningRegion: region,
 				}, nil
 			}
+		default:
+			if endpoint := serviceEndpoint; endpoint != "" {
+				logger.Info(ctx, "Credentials resolution: setting custom service endpoint", map[string]any{
+					"tf_aws.service_endpoint.service":  service,
+					"tf_aws.service_endpoint.endpoint": endpoint,
+				})
+				return aws.Endpoint{
+					URL:           endpoint,
+					Source:        aws.EndpointSourceCustom,
+					SigningRegion: region,
+				}, nil
+			}
 		}
 
 		return aws.Endpoint{}, &aws.EndpointNotFoundError{}
@@ -66,3 +88,91 @@ func credentialsEndpointResolver(ctx context.Context, c *Config) aws.EndpointRes
 
 	return aws.EndpointResolverWithOptionsFunc(resolver)
 }
+
+// serviceEndpointsConfigSource is an `aws.Config` configuration source providing the endpoints set in `Config.Endpoints`.
+// It is added before the environment and shared config sources, so that these endpoints take precedence.
+type serviceEndpointsConfigSource struct {
+	endpoints map[string]string
+}
+
+// Implements the `ServiceBaseEndpointProvider` interface used by AWS SDK for Go v2 service clients
+func (s serviceEndpointsConfigSource) GetServiceBaseEndpoint(_ context.Context, sdkID string) (string, bool, error) {
+	v, ok := Config{Endpoints: s.endpoints}.ServiceEndpoint(sdkID)
+	return v, ok, nil
+}
+
+func withServiceEndpoints(ctx context.Context, c *Config, awsConfig *aws.Config) {
+	if len(c.Endpoints) == 0 {
+		return
+	}
+
+	logger := logging.RetrieveLogger(ctx)
+	logger.Debug(ctx, "Setting service endpoints", map[string]any{
+		"tf_aws.service_endpoints": slices.Sorted(maps.Keys(c.Endpoints)),
+	})
+
+	awsConfig.ConfigSources = append([]any{
+		serviceEndpointsConfigSource{
+			endpoints: maps.Clone(c.Endpoints),
+		},
+	}, awsConfig.ConfigSources...)
+}
+
+// validateSharedConfigServiceEndpoints validates the endpoints in the shared config `services` section used by the profile.
+func validateSharedConfigServiceEndpoints(configSources []any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, source := range configSources {
+		shared, ok := source.(config.SharedConfig)
+		if !ok {
+			continue
+		}
+		for _, serviceID := range slices.Sorted(maps.Keys(shared.Services.ServiceValues)) {
+			endpoint, ok := shared.Services.ServiceValues[serviceID]["endpoint_url"]
+			if !ok {
+				continue
+			}
+			internalconfig.ValidateServiceEndpointURL(&diags,
+				fmt.Sprintf("shared config services section %q, service %q", shared.ServicesSectionName, serviceID),
+				serviceID,
+				endpoint,
+			)
+		}
+	}
+
+	return diags
+}
+
+// validateEndpointVariants validates that the partition of the Region supports the FIPS and dual-stack endpoints configured.
+func validateEndpointVariants(c *Config, region string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !c.UseFIPSEndpoint && !c.UseDualStackEndpoint {
+		return diags
+	}
+
+	ps, err := c.Partitions()
+	if err != nil {
+		return diags.AddSimpleError(err)
+	}
+
+	partition, ok := endpoints.PartitionForRegion(ps, region)
+	if !ok {
+		return diags
+	}
+
+	if c.UseFIPSEndpoint && !partition.SupportsFIPS() {
+		diags = diags.AddError(
+			"Unsupported FIPS Endpoints",
+			fmt.Sprintf("FIPS endpoints are enabled, but the partition %q of Region %q does not support FIPS endpoints.", partition.ID(), region),
+		)
+	}
+	if c.UseDualStackEndpoint && !partition.SupportsDualStack() {
+		diags = diags.AddError(
+			"Unsupported Dual-Stack Endpoints",
+			fmt.Sprintf("Dual-stack endpoints are enabled, but the partition %q of Region %q does not support dual-stack endpoints.", partition.ID(), region),
+		)
+	}
+
+	return diags
+}
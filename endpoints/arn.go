@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+// ARN returns an ARN for a resource in the partition.
+// The Region and account ID may be empty for resources, such as Amazon S3 buckets, whose ARNs do not include them.
+func (p Partition) ARN(service, regionID, accountID, resource string) string {
+	return arn.ARN{
+		Partition: p.id,
+		Service:   service,
+		Region:    regionID,
+		AccountID: accountID,
+		Resource:  resource,
+	}.String()
+}
+
+// IAMRoleARN returns the ARN of an IAM role in the partition.
+// The path may be empty.
+func (p Partition) IAMRoleARN(accountID, path, roleName string) string {
+	return p.ARN("iam", "", accountID, "role"+iamPath(path)+roleName)
+}
+
+// IAMPolicyARN returns the ARN of an IAM policy in the partition.
+// Use the account ID "aws" for AWS managed policies. The path may be empty.
+func (p Partition) IAMPolicyARN(accountID, path, policyName string) string {
+	return p.ARN("iam", "", accountID, "policy"+iamPath(path)+policyName)
+}
+
+// iamPath returns an IAM path with leading and trailing slashes.
+func iamPath(path string) string {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return "/"
+	}
+	return "/" + path + "/"
+}
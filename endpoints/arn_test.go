@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+)
+
+func TestPartitionARN(t *testing.T) {
+	t.Parallel()
+
+	partitions := make(map[string]endpoints.Partition)
+	for _, p := range endpoints.DefaultPartitions() {
+		partitions[p.ID()] = p
+	}
+
+	testcases := map[string]struct {
+		got      string
+		expected string
+	}{
+		"ARN": {
+			got:      partitions[endpoints.AwsPartitionID].ARN("ec2", endpoints.UsWest2RegionID, "123456789012", "instance/i-12345678"),
+			expected: "arn:aws:ec2:us-west-2:123456789012:instance/i-12345678",
+		},
+		"ARN without Region and account ID": {
+			got:      partitions[endpoints.AwsCnPartitionID].ARN("s3", "", "", "bucket"),
+			expected: "arn:aws-cn:s3:::bucket",
+		},
+		"IAM role": {
+			got:      partitions[endpoints.AwsUsGovPartitionID].IAMRoleARN("123456789012", "", "Role"),
+			expected: "arn:aws-us-gov:iam::123456789012:role/Role",
+		},
+		"IAM role with path": {
+			got:      partitions[endpoints.AwsPartitionID].IAMRoleARN("123456789012", "/path/to", "Role"),
+			expected: "arn:aws:iam::123456789012:role/path/to/Role",
+		},
+		"AWS managed IAM policy": {
+			got:      partitions[endpoints.AwsPartitionID].IAMPolicyARN("aws", "service-role/", "AWSLambdaBasicExecutionRole"),
+			expected: "arn:aws:iam::aws:policy/service-role/AWSLambdaBasicExecutionRole",
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if testcase.got != testcase.expected {
+				t.Errorf("expected %q, got %q", testcase.expected, testcase.got)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints
+
+import (
+	"strings"
+)
+
+// EndpointVariant is a set of endpoint variant flags.
+// The zero value selects the default endpoint.
+type EndpointVariant uint8
+
+const (
+	// FIPSVariant selects an endpoint that uses FIPS 140-3 validated cryptographic modules.
+	FIPSVariant EndpointVariant = 1 << iota
+
+	// DualStackVariant selects an endpoint that supports both IPv4 and IPv6.
+	DualStackVariant
+)
+
+// String returns a string representation of the variant, such as "fips|dualstack".
+func (v EndpointVariant) String() string {
+	if v == 0 {
+		return "default"
+	}
+
+	var parts []string
+	if v&FIPSVariant != 0 {
+		parts = append(parts, "fips")
+	}
+	if v&DualStackVariant != 0 {
+		parts = append(parts, "dualstack")
+	}
+	return strings.Join(parts, "|")
+}
+
+// ResolvedEndpoint is a service endpoint resolved for a Region and variant.
+type ResolvedEndpoint struct {
+	// URL is the endpoint's URL. Endpoints always use HTTPS.
+	URL string
+
+	// Hostname is the endpoint's host name.
+	Hostname string
+
+	// SigningRegion is the Region to use when signing requests to the endpoint.
+	SigningRegion string
+
+	// SigningName is the service name to use when signing requests to the endpoint.
+	SigningName string
+}
+
+// endpoint holds the modeled attributes of an endpoint, or the defaults for a service or partition.
+// Unset attributes are inherited from the service defaults, then from the partition defaults.
+type endpoint struct {
+	hostname        string
+	dnsSuffix       string
+	credentialScope credentialScope
+	variants        []endpointVariant
+	deprecated      bool
+}
+
+func (e endpoint) variant(v EndpointVariant) (endpointVariant, bool) {
+	for _, ev := range e.variants {
+		if ev.variant == v {
+			return ev, true
+		}
+	}
+	return endpointVariant{}, false
+}
+
+type credentialScope struct {
+	region  string
+	service string
+}
+
+type endpointVariant struct {
+	variant   EndpointVariant
+	hostname  string
+	dnsSuffix string
+}
+
+// expandHostname expands the "{service}", "{region}", and "{dnsSuffix}" placeholders in a host name template.
+func expandHostname(template, serviceID, regionID, dnsSuffix string) string {
+	return strings.NewReplacer(
+		"{service}", serviceID,
+		"{region}", regionID,
+		"{dnsSuffix}", dnsSuffix,
+	).Replace(template)
+}
@@ -79,13 +79,742 @@ const (
 	UsGovWest1RegionID = "us-gov-west-1" // AWS GovCloud (US-West)
 )
 
+// All known service IDs.
+const (
+	AccessAnalyzerServiceID                   = "access-analyzer"
+	AccountServiceID                          = "account"
+	AcmServiceID                              = "acm"
+	AcmPcaServiceID                           = "acm-pca"
+	AgreementMarketplaceServiceID             = "agreement-marketplace"
+	AirflowServiceID                          = "airflow"
+	AmplifyServiceID                          = "amplify"
+	AmplifybackendServiceID                   = "amplifybackend"
+	AmplifyuibuilderServiceID                 = "amplifyuibuilder"
+	AossServiceID                             = "aoss"
+	ApiDetectiveServiceID                     = "api.detective"
+	ApiEcrServiceID                           = "api.ecr"
+	ApiEcrPublicServiceID                     = "api.ecr-public"
+	ApiIotdeviceadvisorServiceID              = "api.iotdeviceadvisor"
+	ApiIotwirelessServiceID                   = "api.iotwireless"
+	ApiMediatailorServiceID                   = "api.mediatailor"
+	ApiPricingServiceID                       = "api.pricing"
+	ApiSagemakerServiceID                     = "api.sagemaker"
+	ApiTunnelingIotServiceID                  = "api.tunneling.iot"
+	ApigatewayServiceID                       = "apigateway"
+	AppIntegrationsServiceID                  = "app-integrations"
+	AppconfigServiceID                        = "appconfig"
+	AppconfigdataServiceID                    = "appconfigdata"
+	AppflowServiceID                          = "appflow"
+	ApplicationAutoscalingServiceID           = "application-autoscaling"
+	ApplicationinsightsServiceID              = "applicationinsights"
+	AppmeshServiceID                          = "appmesh"
+	ApprunnerServiceID                        = "apprunner"
+	Appstream2ServiceID                       = "appstream2"
+	AppsyncServiceID                          = "appsync"
+	ApsServiceID                              = "aps"
+	ArcZonalShiftServiceID                    = "arc-zonal-shift"
+	AthenaServiceID                           = "athena"
+	AuditmanagerServiceID                     = "auditmanager"
+	AutoscalingServiceID                      = "autoscaling"
+	AutoscalingPlansServiceID                 = "autoscaling-plans"
+	BackupServiceID                           = "backup"
+	BackupGatewayServiceID                    = "backup-gateway"
+	BatchServiceID                            = "batch"
+	BedrockServiceID                          = "bedrock"
+	BillingconductorServiceID                 = "billingconductor"
+	BraketServiceID                           = "braket"
+	BudgetsServiceID                          = "budgets"
+	CasesServiceID                            = "cases"
+	CassandraServiceID                        = "cassandra"
+	CatalogMarketplaceServiceID               = "catalog.marketplace"
+	CeServiceID                               = "ce"
+	ChimeServiceID                            = "chime"
+	CleanroomsServiceID                       = "cleanrooms"
+	Cloud9ServiceID                           = "cloud9"
+	CloudcontrolapiServiceID                  = "cloudcontrolapi"
+	ClouddirectoryServiceID                   = "clouddirectory"
+	CloudformationServiceID                   = "cloudformation"
+	CloudfrontServiceID                       = "cloudfront"
+	CloudhsmServiceID                         = "cloudhsm"
+	Cloudhsmv2ServiceID                       = "cloudhsmv2"
+	CloudsearchServiceID                      = "cloudsearch"
+	CloudtrailServiceID                       = "cloudtrail"
+	CloudtrailDataServiceID                   = "cloudtrail-data"
+	CodeartifactServiceID                     = "codeartifact"
+	CodebuildServiceID                        = "codebuild"
+	CodecatalystServiceID                     = "codecatalyst"
+	CodecommitServiceID                       = "codecommit"
+	CodedeployServiceID                       = "codedeploy"
+	CodeguruProfilerServiceID                 = "codeguru-profiler"
+	CodeguruReviewerServiceID                 = "codeguru-reviewer"
+	CodepipelineServiceID                     = "codepipeline"
+	CodestarConnectionsServiceID              = "codestar-connections"
+	CodestarNotificationsServiceID            = "codestar-notifications"
+	CognitoIdentityServiceID                  = "cognito-identity"
+	CognitoIdpServiceID                       = "cognito-idp"
+	CognitoSyncServiceID                      = "cognito-sync"
+	ComprehendServiceID                       = "comprehend"
+	ComprehendmedicalServiceID                = "comprehendmedical"
+	ComputeOptimizerServiceID                 = "compute-optimizer"
+	ConfigServiceID                           = "config"
+	ConnectServiceID                          = "connect"
+	ConnectCampaignsServiceID                 = "connect-campaigns"
+	ContactLensServiceID                      = "contact-lens"
+	ControltowerServiceID                     = "controltower"
+	CostOptimizationHubServiceID              = "cost-optimization-hub"
+	CurServiceID                              = "cur"
+	DataAtsIotServiceID                       = "data-ats.iot"
+	DataIotServiceID                          = "data.iot"
+	DataJobsIotServiceID                      = "data.jobs.iot"
+	DataMediastoreServiceID                   = "data.mediastore"
+	DatabrewServiceID                         = "databrew"
+	DataexchangeServiceID                     = "dataexchange"
+	DatapipelineServiceID                     = "datapipeline"
+	DatasyncServiceID                         = "datasync"
+	DatazoneServiceID                         = "datazone"
+	DaxServiceID                              = "dax"
+	DevicefarmServiceID                       = "devicefarm"
+	DevopsGuruServiceID                       = "devops-guru"
+	DirectconnectServiceID                    = "directconnect"
+	DiscoveryServiceID                        = "discovery"
+	DlmServiceID                              = "dlm"
+	DmsServiceID                              = "dms"
+	DocdbServiceID                            = "docdb"
+	DrsServiceID                              = "drs"
+	DsServiceID                               = "ds"
+	DynamodbServiceID                         = "dynamodb"
+	EbsServiceID                              = "ebs"
+	Ec2ServiceID                              = "ec2"
+	EcsServiceID                              = "ecs"
+	EdgeSagemakerServiceID                    = "edge.sagemaker"
+	EksServiceID                              = "eks"
+	EksAuthServiceID                          = "eks-auth"
+	ElasticacheServiceID                      = "elasticache"
+	ElasticbeanstalkServiceID                 = "elasticbeanstalk"
+	ElasticfilesystemServiceID                = "elasticfilesystem"
+	ElasticloadbalancingServiceID             = "elasticloadbalancing"
+	ElasticmapreduceServiceID                 = "elasticmapreduce"
+	EmailServiceID                            = "email"
+	EmrContainersServiceID                    = "emr-containers"
+	EmrServerlessServiceID                    = "emr-serverless"
+	EntitlementMarketplaceServiceID           = "entitlement.marketplace"
+	EsServiceID                               = "es"
+	EventsServiceID                           = "events"
+	FinspaceServiceID                         = "finspace"
+	FinspaceApiServiceID                      = "finspace-api"
+	FirehoseServiceID                         = "firehose"
+	FmsServiceID                              = "fms"
+	ForecastServiceID                         = "forecast"
+	ForecastqueryServiceID                    = "forecastquery"
+	FrauddetectorServiceID                    = "frauddetector"
+	FsxServiceID                              = "fsx"
+	GameliftServiceID                         = "gamelift"
+	GameliftstreamsServiceID                  = "gameliftstreams"
+	GeoServiceID                              = "geo"
+	GlacierServiceID                          = "glacier"
+	GlobalacceleratorServiceID                = "globalaccelerator"
+	GlueServiceID                             = "glue"
+	GrafanaServiceID                          = "grafana"
+	GreengrassServiceID                       = "greengrass"
+	GroundstationServiceID                    = "groundstation"
+	GuarddutyServiceID                        = "guardduty"
+	HealthServiceID                           = "health"
+	HealthlakeServiceID                       = "healthlake"
+	IamServiceID                              = "iam"
+	IdentityChimeServiceID                    = "identity-chime"
+	IdentitystoreServiceID                    = "identitystore"
+	ImportexportServiceID                     = "importexport"
+	IngestTimestreamServiceID                 = "ingest.timestream"
+	InspectorServiceID                        = "inspector"
+	Inspector2ServiceID                       = "inspector2"
+	InternetmonitorServiceID                  = "internetmonitor"
+	IotServiceID                              = "iot"
+	IoteventsServiceID                        = "iotevents"
+	IoteventsdataServiceID                    = "ioteventsdata"
+	IotfleetwiseServiceID                     = "iotfleetwise"
+	IotsecuredtunnelingServiceID              = "iotsecuredtunneling"
+	IotsitewiseServiceID                      = "iotsitewise"
+	IotthingsgraphServiceID                   = "iotthingsgraph"
+	IottwinmakerServiceID                     = "iottwinmaker"
+	IotwirelessServiceID                      = "iotwireless"
+	IvsServiceID                              = "ivs"
+	IvschatServiceID                          = "ivschat"
+	IvsrealtimeServiceID                      = "ivsrealtime"
+	KafkaServiceID                            = "kafka"
+	KafkaconnectServiceID                     = "kafkaconnect"
+	KendraServiceID                           = "kendra"
+	KendraRankingServiceID                    = "kendra-ranking"
+	KinesisServiceID                          = "kinesis"
+	KinesisanalyticsServiceID                 = "kinesisanalytics"
+	KinesisvideoServiceID                     = "kinesisvideo"
+	KmsServiceID                              = "kms"
+	LakeformationServiceID                    = "lakeformation"
+	LambdaServiceID                           = "lambda"
+	LicenseManagerServiceID                   = "license-manager"
+	LicenseManagerLinuxSubscriptionsServiceID = "license-manager-linux-subscriptions"
+	LicenseManagerUserSubscriptionsServiceID  = "license-manager-user-subscriptions"
+	LightsailServiceID                        = "lightsail"
+	LogsServiceID                             = "logs"
+	LookoutequipmentServiceID                 = "lookoutequipment"
+	M2ServiceID                               = "m2"
+	MachinelearningServiceID                  = "machinelearning"
+	Macie2ServiceID                           = "macie2"
+	ManagedblockchainServiceID                = "managedblockchain"
+	ManagedblockchainQueryServiceID           = "managedblockchain-query"
+	MarketplacecommerceanalyticsServiceID     = "marketplacecommerceanalytics"
+	MediaPipelinesChimeServiceID              = "media-pipelines-chime"
+	MediaconnectServiceID                     = "mediaconnect"
+	MediaconvertServiceID                     = "mediaconvert"
+	MedialiveServiceID                        = "medialive"
+	MediapackageServiceID                     = "mediapackage"
+	MediapackageVodServiceID                  = "mediapackage-vod"
+	Mediapackagev2ServiceID                   = "mediapackagev2"
+	MediastoreServiceID                       = "mediastore"
+	MeetingsChimeServiceID                    = "meetings-chime"
+	MemoryDbServiceID                         = "memory-db"
+	MessagingChimeServiceID                   = "messaging-chime"
+	MeteringMarketplaceServiceID              = "metering.marketplace"
+	MetricsSagemakerServiceID                 = "metrics.sagemaker"
+	MghServiceID                              = "mgh"
+	MgnServiceID                              = "mgn"
+	MigrationhubOrchestratorServiceID         = "migrationhub-orchestrator"
+	MigrationhubStrategyServiceID             = "migrationhub-strategy"
+	MobileanalyticsServiceID                  = "mobileanalytics"
+	ModelsV2LexServiceID                      = "models-v2-lex"
+	ModelsLexServiceID                        = "models.lex"
+	MonitoringServiceID                       = "monitoring"
+	MqServiceID                               = "mq"
+	MturkRequesterServiceID                   = "mturk-requester"
+	NeptuneServiceID                          = "neptune"
+	NetworkFirewallServiceID                  = "network-firewall"
+	NetworkmanagerServiceID                   = "networkmanager"
+	NotificationsServiceID                    = "notifications"
+	NotificationsContactsServiceID            = "notifications-contacts"
+	NovaActServiceID                          = "nova-act"
+	OamServiceID                              = "oam"
+	OidcServiceID                             = "oidc"
+	OmicsServiceID                            = "omics"
+	OrganizationsServiceID                    = "organizations"
+	OsisServiceID                             = "osis"
+	OutpostsServiceID                         = "outposts"
+	ParticipantConnectServiceID               = "participant.connect"
+	PartnercentralChannelServiceID            = "partnercentral-channel"
+	PersonalizeServiceID                      = "personalize"
+	PiServiceID                               = "pi"
+	PinpointServiceID                         = "pinpoint"
+	PipesServiceID                            = "pipes"
+	PollyServiceID                            = "polly"
+	PortalSsoServiceID                        = "portal.sso"
+	ProfileServiceID                          = "profile"
+	ProtonServiceID                           = "proton"
+	QbusinessServiceID                        = "qbusiness"
+	QueryTimestreamServiceID                  = "query.timestream"
+	QuicksightServiceID                       = "quicksight"
+	RamServiceID                              = "ram"
+	RbinServiceID                             = "rbin"
+	RdsServiceID                              = "rds"
+	RdsDataServiceID                          = "rds-data"
+	RedshiftServiceID                         = "redshift"
+	RedshiftServerlessServiceID               = "redshift-serverless"
+	RekognitionServiceID                      = "rekognition"
+	ResiliencehubServiceID                    = "resiliencehub"
+	ResourceExplorer2ServiceID                = "resource-explorer-2"
+	ResourceGroupsServiceID                   = "resource-groups"
+	RolesanywhereServiceID                    = "rolesanywhere"
+	Route53ServiceID                          = "route53"
+	Route53RecoveryControlConfigServiceID     = "route53-recovery-control-config"
+	Route53domainsServiceID                   = "route53domains"
+	Route53profilesServiceID                  = "route53profiles"
+	Route53resolverServiceID                  = "route53resolver"
+	RumServiceID                              = "rum"
+	RuntimeV2LexServiceID                     = "runtime-v2-lex"
+	RuntimeLexServiceID                       = "runtime.lex"
+	RuntimeSagemakerServiceID                 = "runtime.sagemaker"
+	S3ServiceID                               = "s3"
+	S3ControlServiceID                        = "s3-control"
+	S3OutpostsServiceID                       = "s3-outposts"
+	SagemakerGeospatialServiceID              = "sagemaker-geospatial"
+	SavingsplansServiceID                     = "savingsplans"
+	SchedulerServiceID                        = "scheduler"
+	SchemasServiceID                          = "schemas"
+	SdbServiceID                              = "sdb"
+	SecretsmanagerServiceID                   = "secretsmanager"
+	SecurityhubServiceID                      = "securityhub"
+	SecuritylakeServiceID                     = "securitylake"
+	ServerlessrepoServiceID                   = "serverlessrepo"
+	ServicecatalogServiceID                   = "servicecatalog"
+	ServicecatalogAppregistryServiceID        = "servicecatalog-appregistry"
+	ServicediscoveryServiceID                 = "servicediscovery"
+	ServicequotasServiceID                    = "servicequotas"
+	ShieldServiceID                           = "shield"
+	SignerServiceID                           = "signer"
+	SimspaceweaverServiceID                   = "simspaceweaver"
+	SmsVoiceServiceID                         = "sms-voice"
+	SnowballServiceID                         = "snowball"
+	SnsServiceID                              = "sns"
+	SqsServiceID                              = "sqs"
+	SsmServiceID                              = "ssm"
+	SsmContactsServiceID                      = "ssm-contacts"
+	SsmIncidentsServiceID                     = "ssm-incidents"
+	SsmQuicksetupServiceID                    = "ssm-quicksetup"
+	SsmSapServiceID                           = "ssm-sap"
+	SsoServiceID                              = "sso"
+	StatesServiceID                           = "states"
+	StoragegatewayServiceID                   = "storagegateway"
+	StreamsDynamodbServiceID                  = "streams.dynamodb"
+	StsServiceID                              = "sts"
+	SupportServiceID                          = "support"
+	SupportappServiceID                       = "supportapp"
+	SwfServiceID                              = "swf"
+	SyntheticsServiceID                       = "synthetics"
+	TaggingServiceID                          = "tagging"
+	TaxServiceID                              = "tax"
+	TextractServiceID                         = "textract"
+	ThinclientServiceID                       = "thinclient"
+	TnbServiceID                              = "tnb"
+	TranscribeServiceID                       = "transcribe"
+	TranscribestreamingServiceID              = "transcribestreaming"
+	TransferServiceID                         = "transfer"
+	TranslateServiceID                        = "translate"
+	TrustedadvisorServiceID                   = "trustedadvisor"
+	VerifiedpermissionsServiceID              = "verifiedpermissions"
+	VoiceChimeServiceID                       = "voice-chime"
+	VoiceidServiceID                          = "voiceid"
+	VpcLatticeServiceID                       = "vpc-lattice"
+	WafServiceID                              = "waf"
+	WafRegionalServiceID                      = "waf-regional"
+	Wafv2ServiceID                            = "wafv2"
+	WellarchitectedServiceID                  = "wellarchitected"
+	WisdomServiceID                           = "wisdom"
+	WorkdocsServiceID                         = "workdocs"
+	WorkmailServiceID                         = "workmail"
+	WorkspacesServiceID                       = "workspaces"
+	WorkspacesWebServiceID                    = "workspaces-web"
+	XrayServiceID                             = "xray"
+)
+
+// All known AWS SDK for Go v2 service IDs, such as `sts.ServiceID`.
+var sdkServiceIDs = []string{
+	"AccessAnalyzer",
+	"Account",
+	"ACM",
+	"ACM PCA",
+	"amp",
+	"Amplify",
+	"AmplifyBackend",
+	"AmplifyUIBuilder",
+	"API Gateway",
+	"ApiGatewayManagementApi",
+	"ApiGatewayV2",
+	"App Mesh",
+	"AppConfig",
+	"AppConfigData",
+	"AppFabric",
+	"Appflow",
+	"AppIntegrations",
+	"Application Auto Scaling",
+	"Application Discovery Service",
+	"Application Insights",
+	"Application Signals",
+	"ApplicationCostProfiler",
+	"AppRunner",
+	"AppStream",
+	"AppSync",
+	"AppTest",
+	"ARC Zonal Shift",
+	"Artifact",
+	"Athena",
+	"AuditManager",
+	"Auto Scaling",
+	"Auto Scaling Plans",
+	"b2bi",
+	"Backup",
+	"Backup Gateway",
+	"Batch",
+	"BCM Data Exports",
+	"Bedrock",
+	"Bedrock Agent",
+	"Bedrock Agent Runtime",
+	"Bedrock Runtime",
+	"billingconductor",
+	"Braket",
+	"Budgets",
+	"chatbot",
+	"Chime",
+	"Chime SDK Identity",
+	"Chime SDK Media Pipelines",
+	"Chime SDK Meetings",
+	"Chime SDK Messaging",
+	"Chime SDK Voice",
+	"CleanRooms",
+	"CleanRoomsML",
+	"Cloud9",
+	"CloudControl",
+	"CloudDirectory",
+	"CloudFormation",
+	"CloudFront",
+	"CloudHSM",
+	"CloudHSM V2",
+	"CloudSearch",
+	"CloudSearch Domain",
+	"CloudTrail",
+	"CloudTrail Data",
+	"CloudWatch",
+	"CloudWatch Events",
+	"CloudWatch Logs",
+	"codeartifact",
+	"CodeBuild",
+	"CodeCommit",
+	"CodeConnections",
+	"CodeDeploy",
+	"CodeGuru Reviewer",
+	"CodeGuru Security",
+	"CodeGuruProfiler",
+	"CodePipeline",
+	"CodeStar",
+	"CodeStar connections",
+	"codestar notifications",
+	"Cognito Identity",
+	"Cognito Identity Provider",
+	"Cognito Sync",
+	"Comprehend",
+	"ComprehendMedical",
+	"Compute Optimizer",
+	"Config Service",
+	"Connect",
+	"Connect Contact Lens",
+	"ConnectCampaigns",
+	"ConnectCases",
+	"ConnectParticipant",
+	"ControlCatalog",
+	"ControlTower",
+	"Cost and Usage Report Service",
+	"Cost Explorer",
+	"Cost Optimization Hub",
+	"Customer Profiles",
+	"Data Pipeline",
+	"Database Migration Service",
+	"DataBrew",
+	"DataExchange",
+	"DataSync",
+	"DataZone",
+	"DAX",
+	"deadline",
+	"Detective",
+	"Device Farm",
+	"DevOps Guru",
+	"Direct Connect",
+	"Directory Service",
+	"DLM",
+	"DocDB",
+	"DocDB Elastic",
+	"drs",
+	"DynamoDB",
+	"DynamoDB Streams",
+	"EBS",
+	"EC2",
+	"EC2 Instance Connect",
+	"ECR",
+	"ECR PUBLIC",
+	"ECS",
+	"EFS",
+	"EKS",
+	"EKS Auth",
+	"Elastic Beanstalk",
+	"Elastic Inference",
+	"Elastic Load Balancing",
+	"Elastic Load Balancing v2",
+	"Elastic Transcoder",
+	"ElastiCache",
+	"Elasticsearch Service",
+	"EMR",
+	"EMR containers",
+	"EMR Serverless",
+	"EntityResolution",
+	"EventBridge",
+	"Evidently",
+	"finspace",
+	"finspace data",
+	"Firehose",
+	"fis",
+	"FMS",
+	"forecast",
+	"forecastquery",
+	"FraudDetector",
+	"FreeTier",
+	"FSx",
+	"GameLift",
+	"Glacier",
+	"Global Accelerator",
+	"Glue",
+	"grafana",
+	"Greengrass",
+	"GreengrassV2",
+	"GroundStation",
+	"GuardDuty",
+	"Health",
+	"HealthLake",
+	"IAM",
+	"identitystore",
+	"imagebuilder",
+	"Import Export",
+	"Inspector",
+	"Inspector Scan",
+	"Inspector2",
+	"InternetMonitor",
+	"IoT",
+	"IoT 1Click Devices Service",
+	"IoT 1Click Projects",
+	"IoT Data Plane",
+	"IoT Events",
+	"IoT Events Data",
+	"IoT Jobs Data Plane",
+	"IoT Wireless",
+	"IoTAnalytics",
+	"IotDeviceAdvisor",
+	"IoTFleetHub",
+	"IoTFleetWise",
+	"IoTSecureTunneling",
+	"IoTSiteWise",
+	"IoTThingsGraph",
+	"IoTTwinMaker",
+	"ivs",
+	"IVS RealTime",
+	"ivschat",
+	"Kafka",
+	"KafkaConnect",
+	"kendra",
+	"Kendra Ranking",
+	"Keyspaces",
+	"Kinesis",
+	"Kinesis Analytics",
+	"Kinesis Analytics V2",
+	"Kinesis Video",
+	"Kinesis Video Archived Media",
+	"Kinesis Video Media",
+	"Kinesis Video Signaling",
+	"Kinesis Video WebRTC Storage",
+	"KMS",
+	"LakeFormation",
+	"Lambda",
+	"Launch Wizard",
+	"Lex Model Building Service",
+	"Lex Models V2",
+	"Lex Runtime Service",
+	"Lex Runtime V2",
+	"License Manager",
+	"License Manager Linux Subscriptions",
+	"License Manager User Subscriptions",
+	"Lightsail",
+	"Location",
+	"LookoutEquipment",
+	"LookoutMetrics",
+	"LookoutVision",
+	"m2",
+	"Machine Learning",
+	"Macie2",
+	"MailManager",
+	"ManagedBlockchain",
+	"ManagedBlockchain Query",
+	"Marketplace Agreement",
+	"Marketplace Catalog",
+	"Marketplace Commerce Analytics",
+	"Marketplace Deployment",
+	"Marketplace Entitlement Service",
+	"Marketplace Metering",
+	"MediaConnect",
+	"MediaConvert",
+	"MediaLive",
+	"MediaPackage",
+	"MediaPackage Vod",
+	"MediaPackageV2",
+	"MediaStore",
+	"MediaStore Data",
+	"MediaTailor",
+	"Medical Imaging",
+	"MemoryDB",
+	"mgn",
+	"Migration Hub",
+	"Migration Hub Refactor Spaces",
+	"MigrationHub Config",
+	"MigrationHubOrchestrator",
+	"MigrationHubStrategy",
+	"Mobile Analytics",
+	"mq",
+	"MTurk",
+	"MWAA",
+	"Neptune",
+	"neptunedata",
+	"Network Firewall",
+	"NetworkManager",
+	"NetworkMonitor",
+	"nimble",
+	"OAM",
+	"Omics",
+	"OpenSearch",
+	"OpenSearchServerless",
+	"OpsWorks",
+	"OpsWorksCM",
+	"Organizations",
+	"OSIS",
+	"Outposts",
+	"Panorama",
+	"Payment Cryptography",
+	"Payment Cryptography Data",
+	"Pca Connector Ad",
+	"Pca Connector Scep",
+	"Personalize",
+	"Personalize Events",
+	"Personalize Runtime",
+	"PI",
+	"Pinpoint",
+	"Pinpoint Email",
+	"Pinpoint SMS Voice",
+	"Pinpoint SMS Voice V2",
+	"Pipes",
+	"Polly",
+	"Pricing",
+	"PrivateNetworks",
+	"Proton",
+	"QApps",
+	"QBusiness",
+	"QConnect",
+	"QLDB",
+	"QLDB Session",
+	"QuickSight",
+	"RAM",
+	"rbin",
+	"RDS",
+	"RDS Data",
+	"Redshift",
+	"Redshift Data",
+	"Redshift Serverless",
+	"Rekognition",
+	"repostspace",
+	"resiliencehub",
+	"Resource Explorer 2",
+	"Resource Groups",
+	"Resource Groups Tagging API",
+	"RoboMaker",
+	"RolesAnywhere",
+	"Route 53",
+	"Route 53 Domains",
+	"Route53 Recovery Cluster",
+	"Route53 Recovery Control Config",
+	"Route53 Recovery Readiness",
+	"Route53Profiles",
+	"Route53Resolver",
+	"RUM",
+	"S3",
+	"S3 Control",
+	"S3Outposts",
+	"SageMaker",
+	"SageMaker A2I Runtime",
+	"Sagemaker Edge",
+	"SageMaker FeatureStore Runtime",
+	"SageMaker Geospatial",
+	"SageMaker Metrics",
+	"SageMaker Runtime",
+	"savingsplans",
+	"Scheduler",
+	"schemas",
+	"Secrets Manager",
+	"SecurityHub",
+	"SecurityLake",
+	"ServerlessApplicationRepository",
+	"Service Catalog",
+	"Service Catalog AppRegistry",
+	"Service Quotas",
+	"ServiceDiscovery",
+	"SES",
+	"SESv2",
+	"SFN",
+	"Shield",
+	"signer",
+	"Signin",
+	"SimpleDB",
+	"SimSpaceWeaver",
+	"SMS",
+	"Snow Device Management",
+	"Snowball",
+	"SNS",
+	"SQS",
+	"SSM",
+	"SSM Contacts",
+	"SSM Incidents",
+	"Ssm Sap",
+	"SSO",
+	"SSO Admin",
+	"SSO OIDC",
+	"Storage Gateway",
+	"STS",
+	"SupplyChain",
+	"Support",
+	"Support App",
+	"SWF",
+	"synthetics",
+	"TaxSettings",
+	"Textract",
+	"Timestream InfluxDB",
+	"Timestream Query",
+	"Timestream Write",
+	"tnb",
+	"Transcribe",
+	"Transcribe Streaming",
+	"Transfer",
+	"Translate",
+	"TrustedAdvisor",
+	"VerifiedPermissions",
+	"Voice ID",
+	"VPC Lattice",
+	"WAF",
+	"WAF Regional",
+	"WAFV2",
+	"WellArchitected",
+	"Wisdom",
+	"WorkDocs",
+	"WorkLink",
+	"WorkMail",
+	"WorkMailMessageFlow",
+	"WorkSpaces",
+	"WorkSpaces Thin Client",
+	"WorkSpaces Web",
+	"XRay",
+}
+
 var (
 	partitions = map[string]Partition{
 		AwsPartitionID: {
-			id:          AwsPartitionID,
-			name:        "AWS Standard",
-			dnsSuffix:   "amazonaws.com",
-			regionRegex: regexp.MustCompile(`^(us|eu|ap|sa|ca|me|af|il|mx)\-\w+\-\d+$`),
+			id:                     AwsPartitionID,
+			name:                   "AWS Standard",
+			dnsSuffix:              "amazonaws.com",
+			dualStackDNSSuffix:     "api.aws",
+			fipsDNSSuffix:          "amazonaws.com",
+			fipsDualStackDNSSuffix: "api.aws",
+			implicitGlobalRegion:   UsEast1RegionID,
+			supportsFIPS:           true,
+			supportsDualStack:      true,
+			regionRegex:            regexp.MustCompile(`^(us|eu|ap|sa|ca|me|af|il|mx)\-\w+\-\d+$`),
+			defaults: endpoint{
+				hostname: "{service}.{region}.{dnsSuffix}",
+				variants: []endpointVariant{
+					{
+						variant:   DualStackVariant,
+						hostname:  "{service}.{region}.{dnsSuffix}",
+						dnsSuffix: "api.aws",
+					},
+					{
+						variant:   DualStackVariant | FIPSVariant,
+						hostname:  "{service}-fips.{region}.{dnsSuffix}",
+						dnsSuffix: "api.aws",
+					},
+					{
+						variant:   FIPSVariant,
+						hostname:  "{service}-fips.{region}.{dnsSuffix}",
+						dnsSuffix: "amazonaws.com",
+					},
+				},
+			},
 			regions: map[string]Region{
 				AfSouth1RegionID: {
 					id:          AfSouth1RegionID,
@@ -225,3569 +954,23474 @@ var (
 				},
 			},
 			services: map[string]Service{
-				"access-analyzer": {
-					id: "access-analyzer",
-				},
-				"account": {
-					id: "account",
-				},
-				"acm": {
-					id: "acm",
-				},
-				"acm-pca": {
-					id: "acm-pca",
-				},
-				"agreement-marketplace": {
-					id: "agreement-marketplace",
-				},
-				"airflow": {
-					id: "airflow",
-				},
-				"amplify": {
-					id: "amplify",
-				},
-				"amplifybackend": {
-					id: "amplifybackend",
-				},
-				"amplifyuibuilder": {
-					id: "amplifyuibuilder",
-				},
-				"aoss": {
-					id: "aoss",
-				},
-				"api.detective": {
-					id: "api.detective",
-				},
-				"api.ecr": {
-					id: "api.ecr",
-				},
-				"api.ecr-public": {
-					id: "api.ecr-public",
-				},
-				"api.iotdeviceadvisor": {
-					id: "api.iotdeviceadvisor",
-				},
-				"api.iotwireless": {
-					id: "api.iotwireless",
-				},
-				"api.mediatailor": {
-					id: "api.mediatailor",
-				},
-				"api.pricing": {
-					id: "api.pricing",
-				},
-				"api.sagemaker": {
-					id: "api.sagemaker",
-				},
-				"api.tunneling.iot": {
-					id: "api.tunneling.iot",
-				},
-				"apigateway": {
-					id: "apigateway",
-				},
-				"app-integrations": {
-					id: "app-integrations",
-				},
-				"appconfig": {
-					id: "appconfig",
-				},
-				"appconfigdata": {
-					id: "appconfigdata",
-				},
-				"appflow": {
-					id: "appflow",
-				},
-				"application-autoscaling": {
-					id: "application-autoscaling",
-				},
-				"applicationinsights": {
-					id: "applicationinsights",
-				},
-				"appmesh": {
-					id: "appmesh",
-				},
-				"apprunner": {
-					id: "apprunner",
-				},
-				"appstream2": {
-					id: "appstream2",
-				},
-				"appsync": {
-					id: "appsync",
-				},
-				"aps": {
-					id: "aps",
-				},
-				"arc-zonal-shift": {
-					id: "arc-zonal-shift",
-				},
-				"athena": {
-					id: "athena",
-				},
-				"auditmanager": {
-					id: "auditmanager",
-				},
-				"autoscaling": {
-					id: "autoscaling",
-				},
-				"autoscaling-plans": {
-					id: "autoscaling-plans",
-				},
-				"backup": {
-					id: "backup",
-				},
-				"backup-gateway": {
-					id: "backup-gateway",
-				},
-				"batch": {
-					id: "batch",
-				},
-				"bedrock": {
-					id: "bedrock",
-				},
-				"billingconductor": {
-					id: "billingconductor",
-				},
-				"braket": {
-					id: "braket",
-				},
-				"budgets": {
-					id: "budgets",
-				},
-				"cases": {
-					id: "cases",
-				},
-				"cassandra": {
-					id: "cassandra",
-				},
-				"catalog.marketplace": {
-					id: "catalog.marketplace",
-				},
-				"ce": {
-					id: "ce",
-				},
-				"chime": {
-					id: "chime",
-				},
-				"cleanrooms": {
-					id: "cleanrooms",
-				},
-				"cloud9": {
-					id: "cloud9",
-				},
-				"cloudcontrolapi": {
-					id: "cloudcontrolapi",
-				},
-				"clouddirectory": {
-					id: "clouddirectory",
-				},
-				"cloudformation": {
-					id: "cloudformation",
-				},
-				"cloudfront": {
-					id: "cloudfront",
-				},
-				"cloudhsm": {
-					id: "cloudhsm",
-				},
-				"cloudhsmv2": {
-					id: "cloudhsmv2",
-				},
-				"cloudsearch": {
-					id: "cloudsearch",
-				},
-				"cloudtrail": {
-					id: "cloudtrail",
-				},
-				"cloudtrail-data": {
-					id: "cloudtrail-data",
+				AccessAnalyzerServiceID: {
+					id: AccessAnalyzerServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "access-analyzer-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-west-1": {
+							hostname: "access-analyzer-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "access-analyzer-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "access-analyzer-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "access-analyzer-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "access-analyzer-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				AccountServiceID: {
+					id:                AccountServiceID,
+					partitionEndpoint: AwsGlobalRegionID,
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						AwsGlobalRegionID: {
+							hostname: "account.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+						},
+					},
+				},
+				AcmServiceID: {
+					id: AcmServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"ca-central-1-fips": {
+							hostname: "acm-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"ca-west-1-fips": {
+							hostname: "acm-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-1-fips": {
+							hostname: "acm-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-2-fips": {
+							hostname: "acm-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-1-fips": {
+							hostname: "acm-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "acm-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				AcmPcaServiceID: {
+					id: AcmPcaServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "acm-pca-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-west-1": {
+							hostname: "acm-pca-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "acm-pca-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "acm-pca-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "acm-pca-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "acm-pca-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				AgreementMarketplaceServiceID: {
+					id: AgreementMarketplaceServiceID,
+					regions: []string{
+						UsEast1RegionID,
+					},
+				},
+				AirflowServiceID: {
+					id: AirflowServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				AmplifyServiceID: {
+					id: AmplifyServiceID,
+					regions: []string{
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				AmplifybackendServiceID: {
+					id: AmplifybackendServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				AmplifyuibuilderServiceID: {
+					id: AmplifyuibuilderServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				AossServiceID: {
+					id: AossServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+				},
+				ApiDetectiveServiceID: {
+					id: ApiDetectiveServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"ca-central-1-fips": {
+							hostname: "api.detective-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-1-fips": {
+							hostname: "api.detective-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-2-fips": {
+							hostname: "api.detective-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-1-fips": {
+							hostname: "api.detective-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "api.detective-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ApiEcrServiceID: {
+					id: ApiEcrServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "ecr-fips.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"dkr-us-east-1": {
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "ecr-fips.us-east-1.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"dkr-us-east-2": {
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "ecr-fips.us-east-2.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"dkr-us-west-1": {
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "ecr-fips.us-west-1.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"dkr-us-west-2": {
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "ecr-fips.us-west-2.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"fips-dkr-us-east-1": {
+							hostname: "ecr-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-dkr-us-east-2": {
+							hostname: "ecr-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-dkr-us-west-1": {
+							hostname: "ecr-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-dkr-us-west-2": {
+							hostname: "ecr-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "ecr-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "ecr-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "ecr-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "ecr-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ApiEcrPublicServiceID: {
+					id: ApiEcrPublicServiceID,
+					regions: []string{
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				ApiIotdeviceadvisorServiceID: {
+					id: ApiIotdeviceadvisorServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						EuWest1RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				ApiIotwirelessServiceID: {
+					id: ApiIotwirelessServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApSoutheast2RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				ApiMediatailorServiceID: {
+					id: ApiMediatailorServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest3RegionID,
+						MeCentral1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+				},
+				ApiPricingServiceID: {
+					id: ApiPricingServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "pricing",
+						},
+					},
+					regions: []string{
+						ApSouth1RegionID,
+						EuCentral1RegionID,
+						UsEast1RegionID,
+					},
+				},
+				ApiSagemakerServiceID: {
+					id: ApiSagemakerServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "api-fips.sagemaker.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-east-1-fips": {
+							hostname: "api-fips.sagemaker.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-2-fips": {
+							hostname: "api-fips.sagemaker.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-1-fips": {
+							hostname: "api-fips.sagemaker.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "api-fips.sagemaker.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ApiTunnelingIotServiceID: {
+					id: ApiTunnelingIotServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "api.tunneling.iot-fips.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "api.tunneling.iot-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "api.tunneling.iot-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "api.tunneling.iot-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "api.tunneling.iot-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "api.tunneling.iot-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ApigatewayServiceID: {
+					id: ApigatewayServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "apigateway-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-west-1": {
+							hostname: "apigateway-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "apigateway-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "apigateway-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "apigateway-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "apigateway-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				AppIntegrationsServiceID: {
+					id: AppIntegrationsServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				AppconfigServiceID: {
+					id: AppconfigServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				AppconfigdataServiceID: {
+					id: AppconfigdataServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				AppflowServiceID: {
+					id: AppflowServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "appflow-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "appflow-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "appflow-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "appflow-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ApplicationAutoscalingServiceID: {
+					id: ApplicationAutoscalingServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				ApplicationinsightsServiceID: {
+					id: ApplicationinsightsServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				AppmeshServiceID: {
+					id: AppmeshServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"ca-central-1-fips": {
+							hostname: "appmesh-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-1-fips": {
+							hostname: "appmesh-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-2-fips": {
+							hostname: "appmesh-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-1-fips": {
+							hostname: "appmesh-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "appmesh-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ApprunnerServiceID: {
+					id: ApprunnerServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "apprunner-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "apprunner-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "apprunner-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				Appstream2ServiceID: {
+					id: Appstream2ServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "appstream",
+						},
+					},
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips": {
+							hostname: "appstream2-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-1-fips": {
+							hostname: "appstream2-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "appstream2-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				AppsyncServiceID: {
+					id: AppsyncServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				ApsServiceID: {
+					id: ApsServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+				},
+				ArcZonalShiftServiceID: {
+					id: ArcZonalShiftServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				AthenaServiceID: {
+					id: AthenaServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "athena-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "athena-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "athena-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "athena-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				AuditmanagerServiceID: {
+					id: AuditmanagerServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-east-1-fips": {
+							hostname: "auditmanager-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-2-fips": {
+							hostname: "auditmanager-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-1-fips": {
+							hostname: "auditmanager-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "auditmanager-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				AutoscalingServiceID: {
+					id: AutoscalingServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "autoscaling-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-west-1": {
+							hostname: "autoscaling-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "autoscaling-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "autoscaling-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "autoscaling-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "autoscaling-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				AutoscalingPlansServiceID: {
+					id: AutoscalingPlansServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				BackupServiceID: {
+					id: BackupServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				BackupGatewayServiceID: {
+					id: BackupGatewayServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				BatchServiceID: {
+					id: BatchServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "fips.batch.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "fips.batch.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "fips.batch.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "fips.batch.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "fips.batch.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				BedrockServiceID: {
+					id: BedrockServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"bedrock-ap-northeast-1": {
+							hostname: "bedrock.ap-northeast-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApNortheast1RegionID,
+							},
+						},
+						"bedrock-ap-south-1": {
+							hostname: "bedrock.ap-south-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSouth1RegionID,
+							},
+						},
+						"bedrock-ap-southeast-1": {
+							hostname: "bedrock.ap-southeast-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast1RegionID,
+							},
+						},
+						"bedrock-ap-southeast-2": {
+							hostname: "bedrock.ap-southeast-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast2RegionID,
+							},
+						},
+						"bedrock-ca-central-1": {
+							hostname: "bedrock.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+						},
+						"bedrock-eu-central-1": {
+							hostname: "bedrock.eu-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuCentral1RegionID,
+							},
+						},
+						"bedrock-eu-west-1": {
+							hostname: "bedrock.eu-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuWest1RegionID,
+							},
+						},
+						"bedrock-eu-west-2": {
+							hostname: "bedrock.eu-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuWest2RegionID,
+							},
+						},
+						"bedrock-eu-west-3": {
+							hostname: "bedrock.eu-west-3.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuWest3RegionID,
+							},
+						},
+						"bedrock-fips-ca-central-1": {
+							hostname: "bedrock-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+						},
+						"bedrock-fips-us-east-1": {
+							hostname: "bedrock-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+						},
+						"bedrock-fips-us-west-2": {
+							hostname: "bedrock-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+						},
+						"bedrock-runtime-ap-northeast-1": {
+							hostname: "bedrock-runtime.ap-northeast-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApNortheast1RegionID,
+							},
+						},
+						"bedrock-runtime-ap-south-1": {
+							hostname: "bedrock-runtime.ap-south-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSouth1RegionID,
+							},
+						},
+						"bedrock-runtime-ap-southeast-1": {
+							hostname: "bedrock-runtime.ap-southeast-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast1RegionID,
+							},
+						},
+						"bedrock-runtime-ap-southeast-2": {
+							hostname: "bedrock-runtime.ap-southeast-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast2RegionID,
+							},
+						},
+						"bedrock-runtime-ca-central-1": {
+							hostname: "bedrock-runtime.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+						},
+						"bedrock-runtime-eu-central-1": {
+							hostname: "bedrock-runtime.eu-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuCentral1RegionID,
+							},
+						},
+						"bedrock-runtime-eu-west-1": {
+							hostname: "bedrock-runtime.eu-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuWest1RegionID,
+							},
+						},
+						"bedrock-runtime-eu-west-2": {
+							hostname: "bedrock-runtime.eu-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuWest2RegionID,
+							},
+						},
+						"bedrock-runtime-eu-west-3": {
+							hostname: "bedrock-runtime.eu-west-3.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuWest3RegionID,
+							},
+						},
+						"bedrock-runtime-fips-ca-central-1": {
+							hostname: "bedrock-runtime-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+						},
+						"bedrock-runtime-fips-us-east-1": {
+							hostname: "bedrock-runtime-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+						},
+						"bedrock-runtime-fips-us-west-2": {
+							hostname: "bedrock-runtime-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+						},
+						"bedrock-runtime-sa-east-1": {
+							hostname: "bedrock-runtime.sa-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: SaEast1RegionID,
+							},
+						},
+						"bedrock-runtime-us-east-1": {
+							hostname: "bedrock-runtime.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+						},
+						"bedrock-runtime-us-west-2": {
+							hostname: "bedrock-runtime.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+						},
+						"bedrock-sa-east-1": {
+							hostname: "bedrock.sa-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: SaEast1RegionID,
+							},
+						},
+						"bedrock-us-east-1": {
+							hostname: "bedrock.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+						},
+						"bedrock-us-west-2": {
+							hostname: "bedrock.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+						},
+					},
+				},
+				BillingconductorServiceID: {
+					id:                BillingconductorServiceID,
+					partitionEndpoint: AwsGlobalRegionID,
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						AwsGlobalRegionID: {
+							hostname: "billingconductor.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+						},
+					},
+				},
+				BraketServiceID: {
+					id: BraketServiceID,
+					regions: []string{
+						EuNorth1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				BudgetsServiceID: {
+					id:                BudgetsServiceID,
+					partitionEndpoint: AwsGlobalRegionID,
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						AwsGlobalRegionID: {
+							hostname: "budgets.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+						},
+					},
+				},
+				CasesServiceID: {
+					id: CasesServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							deprecated: true,
+						},
+					},
+				},
+				CassandraServiceID: {
+					id: CassandraServiceID,
+					regions: []string{
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "cassandra-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "cassandra-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				CatalogMarketplaceServiceID: {
+					id: CatalogMarketplaceServiceID,
+					regions: []string{
+						UsEast1RegionID,
+					},
+				},
+				CeServiceID: {
+					id:                CeServiceID,
+					partitionEndpoint: AwsGlobalRegionID,
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						AwsGlobalRegionID: {
+							hostname: "ce.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+						},
+					},
+				},
+				ChimeServiceID: {
+					id:                ChimeServiceID,
+					partitionEndpoint: AwsGlobalRegionID,
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						AwsGlobalRegionID: {
+							hostname: "chime.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+						},
+					},
+				},
+				CleanroomsServiceID: {
+					id: CleanroomsServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+				},
+				Cloud9ServiceID: {
+					id: Cloud9ServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "cloud9-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "cloud9-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "cloud9-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "cloud9-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "cloud9-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				CloudcontrolapiServiceID: {
+					id: CloudcontrolapiServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "cloudcontrolapi-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-west-1": {
+							hostname: "cloudcontrolapi-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "cloudcontrolapi-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "cloudcontrolapi-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "cloudcontrolapi-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "cloudcontrolapi-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ClouddirectoryServiceID: {
+					id: ClouddirectoryServiceID,
+					regions: []string{
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+				},
+				CloudformationServiceID: {
+					id: CloudformationServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-east-1-fips": {
+							hostname: "cloudformation-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-2-fips": {
+							hostname: "cloudformation-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-1-fips": {
+							hostname: "cloudformation-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "cloudformation-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				CloudfrontServiceID: {
+					id:                CloudfrontServiceID,
+					partitionEndpoint: AwsGlobalRegionID,
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						AwsGlobalRegionID: {
+							hostname: "cloudfront.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+						},
+					},
+				},
+				CloudhsmServiceID: {
+					id: CloudhsmServiceID,
+					regions: []string{
+						UsEast1RegionID,
+					},
+				},
+				Cloudhsmv2ServiceID: {
+					id: Cloudhsmv2ServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "cloudhsm",
+						},
+					},
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				CloudsearchServiceID: {
+					id: CloudsearchServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				CloudtrailServiceID: {
+					id: CloudtrailServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "cloudtrail-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "cloudtrail-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "cloudtrail-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "cloudtrail-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				CloudtrailDataServiceID: {
+					id: CloudtrailDataServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				CodeartifactServiceID: {
+					id: CodeartifactServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+				},
+				CodebuildServiceID: {
+					id: CodebuildServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-east-1-fips": {
+							hostname: "codebuild-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-2-fips": {
+							hostname: "codebuild-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-1-fips": {
+							hostname: "codebuild-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "codebuild-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				CodecatalystServiceID: {
+					id:                CodecatalystServiceID,
+					partitionEndpoint: AwsGlobalRegionID,
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						AwsGlobalRegionID: {
+							hostname: "codecatalyst.global.api.aws",
+						},
+					},
+				},
+				CodecommitServiceID: {
+					id: CodecommitServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"ca-central-1-fips": {
+							hostname: "codecommit-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips": {
+							hostname: "codecommit-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-1-fips": {
+							hostname: "codecommit-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-2-fips": {
+							hostname: "codecommit-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-1-fips": {
+							hostname: "codecommit-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "codecommit-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				CodedeployServiceID: {
+					id: CodedeployServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-east-1-fips": {
+							hostname: "codedeploy-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-2-fips": {
+							hostname: "codedeploy-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-1-fips": {
+							hostname: "codedeploy-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "codedeploy-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				CodeguruProfilerServiceID: {
+					id: CodeguruProfilerServiceID,
+				},
+				CodeguruReviewerServiceID: {
+					id: CodeguruReviewerServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+				},
+				CodepipelineServiceID: {
+					id: CodepipelineServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "codepipeline-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "codepipeline-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "codepipeline-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "codepipeline-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "codepipeline-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				CodestarConnectionsServiceID: {
+					id: CodestarConnectionsServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				CodestarNotificationsServiceID: {
+					id: CodestarNotificationsServiceID,
+					regions: []string{
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				CognitoIdentityServiceID: {
+					id: CognitoIdentityServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "cognito-identity-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "cognito-identity-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "cognito-identity-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "cognito-identity-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				CognitoIdpServiceID: {
+					id: CognitoIdpServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "cognito-idp-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "cognito-idp-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "cognito-idp-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "cognito-idp-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				CognitoSyncServiceID: {
+					id: CognitoSyncServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+				},
+				ComprehendServiceID: {
+					id: ComprehendServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "comprehend-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "comprehend-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "comprehend-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ComprehendmedicalServiceID: {
+					id: ComprehendmedicalServiceID,
+					regions: []string{
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "comprehendmedical-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "comprehendmedical-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "comprehendmedical-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "comprehendmedical-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ComputeOptimizerServiceID: {
+					id: ComputeOptimizerServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				ConfigServiceID: {
+					id: ConfigServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "config-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "config-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "config-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "config-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ConnectServiceID: {
+					id: ConnectServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "connect-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "connect-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ConnectCampaignsServiceID: {
+					id: ConnectCampaignsServiceID,
+					regions: []string{
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "connect-campaigns-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "connect-campaigns-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ContactLensServiceID: {
+					id: ContactLensServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				ControltowerServiceID: {
+					id: ControltowerServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"ca-central-1-fips": {
+							hostname: "controltower-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"ca-west-1-fips": {
+							hostname: "controltower-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-1-fips": {
+							hostname: "controltower-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-2-fips": {
+							hostname: "controltower-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-1-fips": {
+							hostname: "controltower-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "controltower-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				CostOptimizationHubServiceID: {
+					id: CostOptimizationHubServiceID,
+					regions: []string{
+						UsEast1RegionID,
+					},
+				},
+				CurServiceID: {
+					id: CurServiceID,
+					regions: []string{
+						UsEast1RegionID,
+					},
+				},
+				DataAtsIotServiceID: {
+					id: DataAtsIotServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "iotdata",
+						},
+					},
+					regions: []string{
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						CaCentral1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "data.iot-fips.ca-central-1.amazonaws.com",
+								},
+							},
+						},
+						"fips-ca-central-1": {
+							hostname: "data.iot-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								service: "iotdata",
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "data.iot-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								service: "iotdata",
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "data.iot-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								service: "iotdata",
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "data.iot-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								service: "iotdata",
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "data.iot-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								service: "iotdata",
+							},
+							deprecated: true,
+						},
+						UsEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "data.iot-fips.us-east-1.amazonaws.com",
+								},
+							},
+						},
+						UsEast2RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "data.iot-fips.us-east-2.amazonaws.com",
+								},
+							},
+						},
+						UsWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "data.iot-fips.us-west-1.amazonaws.com",
+								},
+							},
+						},
+						UsWest2RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "data.iot-fips.us-west-2.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+				DataIotServiceID: {
+					id: DataIotServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "iotdata",
+						},
+					},
+					regions: []string{
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "data.iot-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								service: "iotdata",
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "data.iot-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								service: "iotdata",
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "data.iot-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								service: "iotdata",
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "data.iot-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								service: "iotdata",
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "data.iot-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								service: "iotdata",
+							},
+							deprecated: true,
+						},
+					},
+				},
+				DataJobsIotServiceID: {
+					id: DataJobsIotServiceID,
+					regions: []string{
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "data.jobs.iot-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "data.jobs.iot-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "data.jobs.iot-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "data.jobs.iot-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "data.jobs.iot-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				DataMediastoreServiceID: {
+					id: DataMediastoreServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSoutheast2RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				DatabrewServiceID: {
+					id: DatabrewServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "databrew-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "databrew-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "databrew-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "databrew-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				DataexchangeServiceID: {
+					id: DataexchangeServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				DatapipelineServiceID: {
+					id: DatapipelineServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApSoutheast2RegionID,
+						EuWest1RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				DatasyncServiceID: {
+					id: DatasyncServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "datasync-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-west-1": {
+							hostname: "datasync-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "datasync-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "datasync-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "datasync-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "datasync-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				DatazoneServiceID: {
+					id: DatazoneServiceID,
+					defaults: endpoint{
+						dnsSuffix: "api.aws",
+						variants: []endpointVariant{
+							{
+								variant:   FIPSVariant,
+								hostname:  "{service}-fips.{region}.{dnsSuffix}",
+								dnsSuffix: "api.aws",
+							},
+						},
+					},
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						CaCentral1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "datazone-fips.ca-central-1.amazonaws.com",
+								},
+							},
+						},
+						UsEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "datazone-fips.us-east-1.amazonaws.com",
+								},
+							},
+						},
+						UsEast2RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "datazone-fips.us-east-2.amazonaws.com",
+								},
+							},
+						},
+						UsWest2RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "datazone-fips.us-west-2.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+				DaxServiceID: {
+					id: DaxServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				DevicefarmServiceID: {
+					id: DevicefarmServiceID,
+					regions: []string{
+						UsWest2RegionID,
+					},
+				},
+				DevopsGuruServiceID: {
+					id: DevopsGuruServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "devops-guru-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "devops-guru-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "devops-guru-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "devops-guru-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "devops-guru-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				DirectconnectServiceID: {
+					id: DirectconnectServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "directconnect-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-west-1": {
+							hostname: "directconnect-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "directconnect-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "directconnect-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "directconnect-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "directconnect-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				DiscoveryServiceID: {
+					id: DiscoveryServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApSoutheast2RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				DlmServiceID: {
+					id: DlmServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				DmsServiceID: {
+					id: DmsServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"dms": {
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "dms-fips.us-west-1.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"dms-fips": {
+							hostname: "dms-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-1-fips": {
+							hostname: "dms-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-2-fips": {
+							hostname: "dms-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-1-fips": {
+							hostname: "dms-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "dms-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				DocdbServiceID: {
+					id: DocdbServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						ApNortheast1RegionID: {
+							hostname: "rds.ap-northeast-1.amazonaws.com",
+						},
+						ApNortheast2RegionID: {
+							hostname: "rds.ap-northeast-2.amazonaws.com",
+						},
+						ApSouth1RegionID: {
+							hostname: "rds.ap-south-1.amazonaws.com",
+						},
+						ApSoutheast1RegionID: {
+							hostname: "rds.ap-southeast-1.amazonaws.com",
+						},
+						ApSoutheast2RegionID: {
+							hostname: "rds.ap-southeast-2.amazonaws.com",
+						},
+						CaCentral1RegionID: {
+							hostname: "rds.ca-central-1.amazonaws.com",
+						},
+						EuCentral1RegionID: {
+							hostname: "rds.eu-central-1.amazonaws.com",
+						},
+						EuWest1RegionID: {
+							hostname: "rds.eu-west-1.amazonaws.com",
+						},
+						EuWest2RegionID: {
+							hostname: "rds.eu-west-2.amazonaws.com",
+						},
+						EuWest3RegionID: {
+							hostname: "rds.eu-west-3.amazonaws.com",
+						},
+						SaEast1RegionID: {
+							hostname: "rds.sa-east-1.amazonaws.com",
+						},
+						UsEast1RegionID: {
+							hostname: "rds.us-east-1.amazonaws.com",
+						},
+						UsEast2RegionID: {
+							hostname: "rds.us-east-2.amazonaws.com",
+						},
+						UsWest2RegionID: {
+							hostname: "rds.us-west-2.amazonaws.com",
+						},
+					},
+				},
+				DrsServiceID: {
+					id: DrsServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "drs-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "drs-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "drs-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "drs-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				DsServiceID: {
+					id: DsServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "ds-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-west-1": {
+							hostname: "ds-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "ds-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "ds-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "ds-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "ds-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				DynamodbServiceID: {
+					id: DynamodbServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"ca-central-1-fips": {
+							hostname: "dynamodb-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"ca-west-1-fips": {
+							hostname: "dynamodb-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"local": {
+							hostname: "localhost:8000",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+						},
+						"us-east-1-fips": {
+							hostname: "dynamodb-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-2-fips": {
+							hostname: "dynamodb-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-1-fips": {
+							hostname: "dynamodb-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "dynamodb-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				EbsServiceID: {
+					id: EbsServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "ebs-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-west-1": {
+							hostname: "ebs-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "ebs-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "ebs-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "ebs-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "ebs-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				Ec2ServiceID: {
+					id: Ec2ServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "ec2-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-west-1": {
+							hostname: "ec2-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "ec2-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "ec2-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "ec2-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "ec2-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				EcsServiceID: {
+					id: EcsServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "ecs-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "ecs-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "ecs-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "ecs-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				EdgeSagemakerServiceID: {
+					id: EdgeSagemakerServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+				},
+				EksServiceID: {
+					id: EksServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "fips.eks.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "fips.eks.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "fips.eks.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "fips.eks.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "fips.eks.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				EksAuthServiceID: {
+					id: EksAuthServiceID,
+					defaults: endpoint{
+						dnsSuffix: "api.aws",
+						variants: []endpointVariant{
+							{
+								variant:   FIPSVariant,
+								hostname:  "{service}-fips.{region}.{dnsSuffix}",
+								dnsSuffix: "api.aws",
+							},
+						},
+					},
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				ElasticacheServiceID: {
+					id: ElasticacheServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips": {
+							hostname: "elasticache-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-1-fips": {
+							hostname: "elasticache-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-2-fips": {
+							hostname: "elasticache-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-1-fips": {
+							hostname: "elasticache-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "elasticache-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ElasticbeanstalkServiceID: {
+					id: ElasticbeanstalkServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "elasticbeanstalk-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "elasticbeanstalk-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "elasticbeanstalk-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "elasticbeanstalk-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ElasticfilesystemServiceID: {
+					id: ElasticfilesystemServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-af-south-1": {
+							hostname: "elasticfilesystem-fips.af-south-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: AfSouth1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-east-1": {
+							hostname: "elasticfilesystem-fips.ap-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-northeast-1": {
+							hostname: "elasticfilesystem-fips.ap-northeast-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApNortheast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-northeast-2": {
+							hostname: "elasticfilesystem-fips.ap-northeast-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApNortheast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-northeast-3": {
+							hostname: "elasticfilesystem-fips.ap-northeast-3.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApNortheast3RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-south-1": {
+							hostname: "elasticfilesystem-fips.ap-south-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSouth1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-south-2": {
+							hostname: "elasticfilesystem-fips.ap-south-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSouth2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-southeast-1": {
+							hostname: "elasticfilesystem-fips.ap-southeast-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-southeast-2": {
+							hostname: "elasticfilesystem-fips.ap-southeast-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-southeast-3": {
+							hostname: "elasticfilesystem-fips.ap-southeast-3.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast3RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-southeast-4": {
+							hostname: "elasticfilesystem-fips.ap-southeast-4.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast4RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-central-1": {
+							hostname: "elasticfilesystem-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-west-1": {
+							hostname: "elasticfilesystem-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-central-1": {
+							hostname: "elasticfilesystem-fips.eu-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-central-2": {
+							hostname: "elasticfilesystem-fips.eu-central-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuCentral2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-north-1": {
+							hostname: "elasticfilesystem-fips.eu-north-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuNorth1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-south-1": {
+							hostname: "elasticfilesystem-fips.eu-south-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuSouth1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-south-2": {
+							hostname: "elasticfilesystem-fips.eu-south-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuSouth2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-west-1": {
+							hostname: "elasticfilesystem-fips.eu-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-west-2": {
+							hostname: "elasticfilesystem-fips.eu-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuWest2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-west-3": {
+							hostname: "elasticfilesystem-fips.eu-west-3.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuWest3RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-il-central-1": {
+							hostname: "elasticfilesystem-fips.il-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: IlCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-me-central-1": {
+							hostname: "elasticfilesystem-fips.me-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: MeCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-me-south-1": {
+							hostname: "elasticfilesystem-fips.me-south-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: MeSouth1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-sa-east-1": {
+							hostname: "elasticfilesystem-fips.sa-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: SaEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "elasticfilesystem-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "elasticfilesystem-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "elasticfilesystem-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "elasticfilesystem-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ElasticloadbalancingServiceID: {
+					id: ElasticloadbalancingServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "elasticloadbalancing-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "elasticloadbalancing-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "elasticloadbalancing-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "elasticloadbalancing-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ElasticmapreduceServiceID: {
+					id: ElasticmapreduceServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "elasticmapreduce-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-west-1": {
+							hostname: "elasticmapreduce-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "elasticmapreduce-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "elasticmapreduce-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "elasticmapreduce-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "elasticmapreduce-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				EmailServiceID: {
+					id: EmailServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "email-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "email-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "email-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "email-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "email-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				EmrContainersServiceID: {
+					id: EmrContainersServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "emr-containers-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "emr-containers-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "emr-containers-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "emr-containers-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "emr-containers-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				EmrServerlessServiceID: {
+					id: EmrServerlessServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "emr-serverless-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "emr-serverless-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "emr-serverless-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "emr-serverless-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "emr-serverless-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				EntitlementMarketplaceServiceID: {
+					id: EntitlementMarketplaceServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "aws-marketplace",
+						},
+					},
+					regions: []string{
+						UsEast1RegionID,
+					},
+				},
+				EsServiceID: {
+					id: EsServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						AfSouth1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.af-south-1.api.aws",
+								},
+							},
+						},
+						ApEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.ap-east-1.api.aws",
+								},
+							},
+						},
+						ApNortheast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.ap-northeast-1.api.aws",
+								},
+							},
+						},
+						ApNortheast2RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.ap-northeast-2.api.aws",
+								},
+							},
+						},
+						ApNortheast3RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.ap-northeast-3.api.aws",
+								},
+							},
+						},
+						ApSouth1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.ap-south-1.api.aws",
+								},
+							},
+						},
+						ApSouth2RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.ap-south-2.api.aws",
+								},
+							},
+						},
+						ApSoutheast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.ap-southeast-1.api.aws",
+								},
+							},
+						},
+						ApSoutheast2RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.ap-southeast-2.api.aws",
+								},
+							},
+						},
+						ApSoutheast3RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.ap-southeast-3.api.aws",
+								},
+							},
+						},
+						ApSoutheast4RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.ap-southeast-4.api.aws",
+								},
+							},
+						},
+						CaCentral1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.ca-central-1.api.aws",
+								},
+							},
+						},
+						CaWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.ca-west-1.api.aws",
+								},
+							},
+						},
+						EuCentral1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.eu-central-1.api.aws",
+								},
+							},
+						},
+						EuCentral2RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.eu-central-2.api.aws",
+								},
+							},
+						},
+						EuNorth1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.eu-north-1.api.aws",
+								},
+							},
+						},
+						EuSouth1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.eu-south-1.api.aws",
+								},
+							},
+						},
+						EuSouth2RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.eu-south-2.api.aws",
+								},
+							},
+						},
+						EuWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.eu-west-1.api.aws",
+								},
+							},
+						},
+						EuWest2RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.eu-west-2.api.aws",
+								},
+							},
+						},
+						EuWest3RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.eu-west-3.api.aws",
+								},
+							},
+						},
+						"fips": {
+							hostname: "es-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						IlCentral1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.il-central-1.api.aws",
+								},
+							},
+						},
+						MeCentral1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.me-central-1.api.aws",
+								},
+							},
+						},
+						MeSouth1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.me-south-1.api.aws",
+								},
+							},
+						},
+						SaEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.sa-east-1.api.aws",
+								},
+							},
+						},
+						UsEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.us-east-1.api.aws",
+								},
+							},
+						},
+						"us-east-1-fips": {
+							hostname: "es-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						UsEast2RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.us-east-2.api.aws",
+								},
+							},
+						},
+						"us-east-2-fips": {
+							hostname: "es-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						UsWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.us-west-1.api.aws",
+								},
+							},
+						},
+						"us-west-1-fips": {
+							hostname: "es-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						UsWest2RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.us-west-2.api.aws",
+								},
+							},
+						},
+						"us-west-2-fips": {
+							hostname: "es-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				EventsServiceID: {
+					id: EventsServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "events-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "events-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "events-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "events-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				FinspaceServiceID: {
+					id: FinspaceServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+				},
+				FinspaceApiServiceID: {
+					id: FinspaceApiServiceID,
+					regions: []string{
+						CaCentral1RegionID,
+						EuWest1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+				},
+				FirehoseServiceID: {
+					id: FirehoseServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "firehose-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "firehose-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "firehose-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "firehose-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				FmsServiceID: {
+					id: FmsServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-af-south-1": {
+							hostname: "fms-fips.af-south-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: AfSouth1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-east-1": {
+							hostname: "fms-fips.ap-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-northeast-1": {
+							hostname: "fms-fips.ap-northeast-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApNortheast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-northeast-2": {
+							hostname: "fms-fips.ap-northeast-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApNortheast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-south-1": {
+							hostname: "fms-fips.ap-south-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSouth1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-southeast-1": {
+							hostname: "fms-fips.ap-southeast-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-southeast-2": {
+							hostname: "fms-fips.ap-southeast-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-central-1": {
+							hostname: "fms-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-west-1": {
+							hostname: "fms-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-central-1": {
+							hostname: "fms-fips.eu-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-south-1": {
+							hostname: "fms-fips.eu-south-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuSouth1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-west-1": {
+							hostname: "fms-fips.eu-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-west-2": {
+							hostname: "fms-fips.eu-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuWest2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-west-3": {
+							hostname: "fms-fips.eu-west-3.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuWest3RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-me-south-1": {
+							hostname: "fms-fips.me-south-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: MeSouth1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-sa-east-1": {
+							hostname: "fms-fips.sa-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: SaEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "fms-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "fms-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "fms-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "fms-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ForecastServiceID: {
+					id: ForecastServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "forecast-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "forecast-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "forecast-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ForecastqueryServiceID: {
+					id: ForecastqueryServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "forecastquery-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "forecastquery-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "forecastquery-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				FrauddetectorServiceID: {
+					id: FrauddetectorServiceID,
+					regions: []string{
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						EuWest1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+				},
+				FsxServiceID: {
+					id: FsxServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "fsx-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-west-1": {
+							hostname: "fsx-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-prod-ca-central-1": {
+							hostname: "fsx-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-prod-ca-west-1": {
+							hostname: "fsx-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-prod-us-east-1": {
+							hostname: "fsx-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-prod-us-east-2": {
+							hostname: "fsx-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-prod-us-west-1": {
+							hostname: "fsx-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-prod-us-west-2": {
+							hostname: "fsx-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "fsx-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "fsx-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "fsx-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "fsx-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+						"prod-ca-central-1": {
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "fsx-fips.ca-central-1.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"prod-ca-west-1": {
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "fsx-fips.ca-west-1.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"prod-us-east-1": {
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "fsx-fips.us-east-1.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"prod-us-east-2": {
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "fsx-fips.us-east-2.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"prod-us-west-1": {
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "fsx-fips.us-west-1.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"prod-us-west-2": {
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "fsx-fips.us-west-2.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+					},
+				},
+				GameliftServiceID: {
+					id: GameliftServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				GameliftstreamsServiceID: {
+					id: GameliftstreamsServiceID,
+				},
+				GeoServiceID: {
+					id: GeoServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+				},
+				GlacierServiceID: {
+					id: GlacierServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "glacier-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "glacier-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "glacier-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "glacier-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "glacier-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				GlobalacceleratorServiceID: {
+					id: GlobalacceleratorServiceID,
+					endpoints: map[string]endpoint{
+						"fips-us-west-2": {
+							hostname: "globalaccelerator-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+						},
+					},
+				},
+				GlueServiceID: {
+					id: GlueServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "glue-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "glue-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "glue-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "glue-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				GrafanaServiceID: {
+					id: GrafanaServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+				},
+				GreengrassServiceID: {
+					id: GreengrassServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "greengrass-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "greengrass-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "greengrass-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "greengrass-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				GroundstationServiceID: {
+					id: GroundstationServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApNortheast2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "groundstation-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "groundstation-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "groundstation-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				GuarddutyServiceID: {
+					id: GuarddutyServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-east-1-fips": {
+							hostname: "guardduty-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-2-fips": {
+							hostname: "guardduty-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-1-fips": {
+							hostname: "guardduty-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "guardduty-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				HealthServiceID: {
+					id:                HealthServiceID,
+					partitionEndpoint: AwsGlobalRegionID,
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						AwsGlobalRegionID: {
+							hostname: "global.health.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+						},
+						"fips-us-east-2": {
+							hostname: "health-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						UsEast2RegionID: {
+							deprecated: true,
+						},
+					},
+				},
+				HealthlakeServiceID: {
+					id: HealthlakeServiceID,
+					regions: []string{
+						ApSouth1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+				},
+				IamServiceID: {
+					id:                IamServiceID,
+					partitionEndpoint: AwsGlobalRegionID,
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						AwsGlobalRegionID: {
+							hostname: "iam.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "iam-fips.amazonaws.com",
+								},
+							},
+						},
+						"aws-global-fips": {
+							hostname: "iam-fips.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"iam": {
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "iam-fips.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"iam-fips": {
+							hostname: "iam-fips.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				IdentityChimeServiceID: {
+					id: IdentityChimeServiceID,
+					regions: []string{
+						EuCentral1RegionID,
+						UsEast1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-east-1-fips": {
+							hostname: "identity-chime-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				IdentitystoreServiceID: {
+					id: IdentitystoreServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				ImportexportServiceID: {
+					id:                ImportexportServiceID,
+					partitionEndpoint: AwsGlobalRegionID,
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						AwsGlobalRegionID: {
+							hostname: "importexport.amazonaws.com",
+							credentialScope: credentialScope{
+								region:  UsEast1RegionID,
+								service: "IngestionService",
+							},
+						},
+					},
+				},
+				IngestTimestreamServiceID: {
+					id: IngestTimestreamServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApSoutheast2RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"ingest-fips-us-east-1": {
+							hostname: "ingest.timestream-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"ingest-fips-us-east-2": {
+							hostname: "ingest.timestream-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"ingest-fips-us-west-2": {
+							hostname: "ingest.timestream-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+						"ingest-us-east-1": {
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "ingest.timestream-fips.us-east-1.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"ingest-us-east-2": {
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "ingest.timestream-fips.us-east-2.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"ingest-us-west-2": {
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "ingest.timestream-fips.us-west-2.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+					},
+				},
+				InspectorServiceID: {
+					id: InspectorServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast2RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "inspector-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "inspector-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "inspector-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "inspector-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				Inspector2ServiceID: {
+					id: Inspector2ServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "inspector2-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "inspector2-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "inspector2-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "inspector2-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				InternetmonitorServiceID: {
+					id: InternetmonitorServiceID,
+					defaults: endpoint{
+						dnsSuffix: "api.aws",
+						variants: []endpointVariant{
+							{
+								variant:   FIPSVariant,
+								hostname:  "{service}-fips.{region}.{dnsSuffix}",
+								dnsSuffix: "api.aws",
+							},
+						},
+					},
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						CaCentral1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "internetmonitor-fips.ca-central-1.amazonaws.com",
+								},
+							},
+						},
+						UsEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "internetmonitor-fips.us-east-1.amazonaws.com",
+								},
+							},
+						},
+						UsEast2RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "internetmonitor-fips.us-east-2.amazonaws.com",
+								},
+							},
+						},
+						UsWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "internetmonitor-fips.us-west-1.amazonaws.com",
+								},
+							},
+						},
+						UsWest2RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "internetmonitor-fips.us-west-2.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+				IotServiceID: {
+					id: IotServiceID,
+					regions: []string{
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname:   "iot-fips.ca-central-1.amazonaws.com",
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname:   "iot-fips.us-east-1.amazonaws.com",
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname:   "iot-fips.us-east-2.amazonaws.com",
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname:   "iot-fips.us-west-1.amazonaws.com",
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname:   "iot-fips.us-west-2.amazonaws.com",
+							deprecated: true,
+						},
+					},
+				},
+				IoteventsServiceID: {
+					id: IoteventsServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "iotevents-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "iotevents-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "iotevents-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "iotevents-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				IoteventsdataServiceID: {
+					id: IoteventsdataServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						ApNortheast1RegionID: {
+							hostname: "data.iotevents.ap-northeast-1.amazonaws.com",
+						},
+						ApNortheast2RegionID: {
+							hostname: "data.iotevents.ap-northeast-2.amazonaws.com",
+						},
+						ApSouth1RegionID: {
+							hostname: "data.iotevents.ap-south-1.amazonaws.com",
+						},
+						ApSoutheast1RegionID: {
+							hostname: "data.iotevents.ap-southeast-1.amazonaws.com",
+						},
+						ApSoutheast2RegionID: {
+							hostname: "data.iotevents.ap-southeast-2.amazonaws.com",
+						},
+						CaCentral1RegionID: {
+							hostname: "data.iotevents.ca-central-1.amazonaws.com",
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "data.iotevents-fips.ca-central-1.amazonaws.com",
+								},
+							},
+						},
+						EuCentral1RegionID: {
+							hostname: "data.iotevents.eu-central-1.amazonaws.com",
+						},
+						EuWest1RegionID: {
+							hostname: "data.iotevents.eu-west-1.amazonaws.com",
+						},
+						EuWest2RegionID: {
+							hostname: "data.iotevents.eu-west-2.amazonaws.com",
+						},
+						"fips-ca-central-1": {
+							hostname: "data.iotevents-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "data.iotevents-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "data.iotevents-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "data.iotevents-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+						UsEast1RegionID: {
+							hostname: "data.iotevents.us-east-1.amazonaws.com",
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "data.iotevents-fips.us-east-1.amazonaws.com",
+								},
+							},
+						},
+						UsEast2RegionID: {
+							hostname: "data.iotevents.us-east-2.amazonaws.com",
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "data.iotevents-fips.us-east-2.amazonaws.com",
+								},
+							},
+						},
+						UsWest2RegionID: {
+							hostname: "data.iotevents.us-west-2.amazonaws.com",
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "data.iotevents-fips.us-west-2.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+				IotfleetwiseServiceID: {
+					id: IotfleetwiseServiceID,
+					regions: []string{
+						EuCentral1RegionID,
+						UsEast1RegionID,
+					},
+				},
+				IotsecuredtunnelingServiceID: {
+					id: IotsecuredtunnelingServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "api.tunneling.iot-fips.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "api.tunneling.iot-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "api.tunneling.iot-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "api.tunneling.iot-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "api.tunneling.iot-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "api.tunneling.iot-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				IotsitewiseServiceID: {
+					id: IotsitewiseServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "iotsitewise-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "iotsitewise-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "iotsitewise-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "iotsitewise-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				IotthingsgraphServiceID: {
+					id: IotthingsgraphServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "iotthingsgraph",
+						},
+					},
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSoutheast2RegionID,
+						EuWest1RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				IottwinmakerServiceID: {
+					id: IottwinmakerServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"api-ap-northeast-1": {
+							hostname: "api.iottwinmaker.ap-northeast-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApNortheast1RegionID,
+							},
+						},
+						"api-ap-northeast-2": {
+							hostname: "api.iottwinmaker.ap-northeast-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApNortheast2RegionID,
+							},
+						},
+						"api-ap-south-1": {
+							hostname: "api.iottwinmaker.ap-south-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSouth1RegionID,
+							},
+						},
+						"api-ap-southeast-1": {
+							hostname: "api.iottwinmaker.ap-southeast-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast1RegionID,
+							},
+						},
+						"api-ap-southeast-2": {
+							hostname: "api.iottwinmaker.ap-southeast-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast2RegionID,
+							},
+						},
+						"api-eu-central-1": {
+							hostname: "api.iottwinmaker.eu-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuCentral1RegionID,
+							},
+						},
+						"api-eu-west-1": {
+							hostname: "api.iottwinmaker.eu-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuWest1RegionID,
+							},
+						},
+						"api-us-east-1": {
+							hostname: "api.iottwinmaker.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+						},
+						"api-us-west-2": {
+							hostname: "api.iottwinmaker.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+						},
+						"data-ap-northeast-1": {
+							hostname: "data.iottwinmaker.ap-northeast-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApNortheast1RegionID,
+							},
+						},
+						"data-ap-northeast-2": {
+							hostname: "data.iottwinmaker.ap-northeast-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApNortheast2RegionID,
+							},
+						},
+						"data-ap-south-1": {
+							hostname: "data.iottwinmaker.ap-south-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSouth1RegionID,
+							},
+						},
+						"data-ap-southeast-1": {
+							hostname: "data.iottwinmaker.ap-southeast-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast1RegionID,
+							},
+						},
+						"data-ap-southeast-2": {
+							hostname: "data.iottwinmaker.ap-southeast-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast2RegionID,
+							},
+						},
+						"data-eu-central-1": {
+							hostname: "data.iottwinmaker.eu-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuCentral1RegionID,
+							},
+						},
+						"data-eu-west-1": {
+							hostname: "data.iottwinmaker.eu-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuWest1RegionID,
+							},
+						},
+						"data-us-east-1": {
+							hostname: "data.iottwinmaker.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+						},
+						"data-us-west-2": {
+							hostname: "data.iottwinmaker.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+						},
+						"fips-api-us-east-1": {
+							hostname: "api.iottwinmaker-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+						},
+						"fips-api-us-west-2": {
+							hostname: "api.iottwinmaker-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+						},
+						"fips-data-us-east-1": {
+							hostname: "data.iottwinmaker-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+						},
+						"fips-data-us-west-2": {
+							hostname: "data.iottwinmaker-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+						},
+						"fips-us-east-1": {
+							hostname: "iottwinmaker-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "iottwinmaker-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				IotwirelessServiceID: {
+					id: IotwirelessServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApSoutheast2RegionID,
+						EuWest1RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						ApNortheast1RegionID: {
+							hostname: "api.iotwireless.ap-northeast-1.amazonaws.com",
+						},
+						ApSoutheast2RegionID: {
+							hostname: "api.iotwireless.ap-southeast-2.amazonaws.com",
+						},
+						EuWest1RegionID: {
+							hostname: "api.iotwireless.eu-west-1.amazonaws.com",
+						},
+						UsEast1RegionID: {
+							hostname: "api.iotwireless.us-east-1.amazonaws.com",
+						},
+						UsWest2RegionID: {
+							hostname: "api.iotwireless.us-west-2.amazonaws.com",
+						},
+					},
+				},
+				IvsServiceID: {
+					id: IvsServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				IvschatServiceID: {
+					id: IvschatServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				IvsrealtimeServiceID: {
+					id: IvsrealtimeServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				KafkaServiceID: {
+					id: KafkaServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "kafka-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-west-1": {
+							hostname: "kafka-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "kafka-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "kafka-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "kafka-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "kafka-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				KafkaconnectServiceID: {
+					id: KafkaconnectServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				KendraServiceID: {
+					id: KendraServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "kendra-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "kendra-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "kendra-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "kendra-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				KendraRankingServiceID: {
+					id: KendraRankingServiceID,
+					defaults: endpoint{
+						dnsSuffix: "api.aws",
+						variants: []endpointVariant{
+							{
+								variant:   FIPSVariant,
+								hostname:  "{service}-fips.{region}.{dnsSuffix}",
+								dnsSuffix: "api.aws",
+							},
+						},
+					},
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				KinesisServiceID: {
+					id: KinesisServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "kinesis-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "kinesis-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "kinesis-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "kinesis-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				KinesisanalyticsServiceID: {
+					id: KinesisanalyticsServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				KinesisvideoServiceID: {
+					id: KinesisvideoServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+				},
+				KmsServiceID: {
+					id: KmsServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"ProdFips": {
+							hostname: "kms-fips.eu-central-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuCentral2RegionID,
+							},
+							deprecated: true,
+						},
+						"af-south-1-fips": {
+							hostname: "kms-fips.af-south-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: AfSouth1RegionID,
+							},
+							deprecated: true,
+						},
+						"ap-east-1-fips": {
+							hostname: "kms-fips.ap-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"ap-northeast-1-fips": {
+							hostname: "kms-fips.ap-northeast-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApNortheast1RegionID,
+							},
+							deprecated: true,
+						},
+						"ap-northeast-2-fips": {
+							hostname: "kms-fips.ap-northeast-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApNortheast2RegionID,
+							},
+							deprecated: true,
+						},
+						"ap-northeast-3-fips": {
+							hostname: "kms-fips.ap-northeast-3.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApNortheast3RegionID,
+							},
+							deprecated: true,
+						},
+						"ap-south-1-fips": {
+							hostname: "kms-fips.ap-south-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSouth1RegionID,
+							},
+							deprecated: true,
+						},
+						"ap-south-2-fips": {
+							hostname: "kms-fips.ap-south-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSouth2RegionID,
+							},
+							deprecated: true,
+						},
+						"ap-southeast-1-fips": {
+							hostname: "kms-fips.ap-southeast-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast1RegionID,
+							},
+							deprecated: true,
+						},
+						"ap-southeast-2-fips": {
+							hostname: "kms-fips.ap-southeast-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast2RegionID,
+							},
+							deprecated: true,
+						},
+						"ap-southeast-3-fips": {
+							hostname: "kms-fips.ap-southeast-3.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast3RegionID,
+							},
+							deprecated: true,
+						},
+						"ap-southeast-4-fips": {
+							hostname: "kms-fips.ap-southeast-4.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast4RegionID,
+							},
+							deprecated: true,
+						},
+						"ca-central-1-fips": {
+							hostname: "kms-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"ca-west-1-fips": {
+							hostname: "kms-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"eu-central-1-fips": {
+							hostname: "kms-fips.eu-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"eu-central-2-fips": {
+							hostname: "kms-fips.eu-central-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuCentral2RegionID,
+							},
+							deprecated: true,
+						},
+						"eu-north-1-fips": {
+							hostname: "kms-fips.eu-north-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuNorth1RegionID,
+							},
+							deprecated: true,
+						},
+						"eu-south-1-fips": {
+							hostname: "kms-fips.eu-south-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuSouth1RegionID,
+							},
+							deprecated: true,
+						},
+						"eu-south-2-fips": {
+							hostname: "kms-fips.eu-south-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuSouth2RegionID,
+							},
+							deprecated: true,
+						},
+						"eu-west-1-fips": {
+							hostname: "kms-fips.eu-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"eu-west-2-fips": {
+							hostname: "kms-fips.eu-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuWest2RegionID,
+							},
+							deprecated: true,
+						},
+						"eu-west-3-fips": {
+							hostname: "kms-fips.eu-west-3.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuWest3RegionID,
+							},
+							deprecated: true,
+						},
+						"il-central-1-fips": {
+							hostname: "kms-fips.il-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: IlCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"me-central-1-fips": {
+							hostname: "kms-fips.me-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: MeCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"me-south-1-fips": {
+							hostname: "kms-fips.me-south-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: MeSouth1RegionID,
+							},
+							deprecated: true,
+						},
+						"sa-east-1-fips": {
+							hostname: "kms-fips.sa-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: SaEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-1-fips": {
+							hostname: "kms-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-2-fips": {
+							hostname: "kms-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-1-fips": {
+							hostname: "kms-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "kms-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				LakeformationServiceID: {
+					id: LakeformationServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "lakeformation-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "lakeformation-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "lakeformation-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "lakeformation-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				LambdaServiceID: {
+					id: LambdaServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "lambda-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "lambda-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "lambda-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "lambda-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				LicenseManagerServiceID: {
+					id: LicenseManagerServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "license-manager-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "license-manager-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "license-manager-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "license-manager-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				LicenseManagerLinuxSubscriptionsServiceID: {
+					id: LicenseManagerLinuxSubscriptionsServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "license-manager-linux-subscriptions-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "license-manager-linux-subscriptions-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "license-manager-linux-subscriptions-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "license-manager-linux-subscriptions-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				LicenseManagerUserSubscriptionsServiceID: {
+					id: LicenseManagerUserSubscriptionsServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "license-manager-user-subscriptions-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "license-manager-user-subscriptions-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "license-manager-user-subscriptions-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "license-manager-user-subscriptions-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				LightsailServiceID: {
+					id: LightsailServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+				},
+				LogsServiceID: {
+					id: LogsServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "logs-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-west-1": {
+							hostname: "logs-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "logs-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "logs-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "logs-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "logs-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				LookoutequipmentServiceID: {
+					id: LookoutequipmentServiceID,
+					regions: []string{
+						ApNortheast2RegionID,
+						EuWest1RegionID,
+						UsEast1RegionID,
+					},
+				},
+				M2ServiceID: {
+					id: M2ServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							deprecated: true,
+						},
+					},
+				},
+				MachinelearningServiceID: {
+					id: MachinelearningServiceID,
+					regions: []string{
+						EuWest1RegionID,
+						UsEast1RegionID,
+					},
+				},
+				Macie2ServiceID: {
+					id: Macie2ServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "macie2-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "macie2-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "macie2-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "macie2-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ManagedblockchainServiceID: {
+					id: ManagedblockchainServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSoutheast1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+					},
+				},
+				ManagedblockchainQueryServiceID: {
+					id: ManagedblockchainQueryServiceID,
+					regions: []string{
+						UsEast1RegionID,
+					},
+				},
+				MarketplacecommerceanalyticsServiceID: {
+					id: MarketplacecommerceanalyticsServiceID,
+					regions: []string{
+						UsEast1RegionID,
+					},
+				},
+				MediaPipelinesChimeServiceID: {
+					id: MediaPipelinesChimeServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-east-1-fips": {
+							hostname: "media-pipelines-chime-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "media-pipelines-chime-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				MediaconnectServiceID: {
+					id: MediaconnectServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeCentral1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				MediaconvertServiceID: {
+					id: MediaconvertServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeCentral1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "mediaconvert-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "mediaconvert-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "mediaconvert-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "mediaconvert-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "mediaconvert-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				MedialiveServiceID: {
+					id: MedialiveServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeCentral1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "medialive-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "medialive-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "medialive-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				MediapackageServiceID: {
+					id: MediapackageServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				MediapackageVodServiceID: {
+					id: MediapackageVodServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				Mediapackagev2ServiceID: {
+					id: Mediapackagev2ServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				MediastoreServiceID: {
+					id: MediastoreServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSoutheast2RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				MeetingsChimeServiceID: {
+					id: MeetingsChimeServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest2RegionID,
+						IlCentral1RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"ca-central-1-fips": {
+							hostname: "meetings-chime-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-1-fips": {
+							hostname: "meetings-chime-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "meetings-chime-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				MemoryDbServiceID: {
+					id: MemoryDbServiceID,
+					regions: []string{
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips": {
+							hostname: "memory-db-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+						},
+					},
+				},
+				MessagingChimeServiceID: {
+					id: MessagingChimeServiceID,
+					regions: []string{
+						EuCentral1RegionID,
+						UsEast1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-east-1-fips": {
+							hostname: "messaging-chime-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				MeteringMarketplaceServiceID: {
+					id: MeteringMarketplaceServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "aws-marketplace",
+						},
+					},
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				MetricsSagemakerServiceID: {
+					id: MetricsSagemakerServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				MghServiceID: {
+					id: MghServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApSoutheast2RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				MgnServiceID: {
+					id: MgnServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "mgn-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "mgn-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "mgn-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "mgn-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				MigrationhubOrchestratorServiceID: {
+					id: MigrationhubOrchestratorServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApSoutheast2RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				MigrationhubStrategyServiceID: {
+					id: MigrationhubStrategyServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApSoutheast2RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				MobileanalyticsServiceID: {
+					id: MobileanalyticsServiceID,
+					regions: []string{
+						UsEast1RegionID,
+					},
+				},
+				ModelsV2LexServiceID: {
+					id: ModelsV2LexServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				ModelsLexServiceID: {
+					id: ModelsLexServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "lex",
+						},
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "models-fips.lex.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						ApNortheast1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-east-1-fips": {
+							hostname: "models-fips.lex.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "models-fips.lex.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				MonitoringServiceID: {
+					id: MonitoringServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "monitoring-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "monitoring-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "monitoring-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "monitoring-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				MqServiceID: {
+					id: MqServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "mq-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "mq-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "mq-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "mq-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				MturkRequesterServiceID: {
+					id: MturkRequesterServiceID,
+					regions: []string{
+						UsEast1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"sandbox": {
+							hostname: "mturk-requester-sandbox.us-east-1.amazonaws.com",
+						},
+					},
+				},
+				NeptuneServiceID: {
+					id: NeptuneServiceID,
+					regions: []string{
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						ApEast1RegionID: {
+							hostname: "rds.ap-east-1.amazonaws.com",
+						},
+						ApNortheast1RegionID: {
+							hostname: "rds.ap-northeast-1.amazonaws.com",
+						},
+						ApNortheast2RegionID: {
+							hostname: "rds.ap-northeast-2.amazonaws.com",
+						},
+						ApSouth1RegionID: {
+							hostname: "rds.ap-south-1.amazonaws.com",
+						},
+						ApSoutheast1RegionID: {
+							hostname: "rds.ap-southeast-1.amazonaws.com",
+						},
+						ApSoutheast2RegionID: {
+							hostname: "rds.ap-southeast-2.amazonaws.com",
+						},
+						CaCentral1RegionID: {
+							hostname: "rds.ca-central-1.amazonaws.com",
+						},
+						EuCentral1RegionID: {
+							hostname: "rds.eu-central-1.amazonaws.com",
+						},
+						EuNorth1RegionID: {
+							hostname: "rds.eu-north-1.amazonaws.com",
+						},
+						EuWest1RegionID: {
+							hostname: "rds.eu-west-1.amazonaws.com",
+						},
+						EuWest2RegionID: {
+							hostname: "rds.eu-west-2.amazonaws.com",
+						},
+						EuWest3RegionID: {
+							hostname: "rds.eu-west-3.amazonaws.com",
+						},
+						MeSouth1RegionID: {
+							hostname: "rds.me-south-1.amazonaws.com",
+						},
+						SaEast1RegionID: {
+							hostname: "rds.sa-east-1.amazonaws.com",
+						},
+						UsEast1RegionID: {
+							hostname: "rds.us-east-1.amazonaws.com",
+						},
+						UsEast2RegionID: {
+							hostname: "rds.us-east-2.amazonaws.com",
+						},
+						UsWest1RegionID: {
+							hostname: "rds.us-west-1.amazonaws.com",
+						},
+						UsWest2RegionID: {
+							hostname: "rds.us-west-2.amazonaws.com",
+						},
+					},
+				},
+				NetworkFirewallServiceID: {
+					id: NetworkFirewallServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "network-firewall-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "network-firewall-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "network-firewall-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "network-firewall-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "network-firewall-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				NetworkmanagerServiceID: {
+					id:                NetworkmanagerServiceID,
+					partitionEndpoint: AwsGlobalRegionID,
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						AwsGlobalRegionID: {
+							hostname: "networkmanager.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "networkmanager-fips.us-west-2.amazonaws.com",
+								},
+							},
+						},
+						"fips-aws-global": {
+							hostname: "networkmanager-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				NotificationsServiceID: {
+					id: NotificationsServiceID,
+				},
+				NotificationsContactsServiceID: {
+					id: NotificationsContactsServiceID,
+				},
+				NovaActServiceID: {
+					id: NovaActServiceID,
+				},
+				OamServiceID: {
+					id: OamServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				OidcServiceID: {
+					id: OidcServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				OmicsServiceID: {
+					id: OmicsServiceID,
+					regions: []string{
+						ApSoutheast1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						IlCentral1RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "omics-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "omics-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				OrganizationsServiceID: {
+					id:                OrganizationsServiceID,
+					partitionEndpoint: AwsGlobalRegionID,
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						AwsGlobalRegionID: {
+							hostname: "organizations.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "organizations-fips.us-east-1.amazonaws.com",
+								},
+							},
+						},
+						"fips-aws-global": {
+							hostname: "organizations-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				OsisServiceID: {
+					id: OsisServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				OutpostsServiceID: {
+					id: OutpostsServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "outposts-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "outposts-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "outposts-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "outposts-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "outposts-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ParticipantConnectServiceID: {
+					id: ParticipantConnectServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "participant.connect-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "participant.connect-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				PartnercentralChannelServiceID: {
+					id: PartnercentralChannelServiceID,
+				},
+				PersonalizeServiceID: {
+					id: PersonalizeServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+				},
+				PiServiceID: {
+					id: PiServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "pi-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-west-1": {
+							hostname: "pi-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "pi-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "pi-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "pi-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "pi-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				PinpointServiceID: {
+					id: PinpointServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "mobiletargeting",
+						},
+					},
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "pinpoint-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "pinpoint-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "pinpoint-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "pinpoint-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				PipesServiceID: {
+					id: PipesServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				PollyServiceID: {
+					id: PollyServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "polly-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "polly-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "polly-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "polly-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				PortalSsoServiceID: {
+					id: PortalSsoServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				ProfileServiceID: {
+					id: ProfileServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "profile-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "profile-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "profile-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ProtonServiceID: {
+					id: ProtonServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+				},
+				QbusinessServiceID: {
+					id: QbusinessServiceID,
+					defaults: endpoint{
+						dnsSuffix: "api.aws",
+						variants: []endpointVariant{
+							{
+								variant:   FIPSVariant,
+								hostname:  "{service}-fips.{region}.{dnsSuffix}",
+								dnsSuffix: "api.aws",
+							},
+						},
+					},
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				QueryTimestreamServiceID: {
+					id: QueryTimestreamServiceID,
+				},
+				QuicksightServiceID: {
+					id: QuicksightServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"api": {},
+					},
+				},
+				RamServiceID: {
+					id: RamServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "ram-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-west-1": {
+							hostname: "ram-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "ram-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "ram-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "ram-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "ram-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				RbinServiceID: {
+					id: RbinServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "rbin-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-west-1": {
+							hostname: "rbin-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "rbin-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "rbin-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "rbin-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "rbin-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				RdsServiceID: {
+					id: RdsServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"ca-central-1-fips": {
+							hostname: "rds-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"ca-west-1-fips": {
+							hostname: "rds-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"rds-fips.ca-central-1": {
+							hostname: "rds-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"rds-fips.ca-west-1": {
+							hostname: "rds-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"rds-fips.us-east-1": {
+							hostname: "rds-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"rds-fips.us-east-2": {
+							hostname: "rds-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"rds-fips.us-west-1": {
+							hostname: "rds-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"rds-fips.us-west-2": {
+							hostname: "rds-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+						"rds.ca-central-1": {
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "rds-fips.ca-central-1.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"rds.ca-west-1": {
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "rds-fips.ca-west-1.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"rds.us-east-1": {
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "rds-fips.us-east-1.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"rds.us-east-2": {
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "rds-fips.us-east-2.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"rds.us-west-1": {
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "rds-fips.us-west-1.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"rds.us-west-2": {
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "rds-fips.us-west-2.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"us-east-1-fips": {
+							hostname: "rds-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-2-fips": {
+							hostname: "rds-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-1-fips": {
+							hostname: "rds-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "rds-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				RdsDataServiceID: {
+					id: RdsDataServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "rds-data-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "rds-data-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "rds-data-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "rds-data-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				RedshiftServiceID: {
+					id: RedshiftServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "redshift-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-west-1": {
+							hostname: "redshift-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "redshift-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "redshift-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "redshift-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "redshift-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				RedshiftServerlessServiceID: {
+					id: RedshiftServerlessServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeCentral1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "redshift-serverless-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "redshift-serverless-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "redshift-serverless-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "redshift-serverless-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "redshift-serverless-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				RekognitionServiceID: {
+					id: RekognitionServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						IlCentral1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"ca-central-1-fips": {
+							hostname: "rekognition-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"rekognition-fips.ca-central-1": {
+							hostname: "rekognition-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"rekognition-fips.us-east-1": {
+							hostname: "rekognition-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"rekognition-fips.us-east-2": {
+							hostname: "rekognition-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"rekognition-fips.us-west-1": {
+							hostname: "rekognition-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"rekognition-fips.us-west-2": {
+							hostname: "rekognition-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+						"rekognition.ca-central-1": {
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "rekognition-fips.ca-central-1.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"rekognition.us-east-1": {
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "rekognition-fips.us-east-1.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"rekognition.us-east-2": {
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "rekognition-fips.us-east-2.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"rekognition.us-west-1": {
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "rekognition-fips.us-west-1.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"rekognition.us-west-2": {
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "rekognition-fips.us-west-2.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"us-east-1-fips": {
+							hostname: "rekognition-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-2-fips": {
+							hostname: "rekognition-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-1-fips": {
+							hostname: "rekognition-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "rekognition-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ResiliencehubServiceID: {
+					id: ResiliencehubServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				ResourceExplorer2ServiceID: {
+					id: ResourceExplorer2ServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				ResourceGroupsServiceID: {
+					id: ResourceGroupsServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "resource-groups-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "resource-groups-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "resource-groups-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "resource-groups-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				RolesanywhereServiceID: {
+					id: RolesanywhereServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "rolesanywhere-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "rolesanywhere-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "rolesanywhere-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "rolesanywhere-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				Route53ServiceID: {
+					id:                Route53ServiceID,
+					partitionEndpoint: AwsGlobalRegionID,
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						AwsGlobalRegionID: {
+							hostname: "route53.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "route53-fips.amazonaws.com",
+								},
+							},
+						},
+						"fips-aws-global": {
+							hostname: "route53-fips.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				Route53RecoveryControlConfigServiceID: {
+					id: Route53RecoveryControlConfigServiceID,
+					endpoints: map[string]endpoint{
+						AwsGlobalRegionID: {
+							hostname: "route53-recovery-control-config.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+						},
+					},
+				},
+				Route53domainsServiceID: {
+					id: Route53domainsServiceID,
+					regions: []string{
+						UsEast1RegionID,
+					},
+				},
+				Route53profilesServiceID: {
+					id: Route53profilesServiceID,
+				},
+				Route53resolverServiceID: {
+					id: Route53resolverServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				RumServiceID: {
+					id: RumServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				RuntimeV2LexServiceID: {
+					id: RuntimeV2LexServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				RuntimeLexServiceID: {
+					id: RuntimeLexServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "lex",
+						},
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "runtime-fips.lex.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						ApNortheast1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-east-1-fips": {
+							hostname: "runtime-fips.lex.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "runtime-fips.lex.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				RuntimeSagemakerServiceID: {
+					id: RuntimeSagemakerServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "runtime-fips.sagemaker.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-east-1-fips": {
+							hostname: "runtime-fips.sagemaker.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-2-fips": {
+							hostname: "runtime-fips.sagemaker.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-1-fips": {
+							hostname: "runtime-fips.sagemaker.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "runtime-fips.sagemaker.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				S3ServiceID: {
+					id: S3ServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:   DualStackVariant,
+								hostname:  "{service}.dualstack.{region}.{dnsSuffix}",
+								dnsSuffix: "amazonaws.com",
+							},
+							{
+								variant:   DualStackVariant | FIPSVariant,
+								hostname:  "{service}-fips.dualstack.{region}.{dnsSuffix}",
+								dnsSuffix: "amazonaws.com",
+							},
+						},
+					},
+					partitionEndpoint: AwsGlobalRegionID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						AwsGlobalRegionID: {
+							hostname: "s3.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+						},
+						"fips-ca-central-1": {
+							hostname: "s3-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-west-1": {
+							hostname: "s3-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "s3-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "s3-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "s3-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "s3-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+						"s3-external-1": {
+							hostname: "s3-external-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+						},
+					},
+				},
+				S3ControlServiceID: {
+					id: S3ControlServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:   DualStackVariant,
+								hostname:  "{service}.dualstack.{region}.{dnsSuffix}",
+								dnsSuffix: "amazonaws.com",
+							},
+							{
+								variant:   DualStackVariant | FIPSVariant,
+								hostname:  "{service}-fips.dualstack.{region}.{dnsSuffix}",
+								dnsSuffix: "amazonaws.com",
+							},
+						},
+					},
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"ca-central-1-fips": {
+							hostname: "s3-control-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"ca-west-1-fips": {
+							hostname: "s3-control-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-1-fips": {
+							hostname: "s3-control-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-2-fips": {
+							hostname: "s3-control-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-1-fips": {
+							hostname: "s3-control-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "s3-control-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				S3OutpostsServiceID: {
+					id: S3OutpostsServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							deprecated: true,
+						},
+					},
+				},
+				SagemakerGeospatialServiceID: {
+					id: SagemakerGeospatialServiceID,
+					regions: []string{
+						UsWest2RegionID,
+					},
+				},
+				SavingsplansServiceID: {
+					id:                SavingsplansServiceID,
+					partitionEndpoint: AwsGlobalRegionID,
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						AwsGlobalRegionID: {
+							hostname: "savingsplans.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+						},
+					},
+				},
+				SchedulerServiceID: {
+					id: SchedulerServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				SchemasServiceID: {
+					id: SchemasServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				SdbServiceID: {
+					id: SdbServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						EuWest1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						UsEast1RegionID: {
+							hostname: "sdb.amazonaws.com",
+						},
+					},
+				},
+				SecretsmanagerServiceID: {
+					id: SecretsmanagerServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"ca-central-1-fips": {
+							deprecated: true,
+						},
+						"ca-west-1-fips": {
+							deprecated: true,
+						},
+						"us-east-1-fips": {
+							deprecated: true,
+						},
+						"us-east-2-fips": {
+							deprecated: true,
+						},
+						"us-west-1-fips": {
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							deprecated: true,
+						},
+					},
+				},
+				SecurityhubServiceID: {
+					id: SecurityhubServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "securityhub-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "securityhub-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "securityhub-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "securityhub-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				SecuritylakeServiceID: {
+					id: SecuritylakeServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "securitylake-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "securitylake-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "securitylake-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "securitylake-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ServerlessrepoServiceID: {
+					id: ServerlessrepoServiceID,
+					regions: []string{
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-east-1-fips": {
+							hostname: "serverlessrepo-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-2-fips": {
+							hostname: "serverlessrepo-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-1-fips": {
+							hostname: "serverlessrepo-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "serverlessrepo-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ServicecatalogServiceID: {
+					id: ServicecatalogServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-east-1-fips": {
+							hostname: "servicecatalog-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-2-fips": {
+							hostname: "servicecatalog-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-1-fips": {
+							hostname: "servicecatalog-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "servicecatalog-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ServicecatalogAppregistryServiceID: {
+					id: ServicecatalogAppregistryServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "servicecatalog-appregistry-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "servicecatalog-appregistry-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "servicecatalog-appregistry-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "servicecatalog-appregistry-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "servicecatalog-appregistry-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ServicediscoveryServiceID: {
+					id: ServicediscoveryServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"ca-central-1-fips": {
+							hostname: "servicediscovery-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"ca-west-1-fips": {
+							hostname: "servicediscovery-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-1-fips": {
+							hostname: "servicediscovery-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-2-fips": {
+							hostname: "servicediscovery-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-1-fips": {
+							hostname: "servicediscovery-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "servicediscovery-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ServicequotasServiceID: {
+					id: ServicequotasServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				ShieldServiceID: {
+					id:                ShieldServiceID,
+					partitionEndpoint: AwsGlobalRegionID,
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						AwsGlobalRegionID: {
+							hostname: "shield.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "shield-fips.us-east-1.amazonaws.com",
+								},
+							},
+						},
+						"fips-aws-global": {
+							hostname: "shield-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				SignerServiceID: {
+					id: SignerServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "signer-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "signer-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "signer-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "signer-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-verification-us-east-1": {
+							hostname: "verification.signer-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+						},
+						"fips-verification-us-east-2": {
+							hostname: "verification.signer-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+						},
+						"fips-verification-us-west-1": {
+							hostname: "verification.signer-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+						},
+						"fips-verification-us-west-2": {
+							hostname: "verification.signer-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+						},
+						"verification-af-south-1": {
+							hostname: "verification.signer.af-south-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: AfSouth1RegionID,
+							},
+						},
+						"verification-ap-east-1": {
+							hostname: "verification.signer.ap-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApEast1RegionID,
+							},
+						},
+						"verification-ap-northeast-1": {
+							hostname: "verification.signer.ap-northeast-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApNortheast1RegionID,
+							},
+						},
+						"verification-ap-northeast-2": {
+							hostname: "verification.signer.ap-northeast-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApNortheast2RegionID,
+							},
+						},
+						"verification-ap-south-1": {
+							hostname: "verification.signer.ap-south-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSouth1RegionID,
+							},
+						},
+						"verification-ap-southeast-1": {
+							hostname: "verification.signer.ap-southeast-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast1RegionID,
+							},
+						},
+						"verification-ap-southeast-2": {
+							hostname: "verification.signer.ap-southeast-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast2RegionID,
+							},
+						},
+						"verification-ca-central-1": {
+							hostname: "verification.signer.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+						},
+						"verification-eu-central-1": {
+							hostname: "verification.signer.eu-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuCentral1RegionID,
+							},
+						},
+						"verification-eu-north-1": {
+							hostname: "verification.signer.eu-north-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuNorth1RegionID,
+							},
+						},
+						"verification-eu-south-1": {
+							hostname: "verification.signer.eu-south-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuSouth1RegionID,
+							},
+						},
+						"verification-eu-west-1": {
+							hostname: "verification.signer.eu-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuWest1RegionID,
+							},
+						},
+						"verification-eu-west-2": {
+							hostname: "verification.signer.eu-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuWest2RegionID,
+							},
+						},
+						"verification-eu-west-3": {
+							hostname: "verification.signer.eu-west-3.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuWest3RegionID,
+							},
+						},
+						"verification-me-south-1": {
+							hostname: "verification.signer.me-south-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: MeSouth1RegionID,
+							},
+						},
+						"verification-sa-east-1": {
+							hostname: "verification.signer.sa-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: SaEast1RegionID,
+							},
+						},
+						"verification-us-east-1": {
+							hostname: "verification.signer.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+						},
+						"verification-us-east-2": {
+							hostname: "verification.signer.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+						},
+						"verification-us-west-1": {
+							hostname: "verification.signer.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+						},
+						"verification-us-west-2": {
+							hostname: "verification.signer.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+						},
+					},
+				},
+				SimspaceweaverServiceID: {
+					id: SimspaceweaverServiceID,
+					regions: []string{
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+				},
+				SmsVoiceServiceID: {
+					id: SmsVoiceServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "sms-voice-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "sms-voice-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "sms-voice-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "sms-voice-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "sms-voice-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				SnowballServiceID: {
+					id: SnowballServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ap-northeast-1": {
+							hostname: "snowball-fips.ap-northeast-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApNortheast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-northeast-2": {
+							hostname: "snowball-fips.ap-northeast-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApNortheast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-northeast-3": {
+							hostname: "snowball-fips.ap-northeast-3.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApNortheast3RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-south-1": {
+							hostname: "snowball-fips.ap-south-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSouth1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-southeast-1": {
+							hostname: "snowball-fips.ap-southeast-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-southeast-2": {
+							hostname: "snowball-fips.ap-southeast-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-central-1": {
+							hostname: "snowball-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-central-1": {
+							hostname: "snowball-fips.eu-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-west-1": {
+							hostname: "snowball-fips.eu-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-west-2": {
+							hostname: "snowball-fips.eu-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuWest2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-west-3": {
+							hostname: "snowball-fips.eu-west-3.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuWest3RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-sa-east-1": {
+							hostname: "snowball-fips.sa-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: SaEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "snowball-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "snowball-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "snowball-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "snowball-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				SnsServiceID: {
+					id: SnsServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-west-1": {
+							hostname: "sns-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "sns-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "sns-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "sns-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "sns-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				SqsServiceID: {
+					id: SqsServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "sqs-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "sqs-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "sqs-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "sqs-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				SsmServiceID: {
+					id: SsmServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "ssm-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-west-1": {
+							hostname: "ssm-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "ssm-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "ssm-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "ssm-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "ssm-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				SsmContactsServiceID: {
+					id: SsmContactsServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "ssm-contacts-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "ssm-contacts-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "ssm-contacts-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "ssm-contacts-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				SsmIncidentsServiceID: {
+					id: SsmIncidentsServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "ssm-incidents-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "ssm-incidents-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "ssm-incidents-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "ssm-incidents-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "ssm-incidents-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				SsmQuicksetupServiceID: {
+					id: SsmQuicksetupServiceID,
+				},
+				SsmSapServiceID: {
+					id: SsmSapServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "ssm-sap-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "ssm-sap-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "ssm-sap-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "ssm-sap-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "ssm-sap-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				SsoServiceID: {
+					id: SsoServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				StatesServiceID: {
+					id: StatesServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "states-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "states-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "states-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "states-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				StoragegatewayServiceID: {
+					id: StoragegatewayServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"ca-central-1-fips": {
+							hostname: "storagegateway-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"ca-west-1-fips": {
+							hostname: "storagegateway-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-1-fips": {
+							hostname: "storagegateway-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-2-fips": {
+							hostname: "storagegateway-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-1-fips": {
+							hostname: "storagegateway-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "storagegateway-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				StreamsDynamodbServiceID: {
+					id: StreamsDynamodbServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "dynamodb",
+						},
+					},
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"local": {
+							hostname: "localhost:8000",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+						},
+					},
+				},
+				StsServiceID: {
+					id:                StsServiceID,
+					partitionEndpoint: AwsGlobalRegionID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						AwsGlobalRegionID: {
+							hostname: "sts.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+						},
+						"us-east-1-fips": {
+							hostname: "sts-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-2-fips": {
+							hostname: "sts-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-1-fips": {
+							hostname: "sts-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "sts-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				SupportServiceID: {
+					id:                SupportServiceID,
+					partitionEndpoint: AwsGlobalRegionID,
+					endpoints: map[string]endpoint{
+						AwsGlobalRegionID: {
+							hostname: "support.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+						},
+					},
+				},
+				SupportappServiceID: {
+					id: SupportappServiceID,
+					regions: []string{
+						EuWest1RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				SwfServiceID: {
+					id: SwfServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "swf-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "swf-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "swf-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "swf-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				SyntheticsServiceID: {
+					id: SyntheticsServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "synthetics-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "synthetics-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "synthetics-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "synthetics-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				TaggingServiceID: {
+					id: TaggingServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				TaxServiceID: {
+					id:                TaxServiceID,
+					partitionEndpoint: AwsGlobalRegionID,
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						AwsGlobalRegionID: {
+							hostname: "tax.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+						},
+					},
+				},
+				TextractServiceID: {
+					id: TextractServiceID,
+					regions: []string{
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "textract-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "textract-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "textract-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "textract-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "textract-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ThinclientServiceID: {
+					id: ThinclientServiceID,
+					regions: []string{
+						ApSouth1RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				TnbServiceID: {
+					id: TnbServiceID,
+					regions: []string{
+						ApNortheast2RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuSouth2RegionID,
+						EuWest3RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				TranscribeServiceID: {
+					id: TranscribeServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "fips.transcribe.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "fips.transcribe.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "fips.transcribe.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "fips.transcribe.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "fips.transcribe.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "fips.transcribe.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				TranscribestreamingServiceID: {
+					id: TranscribestreamingServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"transcribestreaming-ca-central-1": {
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "transcribestreaming-fips.ca-central-1.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"transcribestreaming-fips-ca-central-1": {
+							hostname: "transcribestreaming-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"transcribestreaming-fips-us-east-1": {
+							hostname: "transcribestreaming-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"transcribestreaming-fips-us-east-2": {
+							hostname: "transcribestreaming-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"transcribestreaming-fips-us-west-2": {
+							hostname: "transcribestreaming-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+						"transcribestreaming-us-east-1": {
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "transcribestreaming-fips.us-east-1.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"transcribestreaming-us-east-2": {
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "transcribestreaming-fips.us-east-2.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"transcribestreaming-us-west-2": {
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "transcribestreaming-fips.us-west-2.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+					},
+				},
+				TransferServiceID: {
+					id: TransferServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "transfer-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-west-1": {
+							hostname: "transfer-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "transfer-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "transfer-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "transfer-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "transfer-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				TranslateServiceID: {
+					id: TranslateServiceID,
+					regions: []string{
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-east-1-fips": {
+							hostname: "translate-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-2-fips": {
+							hostname: "translate-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-1-fips": {
+							hostname: "translate-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "translate-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				TrustedadvisorServiceID: {
+					id: TrustedadvisorServiceID,
+				},
+				VerifiedpermissionsServiceID: {
+					id: VerifiedpermissionsServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "verifiedpermissions-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-west-1": {
+							hostname: "verifiedpermissions-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "verifiedpermissions-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "verifiedpermissions-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "verifiedpermissions-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "verifiedpermissions-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				VoiceChimeServiceID: {
+					id: VoiceChimeServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"ca-central-1-fips": {
+							hostname: "voice-chime-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-east-1-fips": {
+							hostname: "voice-chime-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-west-2-fips": {
+							hostname: "voice-chime-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				VoiceidServiceID: {
+					id: VoiceidServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-ca-central-1": {
+							hostname: "voiceid-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "voiceid-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "voiceid-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				VpcLatticeServiceID: {
+					id: VpcLatticeServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				WafServiceID: {
+					id:                WafServiceID,
+					partitionEndpoint: AwsGlobalRegionID,
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						"aws": {
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "waf-fips.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"aws-fips": {
+							hostname: "waf-fips.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						AwsGlobalRegionID: {
+							hostname: "waf.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "waf-fips.amazonaws.com",
+								},
+							},
+						},
+						"aws-global-fips": {
+							hostname: "waf-fips.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				WafRegionalServiceID: {
+					id: WafRegionalServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-af-south-1": {
+							hostname: "waf-regional-fips.af-south-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: AfSouth1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-east-1": {
+							hostname: "waf-regional-fips.ap-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-northeast-1": {
+							hostname: "waf-regional-fips.ap-northeast-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApNortheast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-northeast-2": {
+							hostname: "waf-regional-fips.ap-northeast-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApNortheast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-northeast-3": {
+							hostname: "waf-regional-fips.ap-northeast-3.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApNortheast3RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-south-1": {
+							hostname: "waf-regional-fips.ap-south-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSouth1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-south-2": {
+							hostname: "waf-regional-fips.ap-south-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSouth2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-southeast-1": {
+							hostname: "waf-regional-fips.ap-southeast-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-southeast-2": {
+							hostname: "waf-regional-fips.ap-southeast-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-southeast-3": {
+							hostname: "waf-regional-fips.ap-southeast-3.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast3RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-southeast-4": {
+							hostname: "waf-regional-fips.ap-southeast-4.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast4RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-central-1": {
+							hostname: "waf-regional-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-central-1": {
+							hostname: "waf-regional-fips.eu-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-central-2": {
+							hostname: "waf-regional-fips.eu-central-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuCentral2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-north-1": {
+							hostname: "waf-regional-fips.eu-north-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuNorth1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-south-1": {
+							hostname: "waf-regional-fips.eu-south-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuSouth1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-south-2": {
+							hostname: "waf-regional-fips.eu-south-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuSouth2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-west-1": {
+							hostname: "waf-regional-fips.eu-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-west-2": {
+							hostname: "waf-regional-fips.eu-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuWest2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-west-3": {
+							hostname: "waf-regional-fips.eu-west-3.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuWest3RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-il-central-1": {
+							hostname: "waf-regional-fips.il-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: IlCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-me-central-1": {
+							hostname: "waf-regional-fips.me-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: MeCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-me-south-1": {
+							hostname: "waf-regional-fips.me-south-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: MeSouth1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-sa-east-1": {
+							hostname: "waf-regional-fips.sa-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: SaEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "waf-regional-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "waf-regional-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "waf-regional-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "waf-regional-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				Wafv2ServiceID: {
+					id: Wafv2ServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-af-south-1": {
+							hostname: "wafv2-fips.af-south-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: AfSouth1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-east-1": {
+							hostname: "wafv2-fips.ap-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-northeast-1": {
+							hostname: "wafv2-fips.ap-northeast-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApNortheast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-northeast-2": {
+							hostname: "wafv2-fips.ap-northeast-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApNortheast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-northeast-3": {
+							hostname: "wafv2-fips.ap-northeast-3.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApNortheast3RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-south-1": {
+							hostname: "wafv2-fips.ap-south-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSouth1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-south-2": {
+							hostname: "wafv2-fips.ap-south-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSouth2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-southeast-1": {
+							hostname: "wafv2-fips.ap-southeast-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-southeast-2": {
+							hostname: "wafv2-fips.ap-southeast-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-southeast-3": {
+							hostname: "wafv2-fips.ap-southeast-3.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast3RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ap-southeast-4": {
+							hostname: "wafv2-fips.ap-southeast-4.amazonaws.com",
+							credentialScope: credentialScope{
+								region: ApSoutheast4RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-central-1": {
+							hostname: "wafv2-fips.ca-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-ca-west-1": {
+							hostname: "wafv2-fips.ca-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: CaWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-central-1": {
+							hostname: "wafv2-fips.eu-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-central-2": {
+							hostname: "wafv2-fips.eu-central-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuCentral2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-north-1": {
+							hostname: "wafv2-fips.eu-north-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuNorth1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-south-1": {
+							hostname: "wafv2-fips.eu-south-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuSouth1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-south-2": {
+							hostname: "wafv2-fips.eu-south-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuSouth2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-west-1": {
+							hostname: "wafv2-fips.eu-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-west-2": {
+							hostname: "wafv2-fips.eu-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuWest2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-eu-west-3": {
+							hostname: "wafv2-fips.eu-west-3.amazonaws.com",
+							credentialScope: credentialScope{
+								region: EuWest3RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-il-central-1": {
+							hostname: "wafv2-fips.il-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: IlCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-me-central-1": {
+							hostname: "wafv2-fips.me-central-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: MeCentral1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-me-south-1": {
+							hostname: "wafv2-fips.me-south-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: MeSouth1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-sa-east-1": {
+							hostname: "wafv2-fips.sa-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: SaEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-1": {
+							hostname: "wafv2-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "wafv2-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "wafv2-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "wafv2-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				WellarchitectedServiceID: {
+					id: WellarchitectedServiceID,
+					regions: []string{
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuNorth1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				WisdomServiceID: {
+					id: WisdomServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							deprecated: true,
+						},
+						"ui-ap-northeast-1": {},
+						"ui-ap-northeast-2": {},
+						"ui-ap-southeast-1": {},
+						"ui-ap-southeast-2": {},
+						"ui-ca-central-1":   {},
+						"ui-eu-central-1":   {},
+						"ui-eu-west-2":      {},
+						"ui-us-east-1":      {},
+						"ui-us-west-2":      {},
+					},
+				},
+				WorkdocsServiceID: {
+					id: WorkdocsServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						EuWest1RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "workdocs-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "workdocs-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				WorkmailServiceID: {
+					id: WorkmailServiceID,
+					regions: []string{
+						EuWest1RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				WorkspacesServiceID: {
+					id: WorkspacesServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						IlCentral1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "workspaces-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "workspaces-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				WorkspacesWebServiceID: {
+					id: WorkspacesWebServiceID,
+					regions: []string{
+						ApNortheast1RegionID,
+						ApSouth1RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						CaCentral1RegionID,
+						EuCentral1RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						UsEast1RegionID,
+						UsWest2RegionID,
+					},
+				},
+				XrayServiceID: {
+					id: XrayServiceID,
+					regions: []string{
+						AfSouth1RegionID,
+						ApEast1RegionID,
+						ApNortheast1RegionID,
+						ApNortheast2RegionID,
+						ApNortheast3RegionID,
+						ApSouth1RegionID,
+						ApSouth2RegionID,
+						ApSoutheast1RegionID,
+						ApSoutheast2RegionID,
+						ApSoutheast3RegionID,
+						ApSoutheast4RegionID,
+						CaCentral1RegionID,
+						CaWest1RegionID,
+						EuCentral1RegionID,
+						EuCentral2RegionID,
+						EuNorth1RegionID,
+						EuSouth1RegionID,
+						EuSouth2RegionID,
+						EuWest1RegionID,
+						EuWest2RegionID,
+						EuWest3RegionID,
+						IlCentral1RegionID,
+						MeCentral1RegionID,
+						MeSouth1RegionID,
+						SaEast1RegionID,
+						UsEast1RegionID,
+						UsEast2RegionID,
+						UsWest1RegionID,
+						UsWest2RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-east-1": {
+							hostname: "xray-fips.us-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-east-2": {
+							hostname: "xray-fips.us-east-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsEast2RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-1": {
+							hostname: "xray-fips.us-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-west-2": {
+							hostname: "xray-fips.us-west-2.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsWest2RegionID,
+							},
+							deprecated: true,
+						},
+					},
 				},
-				"codeartifact": {
-					id: "codeartifact",
-				},
-				"codebuild": {
-					id: "codebuild",
-				},
-				"codecatalyst": {
-					id: "codecatalyst",
-				},
-				"codecommit": {
-					id: "codecommit",
-				},
-				"codedeploy": {
-					id: "codedeploy",
-				},
-				"codeguru-profiler": {
-					id: "codeguru-profiler",
-				},
-				"codeguru-reviewer": {
-					id: "codeguru-reviewer",
-				},
-				"codepipeline": {
-					id: "codepipeline",
-				},
-				"codestar-connections": {
-					id: "codestar-connections",
-				},
-				"codestar-notifications": {
-					id: "codestar-notifications",
-				},
-				"cognito-identity": {
-					id: "cognito-identity",
-				},
-				"cognito-idp": {
-					id: "cognito-idp",
-				},
-				"cognito-sync": {
-					id: "cognito-sync",
-				},
-				"comprehend": {
-					id: "comprehend",
-				},
-				"comprehendmedical": {
-					id: "comprehendmedical",
-				},
-				"compute-optimizer": {
-					id: "compute-optimizer",
-				},
-				"config": {
-					id: "config",
-				},
-				"connect": {
-					id: "connect",
-				},
-				"connect-campaigns": {
-					id: "connect-campaigns",
-				},
-				"contact-lens": {
-					id: "contact-lens",
-				},
-				"controltower": {
-					id: "controltower",
-				},
-				"cost-optimization-hub": {
-					id: "cost-optimization-hub",
-				},
-				"cur": {
-					id: "cur",
-				},
-				"data-ats.iot": {
-					id: "data-ats.iot",
-				},
-				"data.iot": {
-					id: "data.iot",
-				},
-				"data.jobs.iot": {
-					id: "data.jobs.iot",
-				},
-				"data.mediastore": {
-					id: "data.mediastore",
-				},
-				"databrew": {
-					id: "databrew",
-				},
-				"dataexchange": {
-					id: "dataexchange",
-				},
-				"datapipeline": {
-					id: "datapipeline",
-				},
-				"datasync": {
-					id: "datasync",
-				},
-				"datazone": {
-					id: "datazone",
-				},
-				"dax": {
-					id: "dax",
-				},
-				"devicefarm": {
-					id: "devicefarm",
-				},
-				"devops-guru": {
-					id: "devops-guru",
-				},
-				"directconnect": {
-					id: "directconnect",
-				},
-				"discovery": {
-					id: "discovery",
-				},
-				"dlm": {
-					id: "dlm",
-				},
-				"dms": {
-					id: "dms",
-				},
-				"docdb": {
-					id: "docdb",
-				},
-				"drs": {
-					id: "drs",
-				},
-				"ds": {
-					id: "ds",
-				},
-				"dynamodb": {
-					id: "dynamodb",
-				},
-				"ebs": {
-					id: "ebs",
-				},
-				"ec2": {
-					id: "ec2",
+			},
+		},
+		AwsCnPartitionID: {
+			id:                     AwsCnPartitionID,
+			name:                   "AWS China",
+			dnsSuffix:              "amazonaws.com.cn",
+			dualStackDNSSuffix:     "api.amazonwebservices.com.cn",
+			fipsDNSSuffix:          "amazonaws.com.cn",
+			fipsDualStackDNSSuffix: "api.amazonwebservices.com.cn",
+			implicitGlobalRegion:   CnNorthwest1RegionID,
+			supportsFIPS:           true,
+			supportsDualStack:      true,
+			regionRegex:            regexp.MustCompile(`^cn\-\w+\-\d+$`),
+			defaults: endpoint{
+				hostname: "{service}.{region}.{dnsSuffix}",
+				variants: []endpointVariant{
+					{
+						variant:   DualStackVariant,
+						hostname:  "{service}.{region}.{dnsSuffix}",
+						dnsSuffix: "api.amazonwebservices.com.cn",
+					},
+					{
+						variant:   DualStackVariant | FIPSVariant,
+						hostname:  "{service}-fips.{region}.{dnsSuffix}",
+						dnsSuffix: "api.amazonwebservices.com.cn",
+					},
+					{
+						variant:   FIPSVariant,
+						hostname:  "{service}-fips.{region}.{dnsSuffix}",
+						dnsSuffix: "amazonaws.com.cn",
+					},
 				},
-				"ecs": {
-					id: "ecs",
+			},
+			regions: map[string]Region{
+				CnNorth1RegionID: {
+					id:          CnNorth1RegionID,
+					description: "China (Beijing)",
 				},
-				"edge.sagemaker": {
-					id: "edge.sagemaker",
+				CnNorthwest1RegionID: {
+					id:          CnNorthwest1RegionID,
+					description: "China (Ningxia)",
 				},
-				"eks": {
-					id: "eks",
+			},
+			services: map[string]Service{
+				AccessAnalyzerServiceID: {
+					id: AccessAnalyzerServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				AccountServiceID: {
+					id:                AccountServiceID,
+					partitionEndpoint: "aws-cn-global",
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						"aws-cn-global": {
+							hostname: "account.cn-northwest-1.amazonaws.com.cn",
+							credentialScope: credentialScope{
+								region: CnNorthwest1RegionID,
+							},
+						},
+					},
+				},
+				AcmServiceID: {
+					id: AcmServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				AcmPcaServiceID: {
+					id: AcmPcaServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				AirflowServiceID: {
+					id: AirflowServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				ApiEcrServiceID: {
+					id: ApiEcrServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				ApiPricingServiceID: {
+					id: ApiPricingServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "pricing",
+						},
+					},
+					regions: []string{
+						CnNorthwest1RegionID,
+					},
+				},
+				ApiSagemakerServiceID: {
+					id: ApiSagemakerServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				ApiTunnelingIotServiceID: {
+					id: ApiTunnelingIotServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				ApigatewayServiceID: {
+					id: ApigatewayServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				AppconfigServiceID: {
+					id: AppconfigServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				AppconfigdataServiceID: {
+					id: AppconfigdataServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				ApplicationAutoscalingServiceID: {
+					id: ApplicationAutoscalingServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				ApplicationinsightsServiceID: {
+					id: ApplicationinsightsServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				AppmeshServiceID: {
+					id: AppmeshServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				AppsyncServiceID: {
+					id: AppsyncServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				ArcZonalShiftServiceID: {
+					id: ArcZonalShiftServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				AthenaServiceID: {
+					id: AthenaServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				AutoscalingServiceID: {
+					id: AutoscalingServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				AutoscalingPlansServiceID: {
+					id: AutoscalingPlansServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				BackupServiceID: {
+					id: BackupServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				BatchServiceID: {
+					id: BatchServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				BudgetsServiceID: {
+					id:                BudgetsServiceID,
+					partitionEndpoint: "aws-cn-global",
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						"aws-cn-global": {
+							hostname: "budgets.amazonaws.com.cn",
+							credentialScope: credentialScope{
+								region: CnNorthwest1RegionID,
+							},
+						},
+					},
+				},
+				CassandraServiceID: {
+					id: CassandraServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				CeServiceID: {
+					id:                CeServiceID,
+					partitionEndpoint: "aws-cn-global",
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						"aws-cn-global": {
+							hostname: "ce.cn-northwest-1.amazonaws.com.cn",
+							credentialScope: credentialScope{
+								region: CnNorthwest1RegionID,
+							},
+						},
+					},
+				},
+				CloudcontrolapiServiceID: {
+					id: CloudcontrolapiServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				CloudformationServiceID: {
+					id: CloudformationServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				CloudfrontServiceID: {
+					id:                CloudfrontServiceID,
+					partitionEndpoint: "aws-cn-global",
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						"aws-cn-global": {
+							hostname: "cloudfront.cn-northwest-1.amazonaws.com.cn",
+							credentialScope: credentialScope{
+								region: CnNorthwest1RegionID,
+							},
+						},
+					},
+				},
+				CloudtrailServiceID: {
+					id: CloudtrailServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				CodebuildServiceID: {
+					id: CodebuildServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				CodecommitServiceID: {
+					id: CodecommitServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				CodedeployServiceID: {
+					id: CodedeployServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				CodepipelineServiceID: {
+					id: CodepipelineServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				CognitoIdentityServiceID: {
+					id: CognitoIdentityServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+					},
+				},
+				ComputeOptimizerServiceID: {
+					id: ComputeOptimizerServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				ConfigServiceID: {
+					id: ConfigServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				CurServiceID: {
+					id: CurServiceID,
+					regions: []string{
+						CnNorthwest1RegionID,
+					},
+				},
+				DataAtsIotServiceID: {
+					id: DataAtsIotServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "iotdata",
+						},
+					},
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						CnNorth1RegionID: {
+							hostname: "data.ats.iot.cn-north-1.amazonaws.com.cn",
+						},
+					},
+				},
+				DataIotServiceID: {
+					id: DataIotServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "iotdata",
+						},
+					},
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				DataJobsIotServiceID: {
+					id: DataJobsIotServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				DatabrewServiceID: {
+					id: DatabrewServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				DatasyncServiceID: {
+					id: DatasyncServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				DatazoneServiceID: {
+					id: DatazoneServiceID,
+					defaults: endpoint{
+						dnsSuffix: "api.amazonwebservices.com.cn",
+						variants: []endpointVariant{
+							{
+								variant:   FIPSVariant,
+								hostname:  "{service}-fips.{region}.{dnsSuffix}",
+								dnsSuffix: "api.amazonwebservices.com.cn",
+							},
+						},
+					},
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				DaxServiceID: {
+					id: DaxServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				DirectconnectServiceID: {
+					id: DirectconnectServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				DlmServiceID: {
+					id: DlmServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				DmsServiceID: {
+					id: DmsServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				DocdbServiceID: {
+					id: DocdbServiceID,
+					regions: []string{
+						CnNorthwest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						CnNorthwest1RegionID: {
+							hostname: "rds.cn-northwest-1.amazonaws.com.cn",
+						},
+					},
+				},
+				DsServiceID: {
+					id: DsServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				DynamodbServiceID: {
+					id: DynamodbServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				EbsServiceID: {
+					id: EbsServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				Ec2ServiceID: {
+					id: Ec2ServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				EcsServiceID: {
+					id: EcsServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				EksServiceID: {
+					id: EksServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				EksAuthServiceID: {
+					id: EksAuthServiceID,
+					defaults: endpoint{
+						dnsSuffix: "api.amazonwebservices.com.cn",
+						variants: []endpointVariant{
+							{
+								variant:   FIPSVariant,
+								hostname:  "{service}-fips.{region}.{dnsSuffix}",
+								dnsSuffix: "api.amazonwebservices.com.cn",
+							},
+						},
+					},
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				ElasticacheServiceID: {
+					id: ElasticacheServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				ElasticbeanstalkServiceID: {
+					id: ElasticbeanstalkServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				ElasticfilesystemServiceID: {
+					id: ElasticfilesystemServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-cn-north-1": {
+							hostname: "elasticfilesystem-fips.cn-north-1.amazonaws.com.cn",
+							credentialScope: credentialScope{
+								region: CnNorth1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-cn-northwest-1": {
+							hostname: "elasticfilesystem-fips.cn-northwest-1.amazonaws.com.cn",
+							credentialScope: credentialScope{
+								region: CnNorthwest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ElasticloadbalancingServiceID: {
+					id: ElasticloadbalancingServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				ElasticmapreduceServiceID: {
+					id: ElasticmapreduceServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				EmrContainersServiceID: {
+					id: EmrContainersServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				EmrServerlessServiceID: {
+					id: EmrServerlessServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				EntitlementMarketplaceServiceID: {
+					id: EntitlementMarketplaceServiceID,
+					regions: []string{
+						CnNorthwest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						CnNorthwest1RegionID: {
+							hostname: "entitlement-marketplace.cn-northwest-1.amazonaws.com.cn",
+						},
+					},
+				},
+				EsServiceID: {
+					id: EsServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						CnNorth1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.cn-north-1.api.amazonwebservices.com.cn",
+								},
+							},
+						},
+						CnNorthwest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.cn-northwest-1.api.amazonwebservices.com.cn",
+								},
+							},
+						},
+					},
+				},
+				EventsServiceID: {
+					id: EventsServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				FirehoseServiceID: {
+					id: FirehoseServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				FmsServiceID: {
+					id: FmsServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				FsxServiceID: {
+					id: FsxServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				GameliftServiceID: {
+					id: GameliftServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				GameliftstreamsServiceID: {
+					id: GameliftstreamsServiceID,
+				},
+				GlacierServiceID: {
+					id: GlacierServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				GlueServiceID: {
+					id: GlueServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				GreengrassServiceID: {
+					id: GreengrassServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+					},
+				},
+				GuarddutyServiceID: {
+					id: GuarddutyServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				HealthServiceID: {
+					id:                HealthServiceID,
+					partitionEndpoint: "aws-cn-global",
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						"aws-cn-global": {
+							hostname: "global.health.amazonaws.com.cn",
+							credentialScope: credentialScope{
+								region: CnNorthwest1RegionID,
+							},
+						},
+					},
+				},
+				IamServiceID: {
+					id:                IamServiceID,
+					partitionEndpoint: "aws-cn-global",
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						"aws-cn-global": {
+							hostname: "iam.cn-north-1.amazonaws.com.cn",
+							credentialScope: credentialScope{
+								region: CnNorth1RegionID,
+							},
+						},
+					},
+				},
+				IdentitystoreServiceID: {
+					id: IdentitystoreServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				Inspector2ServiceID: {
+					id: Inspector2ServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				InternetmonitorServiceID: {
+					id: InternetmonitorServiceID,
+					defaults: endpoint{
+						dnsSuffix: "api.amazonwebservices.com.cn",
+						variants: []endpointVariant{
+							{
+								variant:   FIPSVariant,
+								hostname:  "{service}-fips.{region}.{dnsSuffix}",
+								dnsSuffix: "api.amazonwebservices.com.cn",
+							},
+						},
+					},
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				IotServiceID: {
+					id: IotServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				IoteventsServiceID: {
+					id: IoteventsServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+					},
+				},
+				IoteventsdataServiceID: {
+					id: IoteventsdataServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						CnNorth1RegionID: {
+							hostname: "data.iotevents.cn-north-1.amazonaws.com.cn",
+						},
+					},
+				},
+				IotsecuredtunnelingServiceID: {
+					id: IotsecuredtunnelingServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				IotsitewiseServiceID: {
+					id: IotsitewiseServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+					},
+				},
+				IottwinmakerServiceID: {
+					id: IottwinmakerServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"api-cn-north-1": {
+							hostname: "api.iottwinmaker.cn-north-1.amazonaws.com.cn",
+							credentialScope: credentialScope{
+								region: CnNorth1RegionID,
+							},
+						},
+						"data-cn-north-1": {
+							hostname: "data.iottwinmaker.cn-north-1.amazonaws.com.cn",
+							credentialScope: credentialScope{
+								region: CnNorth1RegionID,
+							},
+						},
+					},
+				},
+				KafkaServiceID: {
+					id: KafkaServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				KafkaconnectServiceID: {
+					id: KafkaconnectServiceID,
+				},
+				KendraRankingServiceID: {
+					id: KendraRankingServiceID,
+					defaults: endpoint{
+						dnsSuffix: "api.amazonwebservices.com.cn",
+						variants: []endpointVariant{
+							{
+								variant:   FIPSVariant,
+								hostname:  "{service}-fips.{region}.{dnsSuffix}",
+								dnsSuffix: "api.amazonwebservices.com.cn",
+							},
+						},
+					},
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				KinesisServiceID: {
+					id: KinesisServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				KinesisanalyticsServiceID: {
+					id: KinesisanalyticsServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				KinesisvideoServiceID: {
+					id: KinesisvideoServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+					},
+				},
+				KmsServiceID: {
+					id: KmsServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				LakeformationServiceID: {
+					id: LakeformationServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				LambdaServiceID: {
+					id: LambdaServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				LicenseManagerServiceID: {
+					id: LicenseManagerServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				LicenseManagerLinuxSubscriptionsServiceID: {
+					id: LicenseManagerLinuxSubscriptionsServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				LogsServiceID: {
+					id: LogsServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				MediaconvertServiceID: {
+					id: MediaconvertServiceID,
+					regions: []string{
+						CnNorthwest1RegionID,
+					},
+				},
+				MemoryDbServiceID: {
+					id: MemoryDbServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				MeteringMarketplaceServiceID: {
+					id: MeteringMarketplaceServiceID,
+				},
+				MetricsSagemakerServiceID: {
+					id: MetricsSagemakerServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				MonitoringServiceID: {
+					id: MonitoringServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				MqServiceID: {
+					id: MqServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				NeptuneServiceID: {
+					id: NeptuneServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						CnNorth1RegionID: {
+							hostname: "rds.cn-north-1.amazonaws.com.cn",
+						},
+						CnNorthwest1RegionID: {
+							hostname: "rds.cn-northwest-1.amazonaws.com.cn",
+						},
+					},
+				},
+				NetworkFirewallServiceID: {
+					id: NetworkFirewallServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				NotificationsServiceID: {
+					id: NotificationsServiceID,
+				},
+				OamServiceID: {
+					id: OamServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				OidcServiceID: {
+					id: OidcServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				OrganizationsServiceID: {
+					id:                OrganizationsServiceID,
+					partitionEndpoint: "aws-cn-global",
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						"aws-cn-global": {
+							hostname: "organizations.cn-northwest-1.amazonaws.com.cn",
+							credentialScope: credentialScope{
+								region: CnNorthwest1RegionID,
+							},
+						},
+					},
+				},
+				PersonalizeServiceID: {
+					id: PersonalizeServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+					},
+				},
+				PiServiceID: {
+					id: PiServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				PipesServiceID: {
+					id: PipesServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				PollyServiceID: {
+					id: PollyServiceID,
+					regions: []string{
+						CnNorthwest1RegionID,
+					},
+				},
+				PortalSsoServiceID: {
+					id: PortalSsoServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				QbusinessServiceID: {
+					id: QbusinessServiceID,
+					defaults: endpoint{
+						dnsSuffix: "api.amazonwebservices.com.cn",
+						variants: []endpointVariant{
+							{
+								variant:   FIPSVariant,
+								hostname:  "{service}-fips.{region}.{dnsSuffix}",
+								dnsSuffix: "api.amazonwebservices.com.cn",
+							},
+						},
+					},
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				QuicksightServiceID: {
+					id: QuicksightServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+					},
+				},
+				RamServiceID: {
+					id: RamServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				RbinServiceID: {
+					id: RbinServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				RdsServiceID: {
+					id: RdsServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				RedshiftServiceID: {
+					id: RedshiftServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				RedshiftServerlessServiceID: {
+					id: RedshiftServerlessServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				ResourceGroupsServiceID: {
+					id: ResourceGroupsServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				RolesanywhereServiceID: {
+					id: RolesanywhereServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				Route53ServiceID: {
+					id:                Route53ServiceID,
+					partitionEndpoint: "aws-cn-global",
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						"aws-cn-global": {
+							hostname: "route53.amazonaws.com.cn",
+							credentialScope: credentialScope{
+								region: CnNorthwest1RegionID,
+							},
+						},
+					},
+				},
+				Route53profilesServiceID: {
+					id: Route53profilesServiceID,
+				},
+				Route53resolverServiceID: {
+					id: Route53resolverServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				RuntimeSagemakerServiceID: {
+					id: RuntimeSagemakerServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				S3ServiceID: {
+					id: S3ServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:   DualStackVariant,
+								hostname:  "{service}.dualstack.{region}.{dnsSuffix}",
+								dnsSuffix: "amazonaws.com.cn",
+							},
+						},
+					},
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				S3ControlServiceID: {
+					id: S3ControlServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:   DualStackVariant,
+								hostname:  "{service}.dualstack.{region}.{dnsSuffix}",
+								dnsSuffix: "amazonaws.com.cn",
+							},
+						},
+					},
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				SavingsplansServiceID: {
+					id: SavingsplansServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				SchedulerServiceID: {
+					id: SchedulerServiceID,
+				},
+				SchemasServiceID: {
+					id: SchemasServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				SecretsmanagerServiceID: {
+					id: SecretsmanagerServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				SecurityhubServiceID: {
+					id: SecurityhubServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				ServerlessrepoServiceID: {
+					id: ServerlessrepoServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				ServicecatalogServiceID: {
+					id: ServicecatalogServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				ServicediscoveryServiceID: {
+					id: ServicediscoveryServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				ServicequotasServiceID: {
+					id: ServicequotasServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				SignerServiceID: {
+					id: SignerServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"verification-cn-north-1": {
+							hostname: "verification.signer.cn-north-1.amazonaws.com.cn",
+							credentialScope: credentialScope{
+								region: CnNorth1RegionID,
+							},
+						},
+						"verification-cn-northwest-1": {
+							hostname: "verification.signer.cn-northwest-1.amazonaws.com.cn",
+							credentialScope: credentialScope{
+								region: CnNorthwest1RegionID,
+							},
+						},
+					},
+				},
+				SnowballServiceID: {
+					id: SnowballServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-cn-north-1": {
+							hostname: "snowball-fips.cn-north-1.amazonaws.com.cn",
+							credentialScope: credentialScope{
+								region: CnNorth1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-cn-northwest-1": {
+							hostname: "snowball-fips.cn-northwest-1.amazonaws.com.cn",
+							credentialScope: credentialScope{
+								region: CnNorthwest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				SnsServiceID: {
+					id: SnsServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				SqsServiceID: {
+					id: SqsServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				SsmServiceID: {
+					id: SsmServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				SsoServiceID: {
+					id: SsoServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				StatesServiceID: {
+					id: StatesServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				StoragegatewayServiceID: {
+					id: StoragegatewayServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				StreamsDynamodbServiceID: {
+					id: StreamsDynamodbServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "dynamodb",
+						},
+					},
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				StsServiceID: {
+					id: StsServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				SupportServiceID: {
+					id:                SupportServiceID,
+					partitionEndpoint: "aws-cn-global",
+					endpoints: map[string]endpoint{
+						"aws-cn-global": {
+							hostname: "support.cn-north-1.amazonaws.com.cn",
+							credentialScope: credentialScope{
+								region: CnNorth1RegionID,
+							},
+						},
+					},
+				},
+				SwfServiceID: {
+					id: SwfServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				SyntheticsServiceID: {
+					id: SyntheticsServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				TaggingServiceID: {
+					id: TaggingServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				TranscribeServiceID: {
+					id: TranscribeServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						CnNorth1RegionID: {
+							hostname: "cn.transcribe.cn-north-1.amazonaws.com.cn",
+						},
+						CnNorthwest1RegionID: {
+							hostname: "cn.transcribe.cn-northwest-1.amazonaws.com.cn",
+						},
+					},
+				},
+				TranscribestreamingServiceID: {
+					id: TranscribestreamingServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				TransferServiceID: {
+					id: TransferServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+				},
+				VerifiedpermissionsServiceID: {
+					id: VerifiedpermissionsServiceID,
+				},
+				WafRegionalServiceID: {
+					id: WafRegionalServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-cn-north-1": {
+							hostname: "waf-regional-fips.cn-north-1.amazonaws.com.cn",
+							credentialScope: credentialScope{
+								region: CnNorth1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-cn-northwest-1": {
+							hostname: "waf-regional-fips.cn-northwest-1.amazonaws.com.cn",
+							credentialScope: credentialScope{
+								region: CnNorthwest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				Wafv2ServiceID: {
+					id: Wafv2ServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-cn-north-1": {
+							hostname: "wafv2-fips.cn-north-1.amazonaws.com.cn",
+							credentialScope: credentialScope{
+								region: CnNorth1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-cn-northwest-1": {
+							hostname: "wafv2-fips.cn-northwest-1.amazonaws.com.cn",
+							credentialScope: credentialScope{
+								region: CnNorthwest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				WorkspacesServiceID: {
+					id: WorkspacesServiceID,
+					regions: []string{
+						CnNorthwest1RegionID,
+					},
+				},
+				XrayServiceID: {
+					id: XrayServiceID,
+					regions: []string{
+						CnNorth1RegionID,
+						CnNorthwest1RegionID,
+					},
 				},
-				"eks-auth": {
-					id: "eks-auth",
+			},
+		},
+		AwsEuscPartitionID: {
+			id:                     AwsEuscPartitionID,
+			name:                   "AWS EUSC",
+			dnsSuffix:              "amazonaws.eu",
+			dualStackDNSSuffix:     "api.amazonwebservices.eu",
+			fipsDNSSuffix:          "amazonaws.eu",
+			fipsDualStackDNSSuffix: "api.amazonwebservices.eu",
+			implicitGlobalRegion:   EuscDeEast1RegionID,
+			supportsFIPS:           true,
+			supportsDualStack:      true,
+			regionRegex:            regexp.MustCompile(`^eusc\-(de)\-\w+\-\d+$`),
+			defaults: endpoint{
+				hostname: "{service}.{region}.{dnsSuffix}",
+				variants: []endpointVariant{
+					{
+						variant:   DualStackVariant,
+						hostname:  "{service}.{region}.{dnsSuffix}",
+						dnsSuffix: "api.amazonwebservices.eu",
+					},
+					{
+						variant:   DualStackVariant | FIPSVariant,
+						hostname:  "{service}-fips.{region}.{dnsSuffix}",
+						dnsSuffix: "api.amazonwebservices.eu",
+					},
+					{
+						variant:   FIPSVariant,
+						hostname:  "{service}-fips.{region}.{dnsSuffix}",
+						dnsSuffix: "amazonaws.eu",
+					},
 				},
-				"elasticache": {
-					id: "elasticache",
+			},
+			regions: map[string]Region{
+				EuscDeEast1RegionID: {
+					id:          EuscDeEast1RegionID,
+					description: "AWS European Sovereign Cloud (Germany)",
 				},
-				"elasticbeanstalk": {
-					id: "elasticbeanstalk",
+			},
+			services: map[string]Service{
+				AccessAnalyzerServiceID: {
+					id: AccessAnalyzerServiceID,
 				},
-				"elasticfilesystem": {
-					id: "elasticfilesystem",
+				AcmServiceID: {
+					id: AcmServiceID,
 				},
-				"elasticloadbalancing": {
-					id: "elasticloadbalancing",
+				AcmPcaServiceID: {
+					id: AcmPcaServiceID,
 				},
-				"elasticmapreduce": {
-					id: "elasticmapreduce",
+				AgreementMarketplaceServiceID: {
+					id: AgreementMarketplaceServiceID,
 				},
-				"email": {
-					id: "email",
+				ApiEcrServiceID: {
+					id: ApiEcrServiceID,
 				},
-				"emr-containers": {
-					id: "emr-containers",
+				ApiPricingServiceID: {
+					id: ApiPricingServiceID,
 				},
-				"emr-serverless": {
-					id: "emr-serverless",
+				ApiSagemakerServiceID: {
+					id: ApiSagemakerServiceID,
 				},
-				"entitlement.marketplace": {
-					id: "entitlement.marketplace",
+				ApigatewayServiceID: {
+					id: ApigatewayServiceID,
 				},
-				"es": {
-					id: "es",
+				AppconfigServiceID: {
+					id: AppconfigServiceID,
 				},
-				"events": {
-					id: "events",
+				AppconfigdataServiceID: {
+					id: AppconfigdataServiceID,
 				},
-				"finspace": {
-					id: "finspace",
+				ApplicationAutoscalingServiceID: {
+					id: ApplicationAutoscalingServiceID,
 				},
-				"finspace-api": {
-					id: "finspace-api",
+				ArcZonalShiftServiceID: {
+					id: ArcZonalShiftServiceID,
 				},
-				"firehose": {
-					id: "firehose",
+				AthenaServiceID: {
+					id: AthenaServiceID,
 				},
-				"fms": {
-					id: "fms",
+				AutoscalingServiceID: {
+					id: AutoscalingServiceID,
 				},
-				"forecast": {
-					id: "forecast",
+				BackupServiceID: {
+					id: BackupServiceID,
 				},
-				"forecastquery": {
-					id: "forecastquery",
+				BatchServiceID: {
+					id: BatchServiceID,
 				},
-				"frauddetector": {
-					id: "frauddetector",
+				BedrockServiceID: {
+					id: BedrockServiceID,
 				},
-				"fsx": {
-					id: "fsx",
+				CloudcontrolapiServiceID: {
+					id: CloudcontrolapiServiceID,
 				},
-				"gamelift": {
-					id: "gamelift",
+				CloudformationServiceID: {
+					id: CloudformationServiceID,
 				},
-				"gameliftstreams": {
-					id: "gameliftstreams",
+				CloudtrailServiceID: {
+					id: CloudtrailServiceID,
 				},
-				"geo": {
-					id: "geo",
+				CodedeployServiceID: {
+					id: CodedeployServiceID,
 				},
-				"glacier": {
-					id: "glacier",
+				CognitoIdentityServiceID: {
+					id: CognitoIdentityServiceID,
 				},
-				"globalaccelerator": {
-					id: "globalaccelerator",
+				CognitoIdpServiceID: {
+					id: CognitoIdpServiceID,
 				},
-				"glue": {
-					id: "glue",
+				ComputeOptimizerServiceID: {
+					id: ComputeOptimizerServiceID,
 				},
-				"grafana": {
-					id: "grafana",
+				ConfigServiceID: {
+					id: ConfigServiceID,
 				},
-				"greengrass": {
-					id: "greengrass",
+				ControltowerServiceID: {
+					id: ControltowerServiceID,
 				},
-				"groundstation": {
-					id: "groundstation",
+				CostOptimizationHubServiceID: {
+					id: CostOptimizationHubServiceID,
 				},
-				"guardduty": {
-					id: "guardduty",
+				DatasyncServiceID: {
+					id: DatasyncServiceID,
 				},
-				"health": {
-					id: "health",
+				DatazoneServiceID: {
+					id: DatazoneServiceID,
 				},
-				"healthlake": {
-					id: "healthlake",
+				DirectconnectServiceID: {
+					id: DirectconnectServiceID,
 				},
-				"iam": {
-					id: "iam",
+				DlmServiceID: {
+					id: DlmServiceID,
 				},
-				"identity-chime": {
-					id: "identity-chime",
+				DmsServiceID: {
+					id: DmsServiceID,
 				},
-				"identitystore": {
-					id: "identitystore",
+				DrsServiceID: {
+					id: DrsServiceID,
 				},
-				"importexport": {
-					id: "importexport",
+				DsServiceID: {
+					id: DsServiceID,
 				},
-				"ingest.timestream": {
-					id: "ingest.timestream",
+				DynamodbServiceID: {
+					id: DynamodbServiceID,
 				},
-				"inspector": {
-					id: "inspector",
+				EbsServiceID: {
+					id: EbsServiceID,
 				},
-				"inspector2": {
-					id: "inspector2",
+				Ec2ServiceID: {
+					id: Ec2ServiceID,
 				},
-				"internetmonitor": {
-					id: "internetmonitor",
+				EcsServiceID: {
+					id: EcsServiceID,
 				},
-				"iot": {
-					id: "iot",
+				EksServiceID: {
+					id: EksServiceID,
 				},
-				"iotevents": {
-					id: "iotevents",
+				EksAuthServiceID: {
+					id: EksAuthServiceID,
 				},
-				"ioteventsdata": {
-					id: "ioteventsdata",
+				ElasticacheServiceID: {
+					id: ElasticacheServiceID,
 				},
-				"iotfleetwise": {
-					id: "iotfleetwise",
+				ElasticfilesystemServiceID: {
+					id: ElasticfilesystemServiceID,
 				},
-				"iotsecuredtunneling": {
-					id: "iotsecuredtunneling",
+				ElasticloadbalancingServiceID: {
+					id: ElasticloadbalancingServiceID,
 				},
-				"iotsitewise": {
-					id: "iotsitewise",
+				ElasticmapreduceServiceID: {
+					id: ElasticmapreduceServiceID,
 				},
-				"iotthingsgraph": {
-					id: "iotthingsgraph",
+				EmailServiceID: {
+					id: EmailServiceID,
 				},
-				"iottwinmaker": {
-					id: "iottwinmaker",
+				EntitlementMarketplaceServiceID: {
+					id: EntitlementMarketplaceServiceID,
 				},
-				"iotwireless": {
-					id: "iotwireless",
+				EsServiceID: {
+					id: EsServiceID,
 				},
-				"ivs": {
-					id: "ivs",
+				EventsServiceID: {
+					id: EventsServiceID,
 				},
-				"ivschat": {
-					id: "ivschat",
+				FirehoseServiceID: {
+					id: FirehoseServiceID,
 				},
-				"ivsrealtime": {
-					id: "ivsrealtime",
+				FsxServiceID: {
+					id: FsxServiceID,
 				},
-				"kafka": {
-					id: "kafka",
+				GameliftstreamsServiceID: {
+					id: GameliftstreamsServiceID,
 				},
-				"kafkaconnect": {
-					id: "kafkaconnect",
+				GlueServiceID: {
+					id: GlueServiceID,
 				},
-				"kendra": {
-					id: "kendra",
+				GuarddutyServiceID: {
+					id: GuarddutyServiceID,
 				},
-				"kendra-ranking": {
-					id: "kendra-ranking",
+				HealthServiceID: {
+					id: HealthServiceID,
 				},
-				"kinesis": {
-					id: "kinesis",
+				IdentitystoreServiceID: {
+					id: IdentitystoreServiceID,
 				},
-				"kinesisanalytics": {
-					id: "kinesisanalytics",
+				InternetmonitorServiceID: {
+					id: InternetmonitorServiceID,
 				},
-				"kinesisvideo": {
-					id: "kinesisvideo",
+				KafkaServiceID: {
+					id: KafkaServiceID,
 				},
-				"kms": {
-					id: "kms",
+				KendraRankingServiceID: {
+					id: KendraRankingServiceID,
 				},
-				"lakeformation": {
-					id: "lakeformation",
+				KinesisServiceID: {
+					id: KinesisServiceID,
 				},
-				"lambda": {
-					id: "lambda",
+				KinesisanalyticsServiceID: {
+					id: KinesisanalyticsServiceID,
 				},
-				"license-manager": {
-					id: "license-manager",
+				KmsServiceID: {
+					id: KmsServiceID,
 				},
-				"license-manager-linux-subscriptions": {
-					id: "license-manager-linux-subscriptions",
+				LakeformationServiceID: {
+					id: LakeformationServiceID,
 				},
-				"license-manager-user-subscriptions": {
-					id: "license-manager-user-subscriptions",
+				LambdaServiceID: {
+					id: LambdaServiceID,
 				},
-				"lightsail": {
-					id: "lightsail",
+				LicenseManagerServiceID: {
+					id: LicenseManagerServiceID,
 				},
-				"logs": {
-					id: "logs",
+				LogsServiceID: {
+					id: LogsServiceID,
 				},
-				"lookoutequipment": {
-					id: "lookoutequipment",
+				MeteringMarketplaceServiceID: {
+					id: MeteringMarketplaceServiceID,
 				},
-				"m2": {
-					id: "m2",
+				MetricsSagemakerServiceID: {
+					id: MetricsSagemakerServiceID,
 				},
-				"machinelearning": {
-					id: "machinelearning",
+				MonitoringServiceID: {
+					id: MonitoringServiceID,
 				},
-				"macie2": {
-					id: "macie2",
+				NetworkFirewallServiceID: {
+					id: NetworkFirewallServiceID,
 				},
-				"managedblockchain": {
-					id: "managedblockchain",
+				NotificationsServiceID: {
+					id: NotificationsServiceID,
 				},
-				"managedblockchain-query": {
-					id: "managedblockchain-query",
+				OamServiceID: {
+					id: OamServiceID,
 				},
-				"marketplacecommerceanalytics": {
-					id: "marketplacecommerceanalytics",
+				OidcServiceID: {
+					id: OidcServiceID,
 				},
-				"media-pipelines-chime": {
-					id: "media-pipelines-chime",
+				PiServiceID: {
+					id: PiServiceID,
 				},
-				"mediaconnect": {
-					id: "mediaconnect",
+				PollyServiceID: {
+					id: PollyServiceID,
 				},
-				"mediaconvert": {
-					id: "mediaconvert",
+				PortalSsoServiceID: {
+					id: PortalSsoServiceID,
 				},
-				"medialive": {
-					id: "medialive",
+				QbusinessServiceID: {
+					id: QbusinessServiceID,
 				},
-				"mediapackage": {
-					id: "mediapackage",
+				RamServiceID: {
+					id: RamServiceID,
 				},
-				"mediapackage-vod": {
-					id: "mediapackage-vod",
+				RbinServiceID: {
+					id: RbinServiceID,
 				},
-				"mediapackagev2": {
-					id: "mediapackagev2",
+				RdsServiceID: {
+					id: RdsServiceID,
 				},
-				"mediastore": {
-					id: "mediastore",
+				RedshiftServiceID: {
+					id: RedshiftServiceID,
 				},
-				"meetings-chime": {
-					id: "meetings-chime",
+				ResourceGroupsServiceID: {
+					id: ResourceGroupsServiceID,
 				},
-				"memory-db": {
-					id: "memory-db",
+				RolesanywhereServiceID: {
+					id: RolesanywhereServiceID,
 				},
-				"messaging-chime": {
-					id: "messaging-chime",
+				Route53profilesServiceID: {
+					id: Route53profilesServiceID,
 				},
-				"metering.marketplace": {
-					id: "metering.marketplace",
+				Route53resolverServiceID: {
+					id: Route53resolverServiceID,
 				},
-				"metrics.sagemaker": {
-					id: "metrics.sagemaker",
+				RumServiceID: {
+					id: RumServiceID,
 				},
-				"mgh": {
-					id: "mgh",
+				RuntimeSagemakerServiceID: {
+					id: RuntimeSagemakerServiceID,
 				},
-				"mgn": {
-					id: "mgn",
+				S3ServiceID: {
+					id: S3ServiceID,
 				},
-				"migrationhub-orchestrator": {
-					id: "migrationhub-orchestrator",
+				S3ControlServiceID: {
+					id: S3ControlServiceID,
 				},
-				"migrationhub-strategy": {
-					id: "migrationhub-strategy",
+				SchedulerServiceID: {
+					id: SchedulerServiceID,
 				},
-				"mobileanalytics": {
-					id: "mobileanalytics",
+				SecretsmanagerServiceID: {
+					id: SecretsmanagerServiceID,
 				},
-				"models-v2-lex": {
-					id: "models-v2-lex",
+				SecurityhubServiceID: {
+					id: SecurityhubServiceID,
 				},
-				"models.lex": {
-					id: "models.lex",
+				ServicediscoveryServiceID: {
+					id: ServicediscoveryServiceID,
 				},
-				"monitoring": {
-					id: "monitoring",
+				ServicequotasServiceID: {
+					id: ServicequotasServiceID,
 				},
-				"mq": {
-					id: "mq",
+				SignerServiceID: {
+					id: SignerServiceID,
 				},
-				"mturk-requester": {
-					id: "mturk-requester",
+				SmsVoiceServiceID: {
+					id: SmsVoiceServiceID,
 				},
-				"neptune": {
-					id: "neptune",
+				SnsServiceID: {
+					id: SnsServiceID,
 				},
-				"network-firewall": {
-					id: "network-firewall",
+				SqsServiceID: {
+					id: SqsServiceID,
 				},
-				"networkmanager": {
-					id: "networkmanager",
+				SsmServiceID: {
+					id: SsmServiceID,
 				},
-				"notifications": {
-					id: "notifications",
+				SsoServiceID: {
+					id: SsoServiceID,
 				},
-				"notifications-contacts": {
-					id: "notifications-contacts",
+				StatesServiceID: {
+					id: StatesServiceID,
 				},
-				"nova-act": {
-					id: "nova-act",
+				StoragegatewayServiceID: {
+					id: StoragegatewayServiceID,
 				},
-				"oam": {
-					id: "oam",
+				StreamsDynamodbServiceID: {
+					id: StreamsDynamodbServiceID,
 				},
-				"oidc": {
-					id: "oidc",
+				StsServiceID: {
+					id: StsServiceID,
 				},
-				"omics": {
-					id: "omics",
+				SwfServiceID: {
+					id: SwfServiceID,
 				},
-				"organizations": {
-					id: "organizations",
+				SyntheticsServiceID: {
+					id: SyntheticsServiceID,
 				},
-				"osis": {
-					id: "osis",
+				TaggingServiceID: {
+					id: TaggingServiceID,
 				},
-				"outposts": {
-					id: "outposts",
+				TransferServiceID: {
+					id: TransferServiceID,
 				},
-				"participant.connect": {
-					id: "participant.connect",
+				TrustedadvisorServiceID: {
+					id: TrustedadvisorServiceID,
 				},
-				"partnercentral-channel": {
-					id: "partnercentral-channel",
+				Wafv2ServiceID: {
+					id: Wafv2ServiceID,
 				},
-				"personalize": {
-					id: "personalize",
+				XrayServiceID: {
+					id: XrayServiceID,
 				},
-				"pi": {
-					id: "pi",
+			},
+		},
+		AwsIsoPartitionID: {
+			id:                     AwsIsoPartitionID,
+			name:                   "AWS ISO (US)",
+			dnsSuffix:              "c2s.ic.gov",
+			dualStackDNSSuffix:     "api.aws.ic.gov",
+			fipsDNSSuffix:          "c2s.ic.gov",
+			fipsDualStackDNSSuffix: "api.aws.ic.gov",
+			implicitGlobalRegion:   UsIsoEast1RegionID,
+			supportsFIPS:           true,
+			supportsDualStack:      true,
+			regionRegex:            regexp.MustCompile(`^us\-iso\-\w+\-\d+$`),
+			defaults: endpoint{
+				hostname: "{service}.{region}.{dnsSuffix}",
+				variants: []endpointVariant{
+					{
+						variant:   DualStackVariant,
+						hostname:  "{service}.{region}.{dnsSuffix}",
+						dnsSuffix: "api.aws.ic.gov",
+					},
+					{
+						variant:   DualStackVariant | FIPSVariant,
+						hostname:  "{service}-fips.{region}.{dnsSuffix}",
+						dnsSuffix: "api.aws.ic.gov",
+					},
+					{
+						variant:   FIPSVariant,
+						hostname:  "{service}-fips.{region}.{dnsSuffix}",
+						dnsSuffix: "c2s.ic.gov",
+					},
 				},
-				"pinpoint": {
-					id: "pinpoint",
+			},
+			regions: map[string]Region{
+				UsIsoEast1RegionID: {
+					id:          UsIsoEast1RegionID,
+					description: "US ISO East",
 				},
-				"pipes": {
-					id: "pipes",
+				UsIsoWest1RegionID: {
+					id:          UsIsoWest1RegionID,
+					description: "US ISO WEST",
 				},
-				"polly": {
-					id: "polly",
+			},
+			services: map[string]Service{
+				AcmServiceID: {
+					id: AcmServiceID,
+				},
+				AcmPcaServiceID: {
+					id: AcmPcaServiceID,
+				},
+				AgreementMarketplaceServiceID: {
+					id: AgreementMarketplaceServiceID,
+				},
+				ApiEcrServiceID: {
+					id: ApiEcrServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				ApiPricingServiceID: {
+					id: ApiPricingServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "pricing",
+						},
+					},
+					regions: []string{
+						UsIsoEast1RegionID,
+					},
+				},
+				ApiSagemakerServiceID: {
+					id: ApiSagemakerServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+					},
+				},
+				ApigatewayServiceID: {
+					id: ApigatewayServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				AppconfigServiceID: {
+					id: AppconfigServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				AppconfigdataServiceID: {
+					id: AppconfigdataServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				ApplicationAutoscalingServiceID: {
+					id: ApplicationAutoscalingServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				ArcZonalShiftServiceID: {
+					id: ArcZonalShiftServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				AthenaServiceID: {
+					id: AthenaServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+					},
+				},
+				AutoscalingServiceID: {
+					id: AutoscalingServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				BackupServiceID: {
+					id: BackupServiceID,
+				},
+				BatchServiceID: {
+					id: BatchServiceID,
+				},
+				BedrockServiceID: {
+					id: BedrockServiceID,
+				},
+				BudgetsServiceID: {
+					id: BudgetsServiceID,
+				},
+				CeServiceID: {
+					id: CeServiceID,
+				},
+				CloudcontrolapiServiceID: {
+					id: CloudcontrolapiServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				CloudformationServiceID: {
+					id: CloudformationServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				CloudtrailServiceID: {
+					id: CloudtrailServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				CodebuildServiceID: {
+					id: CodebuildServiceID,
+				},
+				CodedeployServiceID: {
+					id: CodedeployServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				ComprehendServiceID: {
+					id: ComprehendServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+					},
+				},
+				ConfigServiceID: {
+					id: ConfigServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				DatapipelineServiceID: {
+					id: DatapipelineServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+					},
+				},
+				DatasyncServiceID: {
+					id: DatasyncServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-iso-east-1": {
+							hostname: "datasync-fips.us-iso-east-1.c2s.ic.gov",
+							credentialScope: credentialScope{
+								region: UsIsoEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-iso-west-1": {
+							hostname: "datasync-fips.us-iso-west-1.c2s.ic.gov",
+							credentialScope: credentialScope{
+								region: UsIsoWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				DirectconnectServiceID: {
+					id: DirectconnectServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				DlmServiceID: {
+					id: DlmServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				DmsServiceID: {
+					id: DmsServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "dms.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"dms": {
+							credentialScope: credentialScope{
+								region: UsIsoEast1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "dms.us-iso-east-1.c2s.ic.gov",
+								},
+							},
+							deprecated: true,
+						},
+						"dms-fips": {
+							hostname: "dms.us-iso-east-1.c2s.ic.gov",
+							credentialScope: credentialScope{
+								region: UsIsoEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-iso-east-1-fips": {
+							hostname: "dms.us-iso-east-1.c2s.ic.gov",
+							credentialScope: credentialScope{
+								region: UsIsoEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-iso-west-1-fips": {
+							hostname: "dms.us-iso-west-1.c2s.ic.gov",
+							credentialScope: credentialScope{
+								region: UsIsoWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				DsServiceID: {
+					id: DsServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				DynamodbServiceID: {
+					id: DynamodbServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				EbsServiceID: {
+					id: EbsServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				Ec2ServiceID: {
+					id: Ec2ServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				EcsServiceID: {
+					id: EcsServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				EksServiceID: {
+					id: EksServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				ElasticacheServiceID: {
+					id: ElasticacheServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				ElasticfilesystemServiceID: {
+					id: ElasticfilesystemServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-iso-east-1": {
+							hostname: "elasticfilesystem-fips.us-iso-east-1.c2s.ic.gov",
+							credentialScope: credentialScope{
+								region: UsIsoEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-iso-west-1": {
+							hostname: "elasticfilesystem-fips.us-iso-west-1.c2s.ic.gov",
+							credentialScope: credentialScope{
+								region: UsIsoWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ElasticloadbalancingServiceID: {
+					id: ElasticloadbalancingServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				ElasticmapreduceServiceID: {
+					id: ElasticmapreduceServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-iso-east-1": {
+							hostname: "elasticmapreduce.us-iso-east-1.c2s.ic.gov",
+							credentialScope: credentialScope{
+								region: UsIsoEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-iso-west-1": {
+							hostname: "elasticmapreduce.us-iso-west-1.c2s.ic.gov",
+							credentialScope: credentialScope{
+								region: UsIsoWest1RegionID,
+							},
+							deprecated: true,
+						},
+						UsIsoEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "elasticmapreduce.us-iso-east-1.c2s.ic.gov",
+								},
+							},
+						},
+						UsIsoWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "elasticmapreduce.us-iso-west-1.c2s.ic.gov",
+								},
+							},
+						},
+					},
+				},
+				EsServiceID: {
+					id: EsServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				EventsServiceID: {
+					id: EventsServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				FirehoseServiceID: {
+					id: FirehoseServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				FsxServiceID: {
+					id: FsxServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-prod-us-iso-east-1": {
+							hostname: "fsx-fips.us-iso-east-1.c2s.ic.gov",
+							credentialScope: credentialScope{
+								region: UsIsoEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-iso-east-1": {
+							hostname: "fsx-fips.us-iso-east-1.c2s.ic.gov",
+							credentialScope: credentialScope{
+								region: UsIsoEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"prod-us-iso-east-1": {
+							credentialScope: credentialScope{
+								region: UsIsoEast1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "fsx-fips.us-iso-east-1.c2s.ic.gov",
+								},
+							},
+							deprecated: true,
+						},
+					},
+				},
+				GlacierServiceID: {
+					id: GlacierServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				GlueServiceID: {
+					id: GlueServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+					},
+				},
+				GuarddutyServiceID: {
+					id: GuarddutyServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+					},
+				},
+				HealthServiceID: {
+					id: HealthServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+					},
+				},
+				IamServiceID: {
+					id:                IamServiceID,
+					partitionEndpoint: "aws-iso-global",
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						"aws-iso-global": {
+							hostname: "iam.us-iso-east-1.c2s.ic.gov",
+							credentialScope: credentialScope{
+								region: UsIsoEast1RegionID,
+							},
+						},
+					},
+				},
+				KinesisServiceID: {
+					id: KinesisServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				KinesisanalyticsServiceID: {
+					id: KinesisanalyticsServiceID,
+				},
+				KinesisvideoServiceID: {
+					id: KinesisvideoServiceID,
+				},
+				KmsServiceID: {
+					id: KmsServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"ProdFips": {
+							hostname: "kms-fips.us-iso-east-1.c2s.ic.gov",
+							credentialScope: credentialScope{
+								region: UsIsoEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-iso-east-1-fips": {
+							hostname: "kms-fips.us-iso-east-1.c2s.ic.gov",
+							credentialScope: credentialScope{
+								region: UsIsoEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-iso-west-1-fips": {
+							hostname: "kms-fips.us-iso-west-1.c2s.ic.gov",
+							credentialScope: credentialScope{
+								region: UsIsoWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				LakeformationServiceID: {
+					id: LakeformationServiceID,
+				},
+				LambdaServiceID: {
+					id: LambdaServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				LicenseManagerServiceID: {
+					id: LicenseManagerServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				LogsServiceID: {
+					id: LogsServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				MedialiveServiceID: {
+					id: MedialiveServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+					},
+				},
+				MediapackageServiceID: {
+					id: MediapackageServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+					},
+				},
+				MetricsSagemakerServiceID: {
+					id: MetricsSagemakerServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+					},
+				},
+				MonitoringServiceID: {
+					id: MonitoringServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				MqServiceID: {
+					id: MqServiceID,
+				},
+				NetworkFirewallServiceID: {
+					id: NetworkFirewallServiceID,
+				},
+				OamServiceID: {
+					id: OamServiceID,
+				},
+				OrganizationsServiceID: {
+					id: OrganizationsServiceID,
+				},
+				OutpostsServiceID: {
+					id: OutpostsServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+					},
+				},
+				PiServiceID: {
+					id: PiServiceID,
+				},
+				RamServiceID: {
+					id: RamServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				RbinServiceID: {
+					id: RbinServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-iso-east-1": {
+							hostname: "rbin-fips.us-iso-east-1.c2s.ic.gov",
+							credentialScope: credentialScope{
+								region: UsIsoEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-iso-west-1": {
+							hostname: "rbin-fips.us-iso-west-1.c2s.ic.gov",
+							credentialScope: credentialScope{
+								region: UsIsoWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				RdsServiceID: {
+					id: RdsServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"rds.us-iso-east-1": {
+							hostname: "rds.us-iso-east-1.c2s.ic.gov",
+							credentialScope: credentialScope{
+								region: UsIsoEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"rds.us-iso-west-1": {
+							hostname: "rds.us-iso-west-1.c2s.ic.gov",
+							credentialScope: credentialScope{
+								region: UsIsoWest1RegionID,
+							},
+							deprecated: true,
+						},
+						UsIsoEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "rds.us-iso-east-1.c2s.ic.gov",
+								},
+							},
+						},
+						"us-iso-east-1-fips": {
+							hostname: "rds.us-iso-east-1.c2s.ic.gov",
+							credentialScope: credentialScope{
+								region: UsIsoEast1RegionID,
+							},
+							deprecated: true,
+						},
+						UsIsoWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "rds.us-iso-west-1.c2s.ic.gov",
+								},
+							},
+						},
+						"us-iso-west-1-fips": {
+							hostname: "rds.us-iso-west-1.c2s.ic.gov",
+							credentialScope: credentialScope{
+								region: UsIsoWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				RedshiftServiceID: {
+					id: RedshiftServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				ResourceGroupsServiceID: {
+					id: ResourceGroupsServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				Route53ServiceID: {
+					id:                Route53ServiceID,
+					partitionEndpoint: "aws-iso-global",
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						"aws-iso-global": {
+							hostname: "route53.c2s.ic.gov",
+							credentialScope: credentialScope{
+								region: UsIsoEast1RegionID,
+							},
+						},
+					},
+				},
+				Route53resolverServiceID: {
+					id: Route53resolverServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				RuntimeSagemakerServiceID: {
+					id: RuntimeSagemakerServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+					},
+				},
+				S3ServiceID: {
+					id: S3ServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-iso-east-1": {
+							hostname: "s3-fips.us-iso-east-1.c2s.ic.gov",
+							credentialScope: credentialScope{
+								region: UsIsoEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-iso-west-1": {
+							hostname: "s3-fips.us-iso-west-1.c2s.ic.gov",
+							credentialScope: credentialScope{
+								region: UsIsoWest1RegionID,
+							},
+							deprecated: true,
+						},
+						UsIsoEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant | FIPSVariant,
+									hostname: "s3-fips.dualstack.us-iso-east-1.c2s.ic.gov",
+								},
+							},
+						},
+						UsIsoWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant | FIPSVariant,
+									hostname: "s3-fips.dualstack.us-iso-west-1.c2s.ic.gov",
+								},
+							},
+						},
+					},
+				},
+				S3ControlServiceID: {
+					id: S3ControlServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						UsIsoEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "s3-control.dualstack.us-iso-east-1.c2s.ic.gov",
+								},
+								{
+									variant:  DualStackVariant | FIPSVariant,
+									hostname: "s3-control-fips.dualstack.us-iso-east-1.c2s.ic.gov",
+								},
+							},
+						},
+						"us-iso-east-1-fips": {
+							hostname: "s3-control-fips.us-iso-east-1.c2s.ic.gov",
+							credentialScope: credentialScope{
+								region: UsIsoEast1RegionID,
+							},
+							deprecated: true,
+						},
+						UsIsoWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "s3-control.dualstack.us-iso-west-1.c2s.ic.gov",
+								},
+								{
+									variant:  DualStackVariant | FIPSVariant,
+									hostname: "s3-control-fips.dualstack.us-iso-west-1.c2s.ic.gov",
+								},
+							},
+						},
+						"us-iso-west-1-fips": {
+							hostname: "s3-control-fips.us-iso-west-1.c2s.ic.gov",
+							credentialScope: credentialScope{
+								region: UsIsoWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				S3OutpostsServiceID: {
+					id: S3OutpostsServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-iso-east-1": {
+							deprecated: true,
+						},
+					},
+				},
+				SchedulerServiceID: {
+					id: SchedulerServiceID,
+				},
+				SecretsmanagerServiceID: {
+					id: SecretsmanagerServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				SecurityhubServiceID: {
+					id: SecurityhubServiceID,
+				},
+				ServicediscoveryServiceID: {
+					id: ServicediscoveryServiceID,
+				},
+				ServicequotasServiceID: {
+					id: ServicequotasServiceID,
+				},
+				SnowballServiceID: {
+					id: SnowballServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				SnsServiceID: {
+					id: SnsServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				SqsServiceID: {
+					id: SqsServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				SsmServiceID: {
+					id: SsmServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				StatesServiceID: {
+					id: StatesServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				StoragegatewayServiceID: {
+					id: StoragegatewayServiceID,
+				},
+				StreamsDynamodbServiceID: {
+					id: StreamsDynamodbServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "dynamodb",
+						},
+					},
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				StsServiceID: {
+					id: StsServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				SupportServiceID: {
+					id:                SupportServiceID,
+					partitionEndpoint: "aws-iso-global",
+					endpoints: map[string]endpoint{
+						"aws-iso-global": {
+							hostname: "support.us-iso-east-1.c2s.ic.gov",
+							credentialScope: credentialScope{
+								region: UsIsoEast1RegionID,
+							},
+						},
+					},
+				},
+				SwfServiceID: {
+					id: SwfServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				SyntheticsServiceID: {
+					id: SyntheticsServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				TaggingServiceID: {
+					id: TaggingServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				TextractServiceID: {
+					id: TextractServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+					},
+				},
+				TranscribeServiceID: {
+					id: TranscribeServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+					},
+				},
+				TranscribestreamingServiceID: {
+					id: TranscribestreamingServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+					},
+				},
+				TranslateServiceID: {
+					id: TranslateServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+					},
+				},
+				Wafv2ServiceID: {
+					id: Wafv2ServiceID,
+				},
+				WorkspacesServiceID: {
+					id: WorkspacesServiceID,
+					regions: []string{
+						UsIsoEast1RegionID,
+						UsIsoWest1RegionID,
+					},
+				},
+				XrayServiceID: {
+					id: XrayServiceID,
 				},
-				"portal.sso": {
-					id: "portal.sso",
+			},
+		},
+		AwsIsoBPartitionID: {
+			id:                     AwsIsoBPartitionID,
+			name:                   "AWS ISOB (US)",
+			dnsSuffix:              "sc2s.sgov.gov",
+			dualStackDNSSuffix:     "api.aws.scloud",
+			fipsDNSSuffix:          "sc2s.sgov.gov",
+			fipsDualStackDNSSuffix: "api.aws.scloud",
+			implicitGlobalRegion:   UsIsobEast1RegionID,
+			supportsFIPS:           true,
+			supportsDualStack:      true,
+			regionRegex:            regexp.MustCompile(`^us\-isob\-\w+\-\d+$`),
+			defaults: endpoint{
+				hostname: "{service}.{region}.{dnsSuffix}",
+				variants: []endpointVariant{
+					{
+						variant:   DualStackVariant,
+						hostname:  "{service}.{region}.{dnsSuffix}",
+						dnsSuffix: "api.aws.scloud",
+					},
+					{
+						variant:   DualStackVariant | FIPSVariant,
+						hostname:  "{service}-fips.{region}.{dnsSuffix}",
+						dnsSuffix: "api.aws.scloud",
+					},
+					{
+						variant:   FIPSVariant,
+						hostname:  "{service}-fips.{region}.{dnsSuffix}",
+						dnsSuffix: "sc2s.sgov.gov",
+					},
 				},
-				"profile": {
-					id: "profile",
+			},
+			regions: map[string]Region{
+				UsIsobEast1RegionID: {
+					id:          UsIsobEast1RegionID,
+					description: "US ISOB East (Ohio)",
 				},
-				"proton": {
-					id: "proton",
+				UsIsobWest1RegionID: {
+					id:          UsIsobWest1RegionID,
+					description: "US ISOB West",
 				},
-				"qbusiness": {
-					id: "qbusiness",
+			},
+			services: map[string]Service{
+				AcmServiceID: {
+					id: AcmServiceID,
+				},
+				AgreementMarketplaceServiceID: {
+					id: AgreementMarketplaceServiceID,
+				},
+				ApiEcrServiceID: {
+					id: ApiEcrServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				ApiPricingServiceID: {
+					id: ApiPricingServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "pricing",
+						},
+					},
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				ApiSagemakerServiceID: {
+					id: ApiSagemakerServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				ApigatewayServiceID: {
+					id: ApigatewayServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				AppconfigServiceID: {
+					id: AppconfigServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				AppconfigdataServiceID: {
+					id: AppconfigdataServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				ApplicationAutoscalingServiceID: {
+					id: ApplicationAutoscalingServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				Appstream2ServiceID: {
+					id: Appstream2ServiceID,
+				},
+				ArcZonalShiftServiceID: {
+					id: ArcZonalShiftServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				AthenaServiceID: {
+					id: AthenaServiceID,
+				},
+				AutoscalingServiceID: {
+					id: AutoscalingServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				BackupServiceID: {
+					id: BackupServiceID,
+				},
+				BatchServiceID: {
+					id: BatchServiceID,
+				},
+				BedrockServiceID: {
+					id: BedrockServiceID,
+				},
+				BudgetsServiceID: {
+					id: BudgetsServiceID,
+				},
+				CeServiceID: {
+					id: CeServiceID,
+				},
+				CloudcontrolapiServiceID: {
+					id: CloudcontrolapiServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				CloudformationServiceID: {
+					id: CloudformationServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				CloudtrailServiceID: {
+					id: CloudtrailServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				CodebuildServiceID: {
+					id: CodebuildServiceID,
+				},
+				CodedeployServiceID: {
+					id: CodedeployServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				ConfigServiceID: {
+					id: ConfigServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				DatasyncServiceID: {
+					id: DatasyncServiceID,
+				},
+				DirectconnectServiceID: {
+					id: DirectconnectServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				DlmServiceID: {
+					id: DlmServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				DmsServiceID: {
+					id: DmsServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "dms.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"dms": {
+							credentialScope: credentialScope{
+								region: UsIsobEast1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "dms.us-isob-east-1.sc2s.sgov.gov",
+								},
+							},
+							deprecated: true,
+						},
+						"dms-fips": {
+							hostname: "dms.us-isob-east-1.sc2s.sgov.gov",
+							credentialScope: credentialScope{
+								region: UsIsobEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-isob-east-1-fips": {
+							hostname: "dms.us-isob-east-1.sc2s.sgov.gov",
+							credentialScope: credentialScope{
+								region: UsIsobEast1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				DsServiceID: {
+					id: DsServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				DynamodbServiceID: {
+					id: DynamodbServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				EbsServiceID: {
+					id: EbsServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				Ec2ServiceID: {
+					id: Ec2ServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				EcsServiceID: {
+					id: EcsServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				EksServiceID: {
+					id: EksServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				ElasticacheServiceID: {
+					id: ElasticacheServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				ElasticfilesystemServiceID: {
+					id: ElasticfilesystemServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-isob-east-1": {
+							hostname: "elasticfilesystem-fips.us-isob-east-1.sc2s.sgov.gov",
+							credentialScope: credentialScope{
+								region: UsIsobEast1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ElasticloadbalancingServiceID: {
+					id: ElasticloadbalancingServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				ElasticmapreduceServiceID: {
+					id: ElasticmapreduceServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-isob-east-1": {
+							hostname: "elasticmapreduce.us-isob-east-1.sc2s.sgov.gov",
+							credentialScope: credentialScope{
+								region: UsIsobEast1RegionID,
+							},
+							deprecated: true,
+						},
+						UsIsobEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "elasticmapreduce.us-isob-east-1.sc2s.sgov.gov",
+								},
+							},
+						},
+					},
+				},
+				EsServiceID: {
+					id: EsServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				EventsServiceID: {
+					id: EventsServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				FirehoseServiceID: {
+					id: FirehoseServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				FsxServiceID: {
+					id: FsxServiceID,
+				},
+				GlacierServiceID: {
+					id: GlacierServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				GlueServiceID: {
+					id: GlueServiceID,
+				},
+				GuarddutyServiceID: {
+					id: GuarddutyServiceID,
+				},
+				HealthServiceID: {
+					id: HealthServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				IamServiceID: {
+					id:                IamServiceID,
+					partitionEndpoint: "aws-iso-b-global",
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						"aws-iso-b-global": {
+							hostname: "iam.us-isob-east-1.sc2s.sgov.gov",
+							credentialScope: credentialScope{
+								region: UsIsobEast1RegionID,
+							},
+						},
+					},
+				},
+				KinesisServiceID: {
+					id: KinesisServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				KinesisanalyticsServiceID: {
+					id: KinesisanalyticsServiceID,
+				},
+				KmsServiceID: {
+					id: KmsServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"ProdFips": {
+							hostname: "kms-fips.us-isob-east-1.sc2s.sgov.gov",
+							credentialScope: credentialScope{
+								region: UsIsobEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-isob-east-1-fips": {
+							hostname: "kms-fips.us-isob-east-1.sc2s.sgov.gov",
+							credentialScope: credentialScope{
+								region: UsIsobEast1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				LakeformationServiceID: {
+					id: LakeformationServiceID,
+				},
+				LambdaServiceID: {
+					id: LambdaServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				LicenseManagerServiceID: {
+					id: LicenseManagerServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				LogsServiceID: {
+					id: LogsServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				MedialiveServiceID: {
+					id: MedialiveServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				MediapackageServiceID: {
+					id: MediapackageServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				MeteringMarketplaceServiceID: {
+					id: MeteringMarketplaceServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "aws-marketplace",
+						},
+					},
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				MetricsSagemakerServiceID: {
+					id: MetricsSagemakerServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				MonitoringServiceID: {
+					id: MonitoringServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				NetworkFirewallServiceID: {
+					id: NetworkFirewallServiceID,
+				},
+				OamServiceID: {
+					id: OamServiceID,
+				},
+				OrganizationsServiceID: {
+					id: OrganizationsServiceID,
+				},
+				OutpostsServiceID: {
+					id: OutpostsServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				PiServiceID: {
+					id: PiServiceID,
+				},
+				RamServiceID: {
+					id: RamServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				RbinServiceID: {
+					id: RbinServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-isob-east-1": {
+							hostname: "rbin-fips.us-isob-east-1.sc2s.sgov.gov",
+							credentialScope: credentialScope{
+								region: UsIsobEast1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				RdsServiceID: {
+					id: RdsServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"rds.us-isob-east-1": {
+							hostname: "rds.us-isob-east-1.sc2s.sgov.gov",
+							credentialScope: credentialScope{
+								region: UsIsobEast1RegionID,
+							},
+							deprecated: true,
+						},
+						UsIsobEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "rds.us-isob-east-1.sc2s.sgov.gov",
+								},
+							},
+						},
+						"us-isob-east-1-fips": {
+							hostname: "rds.us-isob-east-1.sc2s.sgov.gov",
+							credentialScope: credentialScope{
+								region: UsIsobEast1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				RedshiftServiceID: {
+					id: RedshiftServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				ResourceGroupsServiceID: {
+					id: ResourceGroupsServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				Route53ServiceID: {
+					id:                Route53ServiceID,
+					partitionEndpoint: "aws-iso-b-global",
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						"aws-iso-b-global": {
+							hostname: "route53.sc2s.sgov.gov",
+							credentialScope: credentialScope{
+								region: UsIsobEast1RegionID,
+							},
+						},
+					},
+				},
+				Route53resolverServiceID: {
+					id: Route53resolverServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				RuntimeSagemakerServiceID: {
+					id: RuntimeSagemakerServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				S3ServiceID: {
+					id: S3ServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-isob-east-1": {
+							hostname: "s3-fips.us-isob-east-1.sc2s.sgov.gov",
+							credentialScope: credentialScope{
+								region: UsIsobEast1RegionID,
+							},
+							deprecated: true,
+						},
+						UsIsobEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant | FIPSVariant,
+									hostname: "s3-fips.dualstack.us-isob-east-1.sc2s.sgov.gov",
+								},
+							},
+						},
+					},
+				},
+				S3ControlServiceID: {
+					id: S3ControlServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						UsIsobEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "s3-control.dualstack.us-isob-east-1.sc2s.sgov.gov",
+								},
+								{
+									variant:  DualStackVariant | FIPSVariant,
+									hostname: "s3-control-fips.dualstack.us-isob-east-1.sc2s.sgov.gov",
+								},
+							},
+						},
+						"us-isob-east-1-fips": {
+							hostname: "s3-control-fips.us-isob-east-1.sc2s.sgov.gov",
+							credentialScope: credentialScope{
+								region: UsIsobEast1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				S3OutpostsServiceID: {
+					id: S3OutpostsServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-isob-east-1": {
+							deprecated: true,
+						},
+					},
+				},
+				SchedulerServiceID: {
+					id: SchedulerServiceID,
+				},
+				SecretsmanagerServiceID: {
+					id: SecretsmanagerServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				SecurityhubServiceID: {
+					id: SecurityhubServiceID,
+				},
+				ServicediscoveryServiceID: {
+					id: ServicediscoveryServiceID,
+				},
+				ServicequotasServiceID: {
+					id: ServicequotasServiceID,
+				},
+				SnowballServiceID: {
+					id: SnowballServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				SnsServiceID: {
+					id: SnsServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				SqsServiceID: {
+					id: SqsServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				SsmServiceID: {
+					id: SsmServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				StatesServiceID: {
+					id: StatesServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				StoragegatewayServiceID: {
+					id: StoragegatewayServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips": {
+							hostname: "storagegateway-fips.us-isob-east-1.sc2s.sgov.gov",
+							credentialScope: credentialScope{
+								region: UsIsobEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-isob-east-1-fips": {
+							hostname: "storagegateway-fips.us-isob-east-1.sc2s.sgov.gov",
+							credentialScope: credentialScope{
+								region: UsIsobEast1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				StreamsDynamodbServiceID: {
+					id: StreamsDynamodbServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "dynamodb",
+						},
+					},
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				StsServiceID: {
+					id: StsServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				SupportServiceID: {
+					id:                SupportServiceID,
+					partitionEndpoint: "aws-iso-b-global",
+					endpoints: map[string]endpoint{
+						"aws-iso-b-global": {
+							hostname: "support.us-isob-east-1.sc2s.sgov.gov",
+							credentialScope: credentialScope{
+								region: UsIsobEast1RegionID,
+							},
+						},
+					},
+				},
+				SwfServiceID: {
+					id: SwfServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				SyntheticsServiceID: {
+					id: SyntheticsServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				TaggingServiceID: {
+					id: TaggingServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				Wafv2ServiceID: {
+					id: Wafv2ServiceID,
+				},
+				WorkspacesServiceID: {
+					id: WorkspacesServiceID,
+					regions: []string{
+						UsIsobEast1RegionID,
+					},
+				},
+				XrayServiceID: {
+					id: XrayServiceID,
 				},
-				"query.timestream": {
-					id: "query.timestream",
+			},
+		},
+		AwsIsoEPartitionID: {
+			id:                     AwsIsoEPartitionID,
+			name:                   "AWS ISOE (Europe)",
+			dnsSuffix:              "cloud.adc-e.uk",
+			dualStackDNSSuffix:     "api.cloud-aws.adc-e.uk",
+			fipsDNSSuffix:          "cloud.adc-e.uk",
+			fipsDualStackDNSSuffix: "api.cloud-aws.adc-e.uk",
+			implicitGlobalRegion:   EuIsoeWest1RegionID,
+			supportsFIPS:           true,
+			supportsDualStack:      true,
+			regionRegex:            regexp.MustCompile(`^eu\-isoe\-\w+\-\d+$`),
+			defaults: endpoint{
+				hostname: "{service}.{region}.{dnsSuffix}",
+				variants: []endpointVariant{
+					{
+						variant:   DualStackVariant,
+						hostname:  "{service}.{region}.{dnsSuffix}",
+						dnsSuffix: "api.cloud-aws.adc-e.uk",
+					},
+					{
+						variant:   DualStackVariant | FIPSVariant,
+						hostname:  "{service}-fips.{region}.{dnsSuffix}",
+						dnsSuffix: "api.cloud-aws.adc-e.uk",
+					},
+					{
+						variant:   FIPSVariant,
+						hostname:  "{service}-fips.{region}.{dnsSuffix}",
+						dnsSuffix: "cloud.adc-e.uk",
+					},
 				},
-				"quicksight": {
-					id: "quicksight",
+			},
+			regions: map[string]Region{
+				EuIsoeWest1RegionID: {
+					id:          EuIsoeWest1RegionID,
+					description: "EU ISOE West",
 				},
-				"ram": {
-					id: "ram",
+			},
+			services: map[string]Service{
+				AccessAnalyzerServiceID: {
+					id: AccessAnalyzerServiceID,
 				},
-				"rbin": {
-					id: "rbin",
+				AcmServiceID: {
+					id: AcmServiceID,
 				},
-				"rds": {
-					id: "rds",
+				AcmPcaServiceID: {
+					id: AcmPcaServiceID,
 				},
-				"rds-data": {
-					id: "rds-data",
+				ApiEcrServiceID: {
+					id: ApiEcrServiceID,
 				},
-				"redshift": {
-					id: "redshift",
+				ApiPricingServiceID: {
+					id: ApiPricingServiceID,
 				},
-				"redshift-serverless": {
-					id: "redshift-serverless",
+				ApigatewayServiceID: {
+					id: ApigatewayServiceID,
 				},
-				"rekognition": {
-					id: "rekognition",
+				AppconfigServiceID: {
+					id: AppconfigServiceID,
 				},
-				"resiliencehub": {
-					id: "resiliencehub",
+				AppconfigdataServiceID: {
+					id: AppconfigdataServiceID,
 				},
-				"resource-explorer-2": {
-					id: "resource-explorer-2",
+				ApplicationAutoscalingServiceID: {
+					id: ApplicationAutoscalingServiceID,
 				},
-				"resource-groups": {
-					id: "resource-groups",
+				ArcZonalShiftServiceID: {
+					id: ArcZonalShiftServiceID,
 				},
-				"rolesanywhere": {
-					id: "rolesanywhere",
+				AthenaServiceID: {
+					id: AthenaServiceID,
 				},
-				"route53": {
-					id: "route53",
+				AutoscalingServiceID: {
+					id: AutoscalingServiceID,
 				},
-				"route53-recovery-control-config": {
-					id: "route53-recovery-control-config",
+				BatchServiceID: {
+					id: BatchServiceID,
 				},
-				"route53domains": {
-					id: "route53domains",
+				BudgetsServiceID: {
+					id: BudgetsServiceID,
 				},
-				"route53profiles": {
-					id: "route53profiles",
+				CloudcontrolapiServiceID: {
+					id: CloudcontrolapiServiceID,
 				},
-				"route53resolver": {
-					id: "route53resolver",
+				CloudformationServiceID: {
+					id: CloudformationServiceID,
 				},
-				"rum": {
-					id: "rum",
+				CloudtrailServiceID: {
+					id: CloudtrailServiceID,
 				},
-				"runtime-v2-lex": {
-					id: "runtime-v2-lex",
+				CloudtrailDataServiceID: {
+					id: CloudtrailDataServiceID,
 				},
-				"runtime.lex": {
-					id: "runtime.lex",
+				CodedeployServiceID: {
+					id: CodedeployServiceID,
 				},
-				"runtime.sagemaker": {
-					id: "runtime.sagemaker",
+				ComputeOptimizerServiceID: {
+					id: ComputeOptimizerServiceID,
 				},
-				"s3": {
-					id: "s3",
+				ConfigServiceID: {
+					id: ConfigServiceID,
 				},
-				"s3-control": {
-					id: "s3-control",
+				CostOptimizationHubServiceID: {
+					id: CostOptimizationHubServiceID,
 				},
-				"s3-outposts": {
-					id: "s3-outposts",
+				DirectconnectServiceID: {
+					id: DirectconnectServiceID,
 				},
-				"sagemaker-geospatial": {
-					id: "sagemaker-geospatial",
+				DlmServiceID: {
+					id: DlmServiceID,
 				},
-				"savingsplans": {
-					id: "savingsplans",
+				DmsServiceID: {
+					id: DmsServiceID,
 				},
-				"scheduler": {
-					id: "scheduler",
+				DsServiceID: {
+					id: DsServiceID,
 				},
-				"schemas": {
-					id: "schemas",
+				DynamodbServiceID: {
+					id: DynamodbServiceID,
 				},
-				"sdb": {
-					id: "sdb",
+				EbsServiceID: {
+					id: EbsServiceID,
 				},
-				"secretsmanager": {
-					id: "secretsmanager",
+				Ec2ServiceID: {
+					id: Ec2ServiceID,
 				},
-				"securityhub": {
-					id: "securityhub",
+				EcsServiceID: {
+					id: EcsServiceID,
 				},
-				"securitylake": {
-					id: "securitylake",
+				EksServiceID: {
+					id: EksServiceID,
 				},
-				"serverlessrepo": {
-					id: "serverlessrepo",
+				ElasticacheServiceID: {
+					id: ElasticacheServiceID,
 				},
-				"servicecatalog": {
-					id: "servicecatalog",
+				ElasticfilesystemServiceID: {
+					id: ElasticfilesystemServiceID,
 				},
-				"servicecatalog-appregistry": {
-					id: "servicecatalog-appregistry",
+				ElasticloadbalancingServiceID: {
+					id: ElasticloadbalancingServiceID,
 				},
-				"servicediscovery": {
-					id: "servicediscovery",
+				ElasticmapreduceServiceID: {
+					id: ElasticmapreduceServiceID,
 				},
-				"servicequotas": {
-					id: "servicequotas",
+				EmrServerlessServiceID: {
+					id: EmrServerlessServiceID,
 				},
-				"shield": {
-					id: "shield",
+				EsServiceID: {
+					id: EsServiceID,
 				},
-				"signer": {
-					id: "signer",
+				EventsServiceID: {
+					id: EventsServiceID,
 				},
-				"simspaceweaver": {
-					id: "simspaceweaver",
+				FirehoseServiceID: {
+					id: FirehoseServiceID,
 				},
-				"sms-voice": {
-					id: "sms-voice",
+				GlueServiceID: {
+					id: GlueServiceID,
 				},
-				"snowball": {
-					id: "snowball",
+				KinesisServiceID: {
+					id: KinesisServiceID,
 				},
-				"sns": {
-					id: "sns",
+				KmsServiceID: {
+					id: KmsServiceID,
 				},
-				"sqs": {
-					id: "sqs",
+				LakeformationServiceID: {
+					id: LakeformationServiceID,
 				},
-				"ssm": {
-					id: "ssm",
+				LambdaServiceID: {
+					id: LambdaServiceID,
 				},
-				"ssm-contacts": {
-					id: "ssm-contacts",
+				LicenseManagerServiceID: {
+					id: LicenseManagerServiceID,
 				},
-				"ssm-incidents": {
-					id: "ssm-incidents",
+				LogsServiceID: {
+					id: LogsServiceID,
 				},
-				"ssm-quicksetup": {
-					id: "ssm-quicksetup",
+				MetricsSagemakerServiceID: {
+					id: MetricsSagemakerServiceID,
 				},
-				"ssm-sap": {
-					id: "ssm-sap",
+				MonitoringServiceID: {
+					id: MonitoringServiceID,
 				},
-				"sso": {
-					id: "sso",
+				OamServiceID: {
+					id: OamServiceID,
 				},
-				"states": {
-					id: "states",
+				OrganizationsServiceID: {
+					id: OrganizationsServiceID,
 				},
-				"storagegateway": {
-					id: "storagegateway",
+				PiServiceID: {
+					id: PiServiceID,
 				},
-				"streams.dynamodb": {
-					id: "streams.dynamodb",
+				PipesServiceID: {
+					id: PipesServiceID,
 				},
-				"sts": {
-					id: "sts",
+				RamServiceID: {
+					id: RamServiceID,
 				},
-				"support": {
-					id: "support",
+				RbinServiceID: {
+					id: RbinServiceID,
 				},
-				"supportapp": {
-					id: "supportapp",
+				RdsServiceID: {
+					id: RdsServiceID,
 				},
-				"swf": {
-					id: "swf",
+				RedshiftServiceID: {
+					id: RedshiftServiceID,
 				},
-				"synthetics": {
-					id: "synthetics",
+				RedshiftServerlessServiceID: {
+					id: RedshiftServerlessServiceID,
 				},
-				"tagging": {
-					id: "tagging",
+				RekognitionServiceID: {
+					id: RekognitionServiceID,
 				},
-				"tax": {
-					id: "tax",
+				ResourceGroupsServiceID: {
+					id: ResourceGroupsServiceID,
 				},
-				"textract": {
-					id: "textract",
+				Route53ServiceID: {
+					id: Route53ServiceID,
 				},
-				"thinclient": {
-					id: "thinclient",
+				Route53profilesServiceID: {
+					id: Route53profilesServiceID,
 				},
-				"tnb": {
-					id: "tnb",
+				Route53resolverServiceID: {
+					id: Route53resolverServiceID,
 				},
-				"transcribe": {
-					id: "transcribe",
+				S3ServiceID: {
+					id: S3ServiceID,
 				},
-				"transcribestreaming": {
-					id: "transcribestreaming",
+				SavingsplansServiceID: {
+					id: SavingsplansServiceID,
 				},
-				"transfer": {
-					id: "transfer",
+				SchedulerServiceID: {
+					id: SchedulerServiceID,
 				},
-				"translate": {
-					id: "translate",
+				SchemasServiceID: {
+					id: SchemasServiceID,
 				},
-				"trustedadvisor": {
-					id: "trustedadvisor",
+				SecretsmanagerServiceID: {
+					id: SecretsmanagerServiceID,
 				},
-				"verifiedpermissions": {
-					id: "verifiedpermissions",
+				ServicecatalogServiceID: {
+					id: ServicecatalogServiceID,
 				},
-				"voice-chime": {
-					id: "voice-chime",
+				ServicediscoveryServiceID: {
+					id: ServicediscoveryServiceID,
 				},
-				"voiceid": {
-					id: "voiceid",
+				ServicequotasServiceID: {
+					id: ServicequotasServiceID,
 				},
-				"vpc-lattice": {
-					id: "vpc-lattice",
+				SnsServiceID: {
+					id: SnsServiceID,
 				},
-				"waf": {
-					id: "waf",
+				SqsServiceID: {
+					id: SqsServiceID,
 				},
-				"waf-regional": {
-					id: "waf-regional",
+				SsmServiceID: {
+					id: SsmServiceID,
 				},
-				"wafv2": {
-					id: "wafv2",
+				StatesServiceID: {
+					id: StatesServiceID,
 				},
-				"wellarchitected": {
-					id: "wellarchitected",
+				StreamsDynamodbServiceID: {
+					id: StreamsDynamodbServiceID,
 				},
-				"wisdom": {
-					id: "wisdom",
+				StsServiceID: {
+					id: StsServiceID,
 				},
-				"workdocs": {
-					id: "workdocs",
+				SwfServiceID: {
+					id: SwfServiceID,
 				},
-				"workmail": {
-					id: "workmail",
+				SyntheticsServiceID: {
+					id: SyntheticsServiceID,
 				},
-				"workspaces": {
-					id: "workspaces",
+				TaggingServiceID: {
+					id: TaggingServiceID,
 				},
-				"workspaces-web": {
-					id: "workspaces-web",
+				TrustedadvisorServiceID: {
+					id: TrustedadvisorServiceID,
 				},
-				"xray": {
-					id: "xray",
+				XrayServiceID: {
+					id: XrayServiceID,
 				},
 			},
 		},
-		AwsCnPartitionID: {
-			id:          AwsCnPartitionID,
-			name:        "AWS China",
-			dnsSuffix:   "amazonaws.com.cn",
-			regionRegex: regexp.MustCompile(`^cn\-\w+\-\d+$`),
+		AwsIsoFPartitionID: {
+			id:                     AwsIsoFPartitionID,
+			name:                   "AWS ISOF",
+			dnsSuffix:              "csp.hci.ic.gov",
+			dualStackDNSSuffix:     "api.aws.hci.ic.gov",
+			fipsDNSSuffix:          "csp.hci.ic.gov",
+			fipsDualStackDNSSuffix: "api.aws.hci.ic.gov",
+			implicitGlobalRegion:   UsIsofSouth1RegionID,
+			supportsFIPS:           true,
+			supportsDualStack:      true,
+			regionRegex:            regexp.MustCompile(`^us\-isof\-\w+\-\d+$`),
+			defaults: endpoint{
+				hostname: "{service}.{region}.{dnsSuffix}",
+				variants: []endpointVariant{
+					{
+						variant:   DualStackVariant,
+						hostname:  "{service}.{region}.{dnsSuffix}",
+						dnsSuffix: "api.aws.hci.ic.gov",
+					},
+					{
+						variant:   DualStackVariant | FIPSVariant,
+						hostname:  "{service}-fips.{region}.{dnsSuffix}",
+						dnsSuffix: "api.aws.hci.ic.gov",
+					},
+					{
+						variant:   FIPSVariant,
+						hostname:  "{service}-fips.{region}.{dnsSuffix}",
+						dnsSuffix: "csp.hci.ic.gov",
+					},
+				},
+			},
 			regions: map[string]Region{
-				CnNorth1RegionID: {
-					id:          CnNorth1RegionID,
-					description: "China (Beijing)",
+				UsIsofEast1RegionID: {
+					id:          UsIsofEast1RegionID,
+					description: "US ISOF EAST",
 				},
-				CnNorthwest1RegionID: {
-					id:          CnNorthwest1RegionID,
-					description: "China (Ningxia)",
+				UsIsofSouth1RegionID: {
+					id:          UsIsofSouth1RegionID,
+					description: "US ISOF SOUTH",
 				},
 			},
 			services: map[string]Service{
-				"access-analyzer": {
-					id: "access-analyzer",
-				},
-				"account": {
-					id: "account",
-				},
-				"acm": {
-					id: "acm",
-				},
-				"acm-pca": {
-					id: "acm-pca",
-				},
-				"airflow": {
-					id: "airflow",
-				},
-				"api.ecr": {
-					id: "api.ecr",
-				},
-				"api.pricing": {
-					id: "api.pricing",
-				},
-				"api.sagemaker": {
-					id: "api.sagemaker",
-				},
-				"api.tunneling.iot": {
-					id: "api.tunneling.iot",
-				},
-				"apigateway": {
-					id: "apigateway",
+				AccessAnalyzerServiceID: {
+					id: AccessAnalyzerServiceID,
 				},
-				"appconfig": {
-					id: "appconfig",
+				AcmServiceID: {
+					id: AcmServiceID,
 				},
-				"appconfigdata": {
-					id: "appconfigdata",
+				AcmPcaServiceID: {
+					id: AcmPcaServiceID,
 				},
-				"application-autoscaling": {
-					id: "application-autoscaling",
+				AgreementMarketplaceServiceID: {
+					id: AgreementMarketplaceServiceID,
 				},
-				"applicationinsights": {
-					id: "applicationinsights",
+				ApiEcrServiceID: {
+					id: ApiEcrServiceID,
 				},
-				"appmesh": {
-					id: "appmesh",
+				ApiPricingServiceID: {
+					id: ApiPricingServiceID,
 				},
-				"appsync": {
-					id: "appsync",
+				ApiSagemakerServiceID: {
+					id: ApiSagemakerServiceID,
 				},
-				"arc-zonal-shift": {
-					id: "arc-zonal-shift",
+				AppconfigServiceID: {
+					id: AppconfigServiceID,
 				},
-				"athena": {
-					id: "athena",
+				AppconfigdataServiceID: {
+					id: AppconfigdataServiceID,
 				},
-				"autoscaling": {
-					id: "autoscaling",
+				ApplicationAutoscalingServiceID: {
+					id: ApplicationAutoscalingServiceID,
 				},
-				"autoscaling-plans": {
-					id: "autoscaling-plans",
+				ArcZonalShiftServiceID: {
+					id: ArcZonalShiftServiceID,
 				},
-				"backup": {
-					id: "backup",
+				AthenaServiceID: {
+					id: AthenaServiceID,
 				},
-				"batch": {
-					id: "batch",
+				AutoscalingServiceID: {
+					id: AutoscalingServiceID,
 				},
-				"budgets": {
-					id: "budgets",
+				BackupServiceID: {
+					id: BackupServiceID,
 				},
-				"cassandra": {
-					id: "cassandra",
+				BatchServiceID: {
+					id: BatchServiceID,
 				},
-				"ce": {
-					id: "ce",
+				BedrockServiceID: {
+					id: BedrockServiceID,
 				},
-				"cloudcontrolapi": {
-					id: "cloudcontrolapi",
+				BudgetsServiceID: {
+					id: BudgetsServiceID,
 				},
-				"cloudformation": {
-					id: "cloudformation",
+				CeServiceID: {
+					id: CeServiceID,
 				},
-				"cloudfront": {
-					id: "cloudfront",
+				CloudcontrolapiServiceID: {
+					id: CloudcontrolapiServiceID,
 				},
-				"cloudtrail": {
-					id: "cloudtrail",
+				CloudformationServiceID: {
+					id: CloudformationServiceID,
 				},
-				"codebuild": {
-					id: "codebuild",
+				CloudtrailServiceID: {
+					id: CloudtrailServiceID,
 				},
-				"codecommit": {
-					id: "codecommit",
+				CloudtrailDataServiceID: {
+					id: CloudtrailDataServiceID,
 				},
-				"codedeploy": {
-					id: "codedeploy",
+				CodebuildServiceID: {
+					id: CodebuildServiceID,
 				},
-				"codepipeline": {
-					id: "codepipeline",
+				CodedeployServiceID: {
+					id: CodedeployServiceID,
 				},
-				"cognito-identity": {
-					id: "cognito-identity",
+				CodepipelineServiceID: {
+					id: CodepipelineServiceID,
 				},
-				"compute-optimizer": {
-					id: "compute-optimizer",
+				ComprehendServiceID: {
+					id: ComprehendServiceID,
 				},
-				"config": {
-					id: "config",
+				ComputeOptimizerServiceID: {
+					id: ComputeOptimizerServiceID,
 				},
-				"cur": {
-					id: "cur",
+				ConfigServiceID: {
+					id: ConfigServiceID,
 				},
-				"data-ats.iot": {
-					id: "data-ats.iot",
+				CostOptimizationHubServiceID: {
+					id: CostOptimizationHubServiceID,
 				},
-				"data.iot": {
-					id: "data.iot",
+				DirectconnectServiceID: {
+					id: DirectconnectServiceID,
 				},
-				"data.jobs.iot": {
-					id: "data.jobs.iot",
+				DlmServiceID: {
+					id: DlmServiceID,
 				},
-				"databrew": {
-					id: "databrew",
+				DmsServiceID: {
+					id: DmsServiceID,
 				},
-				"datasync": {
-					id: "datasync",
+				DsServiceID: {
+					id: DsServiceID,
 				},
-				"datazone": {
-					id: "datazone",
+				DynamodbServiceID: {
+					id: DynamodbServiceID,
 				},
-				"dax": {
-					id: "dax",
+				EbsServiceID: {
+					id: EbsServiceID,
 				},
-				"directconnect": {
-					id: "directconnect",
+				Ec2ServiceID: {
+					id: Ec2ServiceID,
 				},
-				"dlm": {
-					id: "dlm",
+				EcsServiceID: {
+					id: EcsServiceID,
 				},
-				"dms": {
-					id: "dms",
+				EksServiceID: {
+					id: EksServiceID,
 				},
-				"docdb": {
-					id: "docdb",
+				ElasticacheServiceID: {
+					id: ElasticacheServiceID,
 				},
-				"ds": {
-					id: "ds",
+				ElasticfilesystemServiceID: {
+					id: ElasticfilesystemServiceID,
 				},
-				"dynamodb": {
-					id: "dynamodb",
+				ElasticloadbalancingServiceID: {
+					id: ElasticloadbalancingServiceID,
 				},
-				"ebs": {
-					id: "ebs",
+				ElasticmapreduceServiceID: {
+					id: ElasticmapreduceServiceID,
 				},
-				"ec2": {
-					id: "ec2",
+				EsServiceID: {
+					id: EsServiceID,
 				},
-				"ecs": {
-					id: "ecs",
+				EventsServiceID: {
+					id: EventsServiceID,
 				},
-				"eks": {
-					id: "eks",
+				FirehoseServiceID: {
+					id: FirehoseServiceID,
 				},
-				"eks-auth": {
-					id: "eks-auth",
+				FsxServiceID: {
+					id: FsxServiceID,
 				},
-				"elasticache": {
-					id: "elasticache",
+				GlueServiceID: {
+					id: GlueServiceID,
 				},
-				"elasticbeanstalk": {
-					id: "elasticbeanstalk",
+				GuarddutyServiceID: {
+					id: GuarddutyServiceID,
 				},
-				"elasticfilesystem": {
-					id: "elasticfilesystem",
+				IamServiceID: {
+					id: IamServiceID,
 				},
-				"elasticloadbalancing": {
-					id: "elasticloadbalancing",
+				IdentitystoreServiceID: {
+					id: IdentitystoreServiceID,
 				},
-				"elasticmapreduce": {
-					id: "elasticmapreduce",
+				KinesisServiceID: {
+					id: KinesisServiceID,
 				},
-				"emr-containers": {
-					id: "emr-containers",
+				KmsServiceID: {
+					id: KmsServiceID,
 				},
-				"emr-serverless": {
-					id: "emr-serverless",
+				LakeformationServiceID: {
+					id: LakeformationServiceID,
 				},
-				"entitlement.marketplace": {
-					id: "entitlement.marketplace",
+				LambdaServiceID: {
+					id: LambdaServiceID,
 				},
-				"es": {
-					id: "es",
+				LicenseManagerServiceID: {
+					id: LicenseManagerServiceID,
 				},
-				"events": {
-					id: "events",
+				LogsServiceID: {
+					id: LogsServiceID,
 				},
-				"firehose": {
-					id: "firehose",
+				MetricsSagemakerServiceID: {
+					id: MetricsSagemakerServiceID,
 				},
-				"fms": {
-					id: "fms",
+				MonitoringServiceID: {
+					id: MonitoringServiceID,
 				},
-				"fsx": {
-					id: "fsx",
+				OamServiceID: {
+					id: OamServiceID,
 				},
-				"gamelift": {
-					id: "gamelift",
+				OrganizationsServiceID: {
+					id: OrganizationsServiceID,
 				},
-				"gameliftstreams": {
-					id: "gameliftstreams",
+				PiServiceID: {
+					id: PiServiceID,
 				},
-				"glacier": {
-					id: "glacier",
+				PipesServiceID: {
+					id: PipesServiceID,
 				},
-				"glue": {
-					id: "glue",
+				QuicksightServiceID: {
+					id: QuicksightServiceID,
 				},
-				"greengrass": {
-					id: "greengrass",
+				RamServiceID: {
+					id: RamServiceID,
 				},
-				"guardduty": {
-					id: "guardduty",
+				RbinServiceID: {
+					id: RbinServiceID,
 				},
-				"health": {
-					id: "health",
+				RdsServiceID: {
+					id: RdsServiceID,
 				},
-				"iam": {
-					id: "iam",
+				RedshiftServiceID: {
+					id: RedshiftServiceID,
 				},
-				"identitystore": {
-					id: "identitystore",
+				RedshiftServerlessServiceID: {
+					id: RedshiftServerlessServiceID,
 				},
-				"inspector2": {
-					id: "inspector2",
+				RekognitionServiceID: {
+					id: RekognitionServiceID,
 				},
-				"internetmonitor": {
-					id: "internetmonitor",
+				ResourceGroupsServiceID: {
+					id: ResourceGroupsServiceID,
 				},
-				"iot": {
-					id: "iot",
+				RolesanywhereServiceID: {
+					id: RolesanywhereServiceID,
 				},
-				"iotevents": {
-					id: "iotevents",
+				Route53ServiceID: {
+					id: Route53ServiceID,
 				},
-				"ioteventsdata": {
-					id: "ioteventsdata",
+				Route53profilesServiceID: {
+					id: Route53profilesServiceID,
 				},
-				"iotsecuredtunneling": {
-					id: "iotsecuredtunneling",
+				Route53resolverServiceID: {
+					id: Route53resolverServiceID,
 				},
-				"iotsitewise": {
-					id: "iotsitewise",
+				RuntimeSagemakerServiceID: {
+					id: RuntimeSagemakerServiceID,
 				},
-				"iottwinmaker": {
-					id: "iottwinmaker",
+				S3ServiceID: {
+					id: S3ServiceID,
 				},
-				"kafka": {
-					id: "kafka",
+				SavingsplansServiceID: {
+					id: SavingsplansServiceID,
 				},
-				"kafkaconnect": {
-					id: "kafkaconnect",
+				SchedulerServiceID: {
+					id: SchedulerServiceID,
 				},
-				"kendra-ranking": {
-					id: "kendra-ranking",
+				SchemasServiceID: {
+					id: SchemasServiceID,
 				},
-				"kinesis": {
-					id: "kinesis",
+				SecretsmanagerServiceID: {
+					id: SecretsmanagerServiceID,
 				},
-				"kinesisanalytics": {
-					id: "kinesisanalytics",
+				ServicediscoveryServiceID: {
+					id: ServicediscoveryServiceID,
 				},
-				"kinesisvideo": {
-					id: "kinesisvideo",
+				ServicequotasServiceID: {
+					id: ServicequotasServiceID,
 				},
-				"kms": {
-					id: "kms",
+				SnsServiceID: {
+					id: SnsServiceID,
 				},
-				"lakeformation": {
-					id: "lakeformation",
+				SqsServiceID: {
+					id: SqsServiceID,
 				},
-				"lambda": {
-					id: "lambda",
+				SsmServiceID: {
+					id: SsmServiceID,
 				},
-				"license-manager": {
-					id: "license-manager",
+				StatesServiceID: {
+					id: StatesServiceID,
 				},
-				"license-manager-linux-subscriptions": {
-					id: "license-manager-linux-subscriptions",
+				StreamsDynamodbServiceID: {
+					id: StreamsDynamodbServiceID,
 				},
-				"logs": {
-					id: "logs",
+				StsServiceID: {
+					id: StsServiceID,
 				},
-				"mediaconvert": {
-					id: "mediaconvert",
+				SwfServiceID: {
+					id: SwfServiceID,
 				},
-				"memory-db": {
-					id: "memory-db",
+				SyntheticsServiceID: {
+					id: SyntheticsServiceID,
 				},
-				"metering.marketplace": {
-					id: "metering.marketplace",
+				TaggingServiceID: {
+					id: TaggingServiceID,
 				},
-				"metrics.sagemaker": {
-					id: "metrics.sagemaker",
+				TextractServiceID: {
+					id: TextractServiceID,
 				},
-				"monitoring": {
-					id: "monitoring",
+				TranscribeServiceID: {
+					id: TranscribeServiceID,
 				},
-				"mq": {
-					id: "mq",
+				TranscribestreamingServiceID: {
+					id: TranscribestreamingServiceID,
 				},
-				"neptune": {
-					id: "neptune",
+				TranslateServiceID: {
+					id: TranslateServiceID,
 				},
-				"network-firewall": {
-					id: "network-firewall",
+				TrustedadvisorServiceID: {
+					id: TrustedadvisorServiceID,
 				},
-				"notifications": {
-					id: "notifications",
+				XrayServiceID: {
+					id: XrayServiceID,
 				},
-				"oam": {
-					id: "oam",
-				},
-				"oidc": {
-					id: "oidc",
-				},
-				"organizations": {
-					id: "organizations",
-				},
-				"personalize": {
-					id: "personalize",
-				},
-				"pi": {
-					id: "pi",
-				},
-				"pipes": {
-					id: "pipes",
-				},
-				"polly": {
-					id: "polly",
-				},
-				"portal.sso": {
-					id: "portal.sso",
-				},
-				"qbusiness": {
-					id: "qbusiness",
-				},
-				"quicksight": {
-					id: "quicksight",
-				},
-				"ram": {
-					id: "ram",
-				},
-				"rbin": {
-					id: "rbin",
-				},
-				"rds": {
-					id: "rds",
-				},
-				"redshift": {
-					id: "redshift",
-				},
-				"redshift-serverless": {
-					id: "redshift-serverless",
-				},
-				"resource-groups": {
-					id: "resource-groups",
-				},
-				"rolesanywhere": {
-					id: "rolesanywhere",
-				},
-				"route53": {
-					id: "route53",
-				},
-				"route53profiles": {
-					id: "route53profiles",
-				},
-				"route53resolver": {
-					id: "route53resolver",
-				},
-				"runtime.sagemaker": {
-					id: "runtime.sagemaker",
-				},
-				"s3": {
-					id: "s3",
-				},
-				"s3-control": {
-					id: "s3-control",
-				},
-				"savingsplans": {
-					id: "savingsplans",
-				},
-				"scheduler": {
-					id: "scheduler",
-				},
-				"schemas": {
-					id: "schemas",
-				},
-				"secretsmanager": {
-					id: "secretsmanager",
-				},
-				"securityhub": {
-					id: "securityhub",
-				},
-				"serverlessrepo": {
-					id: "serverlessrepo",
-				},
-				"servicecatalog": {
-					id: "servicecatalog",
-				},
-				"servicediscovery": {
-					id: "servicediscovery",
-				},
-				"servicequotas": {
-					id: "servicequotas",
-				},
-				"signer": {
-					id: "signer",
-				},
-				"snowball": {
-					id: "snowball",
-				},
-				"sns": {
-					id: "sns",
-				},
-				"sqs": {
-					id: "sqs",
-				},
-				"ssm": {
-					id: "ssm",
-				},
-				"sso": {
-					id: "sso",
-				},
-				"states": {
-					id: "states",
-				},
-				"storagegateway": {
-					id: "storagegateway",
-				},
-				"streams.dynamodb": {
-					id: "streams.dynamodb",
-				},
-				"sts": {
-					id: "sts",
-				},
-				"support": {
-					id: "support",
-				},
-				"swf": {
-					id: "swf",
-				},
-				"synthetics": {
-					id: "synthetics",
-				},
-				"tagging": {
-					id: "tagging",
-				},
-				"transcribe": {
-					id: "transcribe",
-				},
-				"transcribestreaming": {
-					id: "transcribestreaming",
-				},
-				"transfer": {
-					id: "transfer",
-				},
-				"verifiedpermissions": {
-					id: "verifiedpermissions",
-				},
-				"waf-regional": {
-					id: "waf-regional",
+			},
+		},
+		AwsUsGovPartitionID: {
+			id:                     AwsUsGovPartitionID,
+			name:                   "AWS GovCloud (US)",
+			dnsSuffix:              "amazonaws.com",
+			dualStackDNSSuffix:     "api.aws",
+			fipsDNSSuffix:          "amazonaws.com",
+			fipsDualStackDNSSuffix: "api.aws",
+			implicitGlobalRegion:   UsGovWest1RegionID,
+			supportsFIPS:           true,
+			supportsDualStack:      true,
+			regionRegex:            regexp.MustCompile(`^us\-gov\-\w+\-\d+$`),
+			defaults: endpoint{
+				hostname: "{service}.{region}.{dnsSuffix}",
+				variants: []endpointVariant{
+					{
+						variant:   DualStackVariant,
+						hostname:  "{service}.{region}.{dnsSuffix}",
+						dnsSuffix: "api.aws",
+					},
+					{
+						variant:   DualStackVariant | FIPSVariant,
+						hostname:  "{service}-fips.{region}.{dnsSuffix}",
+						dnsSuffix: "api.aws",
+					},
+					{
+						variant:   FIPSVariant,
+						hostname:  "{service}-fips.{region}.{dnsSuffix}",
+						dnsSuffix: "amazonaws.com",
+					},
 				},
-				"wafv2": {
-					id: "wafv2",
+			},
+			regions: map[string]Region{
+				UsGovEast1RegionID: {
+					id:          UsGovEast1RegionID,
+					description: "AWS GovCloud (US-East)",
 				},
-				"workspaces": {
-					id: "workspaces",
+				UsGovWest1RegionID: {
+					id:          UsGovWest1RegionID,
+					description: "AWS GovCloud (US-West)",
 				},
-				"xray": {
-					id: "xray",
-				},
-			},
-		},
-		AwsEuscPartitionID: {
-			id:          AwsEuscPartitionID,
-			name:        "AWS EUSC",
-			dnsSuffix:   "amazonaws.eu",
-			regionRegex: regexp.MustCompile(`^eusc\-(de)\-\w+\-\d+$`),
-			regions: map[string]Region{
-				EuscDeEast1RegionID: {
-					id:          EuscDeEast1RegionID,
-					description: "AWS European Sovereign Cloud (Germany)",
-				},
-			},
-			services: map[string]Service{
-				"access-analyzer": {
-					id: "access-analyzer",
-				},
-				"acm": {
-					id: "acm",
-				},
-				"acm-pca": {
-					id: "acm-pca",
-				},
-				"agreement-marketplace": {
-					id: "agreement-marketplace",
-				},
-				"api.ecr": {
-					id: "api.ecr",
-				},
-				"api.pricing": {
-					id: "api.pricing",
-				},
-				"api.sagemaker": {
-					id: "api.sagemaker",
-				},
-				"apigateway": {
-					id: "apigateway",
-				},
-				"appconfig": {
-					id: "appconfig",
-				},
-				"appconfigdata": {
-					id: "appconfigdata",
-				},
-				"application-autoscaling": {
-					id: "application-autoscaling",
-				},
-				"arc-zonal-shift": {
-					id: "arc-zonal-shift",
-				},
-				"athena": {
-					id: "athena",
-				},
-				"autoscaling": {
-					id: "autoscaling",
-				},
-				"backup": {
-					id: "backup",
-				},
-				"batch": {
-					id: "batch",
-				},
-				"bedrock": {
-					id: "bedrock",
-				},
-				"cloudcontrolapi": {
-					id: "cloudcontrolapi",
-				},
-				"cloudformation": {
-					id: "cloudformation",
-				},
-				"cloudtrail": {
-					id: "cloudtrail",
-				},
-				"codedeploy": {
-					id: "codedeploy",
-				},
-				"cognito-identity": {
-					id: "cognito-identity",
-				},
-				"cognito-idp": {
-					id: "cognito-idp",
-				},
-				"compute-optimizer": {
-					id: "compute-optimizer",
-				},
-				"config": {
-					id: "config",
-				},
-				"controltower": {
-					id: "controltower",
-				},
-				"cost-optimization-hub": {
-					id: "cost-optimization-hub",
-				},
-				"datasync": {
-					id: "datasync",
-				},
-				"datazone": {
-					id: "datazone",
-				},
-				"directconnect": {
-					id: "directconnect",
-				},
-				"dlm": {
-					id: "dlm",
-				},
-				"dms": {
-					id: "dms",
-				},
-				"drs": {
-					id: "drs",
-				},
-				"ds": {
-					id: "ds",
-				},
-				"dynamodb": {
-					id: "dynamodb",
-				},
-				"ebs": {
-					id: "ebs",
-				},
-				"ec2": {
-					id: "ec2",
-				},
-				"ecs": {
-					id: "ecs",
-				},
-				"eks": {
-					id: "eks",
-				},
-				"eks-auth": {
-					id: "eks-auth",
-				},
-				"elasticache": {
-					id: "elasticache",
-				},
-				"elasticfilesystem": {
-					id: "elasticfilesystem",
-				},
-				"elasticloadbalancing": {
-					id: "elasticloadbalancing",
-				},
-				"elasticmapreduce": {
-					id: "elasticmapreduce",
-				},
-				"email": {
-					id: "email",
-				},
-				"entitlement.marketplace": {
-					id: "entitlement.marketplace",
-				},
-				"es": {
-					id: "es",
-				},
-				"events": {
-					id: "events",
-				},
-				"firehose": {
-					id: "firehose",
-				},
-				"fsx": {
-					id: "fsx",
-				},
-				"gameliftstreams": {
-					id: "gameliftstreams",
-				},
-				"glue": {
-					id: "glue",
-				},
-				"guardduty": {
-					id: "guardduty",
-				},
-				"health": {
-					id: "health",
-				},
-				"identitystore": {
-					id: "identitystore",
-				},
-				"internetmonitor": {
-					id: "internetmonitor",
-				},
-				"kafka": {
-					id: "kafka",
-				},
-				"kendra-ranking": {
-					id: "kendra-ranking",
-				},
-				"kinesis": {
-					id: "kinesis",
-				},
-				"kinesisanalytics": {
-					id: "kinesisanalytics",
-				},
-				"kms": {
-					id: "kms",
-				},
-				"lakeformation": {
-					id: "lakeformation",
-				},
-				"lambda": {
-					id: "lambda",
-				},
-				"license-manager": {
-					id: "license-manager",
-				},
-				"logs": {
-					id: "logs",
-				},
-				"metering.marketplace": {
-					id: "metering.marketplace",
-				},
-				"metrics.sagemaker": {
-					id: "metrics.sagemaker",
-				},
-				"monitoring": {
-					id: "monitoring",
-				},
-				"network-firewall": {
-					id: "network-firewall",
-				},
-				"notifications": {
-					id: "notifications",
-				},
-				"oam": {
-					id: "oam",
-				},
-				"oidc": {
-					id: "oidc",
-				},
-				"pi": {
-					id: "pi",
-				},
-				"polly": {
-					id: "polly",
-				},
-				"portal.sso": {
-					id: "portal.sso",
-				},
-				"qbusiness": {
-					id: "qbusiness",
-				},
-				"ram": {
-					id: "ram",
-				},
-				"rbin": {
-					id: "rbin",
-				},
-				"rds": {
-					id: "rds",
-				},
-				"redshift": {
-					id: "redshift",
-				},
-				"resource-groups": {
-					id: "resource-groups",
-				},
-				"rolesanywhere": {
-					id: "rolesanywhere",
-				},
-				"route53profiles": {
-					id: "route53profiles",
-				},
-				"route53resolver": {
-					id: "route53resolver",
-				},
-				"rum": {
-					id: "rum",
-				},
-				"runtime.sagemaker": {
-					id: "runtime.sagemaker",
-				},
-				"s3": {
-					id: "s3",
-				},
-				"s3-control": {
-					id: "s3-control",
-				},
-				"scheduler": {
-					id: "scheduler",
-				},
-				"secretsmanager": {
-					id: "secretsmanager",
-				},
-				"securityhub": {
-					id: "securityhub",
-				},
-				"servicediscovery": {
-					id: "servicediscovery",
-				},
-				"servicequotas": {
-					id: "servicequotas",
-				},
-				"signer": {
-					id: "signer",
-				},
-				"sms-voice": {
-					id: "sms-voice",
-				},
-				"sns": {
-					id: "sns",
-				},
-				"sqs": {
-					id: "sqs",
-				},
-				"ssm": {
-					id: "ssm",
-				},
-				"sso": {
-					id: "sso",
-				},
-				"states": {
-					id: "states",
-				},
-				"storagegateway": {
-					id: "storagegateway",
-				},
-				"streams.dynamodb": {
-					id: "streams.dynamodb",
-				},
-				"sts": {
-					id: "sts",
-				},
-				"swf": {
-					id: "swf",
-				},
-				"synthetics": {
-					id: "synthetics",
-				},
-				"tagging": {
-					id: "tagging",
-				},
-				"transfer": {
-					id: "transfer",
-				},
-				"trustedadvisor": {
-					id: "trustedadvisor",
-				},
-				"wafv2": {
-					id: "wafv2",
-				},
-				"xray": {
-					id: "xray",
-				},
-			},
-		},
-		AwsIsoPartitionID: {
-			id:          AwsIsoPartitionID,
-			name:        "AWS ISO (US)",
-			dnsSuffix:   "c2s.ic.gov",
-			regionRegex: regexp.MustCompile(`^us\-iso\-\w+\-\d+$`),
-			regions: map[string]Region{
-				UsIsoEast1RegionID: {
-					id:          UsIsoEast1RegionID,
-					description: "US ISO East",
-				},
-				UsIsoWest1RegionID: {
-					id:          UsIsoWest1RegionID,
-					description: "US ISO WEST",
-				},
-			},
-			services: map[string]Service{
-				"acm": {
-					id: "acm",
-				},
-				"acm-pca": {
-					id: "acm-pca",
-				},
-				"agreement-marketplace": {
-					id: "agreement-marketplace",
-				},
-				"api.ecr": {
-					id: "api.ecr",
-				},
-				"api.pricing": {
-					id: "api.pricing",
-				},
-				"api.sagemaker": {
-					id: "api.sagemaker",
-				},
-				"apigateway": {
-					id: "apigateway",
-				},
-				"appconfig": {
-					id: "appconfig",
-				},
-				"appconfigdata": {
-					id: "appconfigdata",
-				},
-				"application-autoscaling": {
-					id: "application-autoscaling",
-				},
-				"arc-zonal-shift": {
-					id: "arc-zonal-shift",
-				},
-				"athena": {
-					id: "athena",
-				},
-				"autoscaling": {
-					id: "autoscaling",
-				},
-				"backup": {
-					id: "backup",
-				},
-				"batch": {
-					id: "batch",
-				},
-				"bedrock": {
-					id: "bedrock",
-				},
-				"budgets": {
-					id: "budgets",
-				},
-				"ce": {
-					id: "ce",
-				},
-				"cloudcontrolapi": {
-					id: "cloudcontrolapi",
-				},
-				"cloudformation": {
-					id: "cloudformation",
-				},
-				"cloudtrail": {
-					id: "cloudtrail",
-				},
-				"codebuild": {
-					id: "codebuild",
-				},
-				"codedeploy": {
-					id: "codedeploy",
-				},
-				"comprehend": {
-					id: "comprehend",
-				},
-				"config": {
-					id: "config",
-				},
-				"datapipeline": {
-					id: "datapipeline",
-				},
-				"datasync": {
-					id: "datasync",
-				},
-				"directconnect": {
-					id: "directconnect",
-				},
-				"dlm": {
-					id: "dlm",
-				},
-				"dms": {
-					id: "dms",
-				},
-				"ds": {
-					id: "ds",
-				},
-				"dynamodb": {
-					id: "dynamodb",
-				},
-				"ebs": {
-					id: "ebs",
-				},
-				"ec2": {
-					id: "ec2",
-				},
-				"ecs": {
-					id: "ecs",
-				},
-				"eks": {
-					id: "eks",
-				},
-				"elasticache": {
-					id: "elasticache",
-				},
-				"elasticfilesystem": {
-					id: "elasticfilesystem",
-				},
-				"elasticloadbalancing": {
-					id: "elasticloadbalancing",
-				},
-				"elasticmapreduce": {
-					id: "elasticmapreduce",
-				},
-				"es": {
-					id: "es",
-				},
-				"events": {
-					id: "events",
-				},
-				"firehose": {
-					id: "firehose",
-				},
-				"fsx": {
-					id: "fsx",
-				},
-				"glacier": {
-					id: "glacier",
-				},
-				"glue": {
-					id: "glue",
-				},
-				"guardduty": {
-					id: "guardduty",
-				},
-				"health": {
-					id: "health",
-				},
-				"iam": {
-					id: "iam",
-				},
-				"kinesis": {
-					id: "kinesis",
-				},
-				"kinesisanalytics": {
-					id: "kinesisanalytics",
-				},
-				"kinesisvideo": {
-					id: "kinesisvideo",
-				},
-				"kms": {
-					id: "kms",
-				},
-				"lakeformation": {
-					id: "lakeformation",
-				},
-				"lambda": {
-					id: "lambda",
-				},
-				"license-manager": {
-					id: "license-manager",
-				},
-				"logs": {
-					id: "logs",
-				},
-				"medialive": {
-					id: "medialive",
-				},
-				"mediapackage": {
-					id: "mediapackage",
-				},
-				"metrics.sagemaker": {
-					id: "metrics.sagemaker",
-				},
-				"monitoring": {
-					id: "monitoring",
-				},
-				"mq": {
-					id: "mq",
-				},
-				"network-firewall": {
-					id: "network-firewall",
-				},
-				"oam": {
-					id: "oam",
-				},
-				"organizations": {
-					id: "organizations",
-				},
-				"outposts": {
-					id: "outposts",
-				},
-				"pi": {
-					id: "pi",
-				},
-				"ram": {
-					id: "ram",
-				},
-				"rbin": {
-					id: "rbin",
-				},
-				"rds": {
-					id: "rds",
-				},
-				"redshift": {
-					id: "redshift",
-				},
-				"resource-groups": {
-					id: "resource-groups",
-				},
-				"route53": {
-					id: "route53",
-				},
-				"route53resolver": {
-					id: "route53resolver",
-				},
-				"runtime.sagemaker": {
-					id: "runtime.sagemaker",
-				},
-				"s3": {
-					id: "s3",
-				},
-				"s3-control": {
-					id: "s3-control",
-				},
-				"s3-outposts": {
-					id: "s3-outposts",
-				},
-				"scheduler": {
-					id: "scheduler",
-				},
-				"secretsmanager": {
-					id: "secretsmanager",
-				},
-				"securityhub": {
-					id: "securityhub",
-				},
-				"servicediscovery": {
-					id: "servicediscovery",
-				},
-				"servicequotas": {
-					id: "servicequotas",
-				},
-				"snowball": {
-					id: "snowball",
-				},
-				"sns": {
-					id: "sns",
-				},
-				"sqs": {
-					id: "sqs",
-				},
-				"ssm": {
-					id: "ssm",
-				},
-				"states": {
-					id: "states",
-				},
-				"storagegateway": {
-					id: "storagegateway",
-				},
-				"streams.dynamodb": {
-					id: "streams.dynamodb",
-				},
-				"sts": {
-					id: "sts",
-				},
-				"support": {
-					id: "support",
-				},
-				"swf": {
-					id: "swf",
-				},
-				"synthetics": {
-					id: "synthetics",
-				},
-				"tagging": {
-					id: "tagging",
-				},
-				"textract": {
-					id: "textract",
-				},
-				"transcribe": {
-					id: "transcribe",
-				},
-				"transcribestreaming": {
-					id: "transcribestreaming",
-				},
-				"translate": {
-					id: "translate",
-				},
-				"wafv2": {
-					id: "wafv2",
-				},
-				"workspaces": {
-					id: "workspaces",
-				},
-				"xray": {
-					id: "xray",
-				},
-			},
-		},
-		AwsIsoBPartitionID: {
-			id:          AwsIsoBPartitionID,
-			name:        "AWS ISOB (US)",
-			dnsSuffix:   "sc2s.sgov.gov",
-			regionRegex: regexp.MustCompile(`^us\-isob\-\w+\-\d+$`),
-			regions: map[string]Region{
-				UsIsobEast1RegionID: {
-					id:          UsIsobEast1RegionID,
-					description: "US ISOB East (Ohio)",
-				},
-				UsIsobWest1RegionID: {
-					id:          UsIsobWest1RegionID,
-					description: "US ISOB West",
-				},
-			},
-			services: map[string]Service{
-				"acm": {
-					id: "acm",
-				},
-				"agreement-marketplace": {
-					id: "agreement-marketplace",
-				},
-				"api.ecr": {
-					id: "api.ecr",
-				},
-				"api.pricing": {
-					id: "api.pricing",
-				},
-				"api.sagemaker": {
-					id: "api.sagemaker",
-				},
-				"apigateway": {
-					id: "apigateway",
-				},
-				"appconfig": {
-					id: "appconfig",
-				},
-				"appconfigdata": {
-					id: "appconfigdata",
-				},
-				"application-autoscaling": {
-					id: "application-autoscaling",
-				},
-				"appstream2": {
-					id: "appstream2",
-				},
-				"arc-zonal-shift": {
-					id: "arc-zonal-shift",
-				},
-				"athena": {
-					id: "athena",
-				},
-				"autoscaling": {
-					id: "autoscaling",
-				},
-				"backup": {
-					id: "backup",
-				},
-				"batch": {
-					id: "batch",
-				},
-				"bedrock": {
-					id: "bedrock",
-				},
-				"budgets": {
-					id: "budgets",
-				},
-				"ce": {
-					id: "ce",
-				},
-				"cloudcontrolapi": {
-					id: "cloudcontrolapi",
-				},
-				"cloudformation": {
-					id: "cloudformation",
-				},
-				"cloudtrail": {
-					id: "cloudtrail",
-				},
-				"codebuild": {
-					id: "codebuild",
-				},
-				"codedeploy": {
-					id: "codedeploy",
-				},
-				"config": {
-					id: "config",
-				},
-				"datasync": {
-					id: "datasync",
-				},
-				"directconnect": {
-					id: "directconnect",
-				},
-				"dlm": {
-					id: "dlm",
-				},
-				"dms": {
-					id: "dms",
-				},
-				"ds": {
-					id: "ds",
-				},
-				"dynamodb": {
-					id: "dynamodb",
-				},
-				"ebs": {
-					id: "ebs",
-				},
-				"ec2": {
-					id: "ec2",
-				},
-				"ecs": {
-					id: "ecs",
-				},
-				"eks": {
-					id: "eks",
-				},
-				"elasticache": {
-					id: "elasticache",
-				},
-				"elasticfilesystem": {
-					id: "elasticfilesystem",
-				},
-				"elasticloadbalancing": {
-					id: "elasticloadbalancing",
-				},
-				"elasticmapreduce": {
-					id: "elasticmapreduce",
-				},
-				"es": {
-					id: "es",
-				},
-				"events": {
-					id: "events",
-				},
-				"firehose": {
-					id: "firehose",
-				},
-				"fsx": {
-					id: "fsx",
-				},
-				"glacier": {
-					id: "glacier",
-				},
-				"glue": {
-					id: "glue",
-				},
-				"guardduty": {
-					id: "guardduty",
-				},
-				"health": {
-					id: "health",
-				},
-				"iam": {
-					id: "iam",
-				},
-				"kinesis": {
-					id: "kinesis",
-				},
-				"kinesisanalytics": {
-					id: "kinesisanalytics",
-				},
-				"kms": {
-					id: "kms",
-				},
-				"lakeformation": {
-					id: "lakeformation",
-				},
-				"lambda": {
-					id: "lambda",
-				},
-				"license-manager": {
-					id: "license-manager",
-				},
-				"logs": {
-					id: "logs",
-				},
-				"medialive": {
-					id: "medialive",
-				},
-				"mediapackage": {
-					id: "mediapackage",
-				},
-				"metering.marketplace": {
-					id: "metering.marketplace",
-				},
-				"metrics.sagemaker": {
-					id: "metrics.sagemaker",
-				},
-				"monitoring": {
-					id: "monitoring",
-				},
-				"network-firewall": {
-					id: "network-firewall",
-				},
-				"oam": {
-					id: "oam",
-				},
-				"organizations": {
-					id: "organizations",
-				},
-				"outposts": {
-					id: "outposts",
-				},
-				"pi": {
-					id: "pi",
-				},
-				"ram": {
-					id: "ram",
-				},
-				"rbin": {
-					id: "rbin",
-				},
-				"rds": {
-					id: "rds",
-				},
-				"redshift": {
-					id: "redshift",
-				},
-				"resource-groups": {
-					id: "resource-groups",
-				},
-				"route53": {
-					id: "route53",
-				},
-				"route53resolver": {
-					id: "route53resolver",
-				},
-				"runtime.sagemaker": {
-					id: "runtime.sagemaker",
-				},
-				"s3": {
-					id: "s3",
-				},
-				"s3-control": {
-					id: "s3-control",
-				},
-				"s3-outposts": {
-					id: "s3-outposts",
-				},
-				"scheduler": {
-					id: "scheduler",
-				},
-				"secretsmanager": {
-					id: "secretsmanager",
-				},
-				"securityhub": {
-					id: "securityhub",
-				},
-				"servicediscovery": {
-					id: "servicediscovery",
-				},
-				"servicequotas": {
-					id: "servicequotas",
-				},
-				"snowball": {
-					id: "snowball",
-				},
-				"sns": {
-					id: "sns",
-				},
-				"sqs": {
-					id: "sqs",
-				},
-				"ssm": {
-					id: "ssm",
-				},
-				"states": {
-					id: "states",
-				},
-				"storagegateway": {
-					id: "storagegateway",
-				},
-				"streams.dynamodb": {
-					id: "streams.dynamodb",
-				},
-				"sts": {
-					id: "sts",
-				},
-				"support": {
-					id: "support",
-				},
-				"swf": {
-					id: "swf",
-				},
-				"synthetics": {
-					id: "synthetics",
-				},
-				"tagging": {
-					id: "tagging",
-				},
-				"wafv2": {
-					id: "wafv2",
-				},
-				"workspaces": {
-					id: "workspaces",
-				},
-				"xray": {
-					id: "xray",
-				},
-			},
-		},
-		AwsIsoEPartitionID: {
-			id:          AwsIsoEPartitionID,
-			name:        "AWS ISOE (Europe)",
-			dnsSuffix:   "cloud.adc-e.uk",
-			regionRegex: regexp.MustCompile(`^eu\-isoe\-\w+\-\d+$`),
-			regions: map[string]Region{
-				EuIsoeWest1RegionID: {
-					id:          EuIsoeWest1RegionID,
-					description: "EU ISOE West",
-				},
-			},
-			services: map[string]Service{
-				"access-analyzer": {
-					id: "access-analyzer",
-				},
-				"acm": {
-					id: "acm",
-				},
-				"acm-pca": {
-					id: "acm-pca",
-				},
-				"api.ecr": {
-					id: "api.ecr",
-				},
-				"api.pricing": {
-					id: "api.pricing",
-				},
-				"apigateway": {
-					id: "apigateway",
-				},
-				"appconfig": {
-					id: "appconfig",
-				},
-				"appconfigdata": {
-					id: "appconfigdata",
-				},
-				"application-autoscaling": {
-					id: "application-autoscaling",
-				},
-				"arc-zonal-shift": {
-					id: "arc-zonal-shift",
-				},
-				"athena": {
-					id: "athena",
-				},
-				"autoscaling": {
-					id: "autoscaling",
-				},
-				"batch": {
-					id: "batch",
-				},
-				"budgets": {
-					id: "budgets",
-				},
-				"cloudcontrolapi": {
-					id: "cloudcontrolapi",
-				},
-				"cloudformation": {
-					id: "cloudformation",
-				},
-				"cloudtrail": {
-					id: "cloudtrail",
-				},
-				"cloudtrail-data": {
-					id: "cloudtrail-data",
-				},
-				"codedeploy": {
-					id: "codedeploy",
-				},
-				"compute-optimizer": {
-					id: "compute-optimizer",
-				},
-				"config": {
-					id: "config",
-				},
-				"cost-optimization-hub": {
-					id: "cost-optimization-hub",
-				},
-				"directconnect": {
-					id: "directconnect",
-				},
-				"dlm": {
-					id: "dlm",
-				},
-				"dms": {
-					id: "dms",
-				},
-				"ds": {
-					id: "ds",
-				},
-				"dynamodb": {
-					id: "dynamodb",
-				},
-				"ebs": {
-					id: "ebs",
-				},
-				"ec2": {
-					id: "ec2",
-				},
-				"ecs": {
-					id: "ecs",
-				},
-				"eks": {
-					id: "eks",
-				},
-				"elasticache": {
-					id: "elasticache",
-				},
-				"elasticfilesystem": {
-					id: "elasticfilesystem",
-				},
-				"elasticloadbalancing": {
-					id: "elasticloadbalancing",
-				},
-				"elasticmapreduce": {
-					id: "elasticmapreduce",
-				},
-				"emr-serverless": {
-					id: "emr-serverless",
-				},
-				"es": {
-					id: "es",
-				},
-				"events": {
-					id: "events",
-				},
-				"firehose": {
-					id: "firehose",
-				},
-				"glue": {
-					id: "glue",
-				},
-				"kinesis": {
-					id: "kinesis",
-				},
-				"kms": {
-					id: "kms",
-				},
-				"lakeformation": {
-					id: "lakeformation",
-				},
-				"lambda": {
-					id: "lambda",
-				},
-				"license-manager": {
-					id: "license-manager",
-				},
-				"logs": {
-					id: "logs",
-				},
-				"metrics.sagemaker": {
-					id: "metrics.sagemaker",
-				},
-				"monitoring": {
-					id: "monitoring",
-				},
-				"oam": {
-					id: "oam",
-				},
-				"organizations": {
-					id: "organizations",
-				},
-				"pi": {
-					id: "pi",
-				},
-				"pipes": {
-					id: "pipes",
-				},
-				"ram": {
-					id: "ram",
-				},
-				"rbin": {
-					id: "rbin",
-				},
-				"rds": {
-					id: "rds",
-				},
-				"redshift": {
-					id: "redshift",
-				},
-				"redshift-serverless": {
-					id: "redshift-serverless",
-				},
-				"rekognition": {
-					id: "rekognition",
-				},
-				"resource-groups": {
-					id: "resource-groups",
-				},
-				"route53": {
-					id: "route53",
-				},
-				"route53profiles": {
-					id: "route53profiles",
-				},
-				"route53resolver": {
-					id: "route53resolver",
-				},
-				"s3": {
-					id: "s3",
-				},
-				"savingsplans": {
-					id: "savingsplans",
-				},
-				"scheduler": {
-					id: "scheduler",
-				},
-				"schemas": {
-					id: "schemas",
-				},
-				"secretsmanager": {
-					id: "secretsmanager",
-				},
-				"servicecatalog": {
-					id: "servicecatalog",
-				},
-				"servicediscovery": {
-					id: "servicediscovery",
-				},
-				"servicequotas": {
-					id: "servicequotas",
-				},
-				"sns": {
-					id: "sns",
-				},
-				"sqs": {
-					id: "sqs",
-				},
-				"ssm": {
-					id: "ssm",
-				},
-				"states": {
-					id: "states",
-				},
-				"streams.dynamodb": {
-					id: "streams.dynamodb",
-				},
-				"sts": {
-					id: "sts",
-				},
-				"swf": {
-					id: "swf",
-				},
-				"synthetics": {
-					id: "synthetics",
-				},
-				"tagging": {
-					id: "tagging",
-				},
-				"trustedadvisor": {
-					id: "trustedadvisor",
-				},
-				"xray": {
-					id: "xray",
-				},
-			},
-		},
-		AwsIsoFPartitionID: {
-			id:          AwsIsoFPartitionID,
-			name:        "AWS ISOF",
-			dnsSuffix:   "csp.hci.ic.gov",
-			regionRegex: regexp.MustCompile(`^us\-isof\-\w+\-\d+$`),
-			regions: map[string]Region{
-				UsIsofEast1RegionID: {
-					id:          UsIsofEast1RegionID,
-					description: "US ISOF EAST",
-				},
-				UsIsofSouth1RegionID: {
-					id:          UsIsofSouth1RegionID,
-					description: "US ISOF SOUTH",
-				},
-			},
-			services: map[string]Service{
-				"access-analyzer": {
-					id: "access-analyzer",
-				},
-				"acm": {
-					id: "acm",
-				},
-				"acm-pca": {
-					id: "acm-pca",
-				},
-				"agreement-marketplace": {
-					id: "agreement-marketplace",
-				},
-				"api.ecr": {
-					id: "api.ecr",
-				},
-				"api.pricing": {
-					id: "api.pricing",
-				},
-				"api.sagemaker": {
-					id: "api.sagemaker",
-				},
-				"appconfig": {
-					id: "appconfig",
-				},
-				"appconfigdata": {
-					id: "appconfigdata",
-				},
-				"application-autoscaling": {
-					id: "application-autoscaling",
-				},
-				"arc-zonal-shift": {
-					id: "arc-zonal-shift",
-				},
-				"athena": {
-					id: "athena",
-				},
-				"autoscaling": {
-					id: "autoscaling",
-				},
-				"backup": {
-					id: "backup",
-				},
-				"batch": {
-					id: "batch",
-				},
-				"bedrock": {
-					id: "bedrock",
-				},
-				"budgets": {
-					id: "budgets",
-				},
-				"ce": {
-					id: "ce",
-				},
-				"cloudcontrolapi": {
-					id: "cloudcontrolapi",
-				},
-				"cloudformation": {
-					id: "cloudformation",
-				},
-				"cloudtrail": {
-					id: "cloudtrail",
-				},
-				"cloudtrail-data": {
-					id: "cloudtrail-data",
-				},
-				"codebuild": {
-					id: "codebuild",
-				},
-				"codedeploy": {
-					id: "codedeploy",
-				},
-				"codepipeline": {
-					id: "codepipeline",
-				},
-				"comprehend": {
-					id: "comprehend",
-				},
-				"compute-optimizer": {
-					id: "compute-optimizer",
-				},
-				"config": {
-					id: "config",
-				},
-				"cost-optimization-hub": {
-					id: "cost-optimization-hub",
-				},
-				"directconnect": {
-					id: "directconnect",
-				},
-				"dlm": {
-					id: "dlm",
-				},
-				"dms": {
-					id: "dms",
-				},
-				"ds": {
-					id: "ds",
-				},
-				"dynamodb": {
-					id: "dynamodb",
-				},
-				"ebs": {
-					id: "ebs",
-				},
-				"ec2": {
-					id: "ec2",
-				},
-				"ecs": {
-					id: "ecs",
-				},
-				"eks": {
-					id: "eks",
-				},
-				"elasticache": {
-					id: "elasticache",
-				},
-				"elasticfilesystem": {
-					id: "elasticfilesystem",
-				},
-				"elasticloadbalancing": {
-					id: "elasticloadbalancing",
-				},
-				"elasticmapreduce": {
-					id: "elasticmapreduce",
-				},
-				"es": {
-					id: "es",
-				},
-				"events": {
-					id: "events",
-				},
-				"firehose": {
-					id: "firehose",
-				},
-				"fsx": {
-					id: "fsx",
-				},
-				"glue": {
-					id: "glue",
-				},
-				"guardduty": {
-					id: "guardduty",
-				},
-				"iam": {
-					id: "iam",
-				},
-				"identitystore": {
-					id: "identitystore",
-				},
-				"kinesis": {
-					id: "kinesis",
-				},
-				"kms": {
-					id: "kms",
-				},
-				"lakeformation": {
-					id: "lakeformation",
-				},
-				"lambda": {
-					id: "lambda",
-				},
-				"license-manager": {
-					id: "license-manager",
-				},
-				"logs": {
-					id: "logs",
-				},
-				"metrics.sagemaker": {
-					id: "metrics.sagemaker",
-				},
-				"monitoring": {
-					id: "monitoring",
-				},
-				"oam": {
-					id: "oam",
-				},
-				"organizations": {
-					id: "organizations",
-				},
-				"pi": {
-					id: "pi",
-				},
-				"pipes": {
-					id: "pipes",
-				},
-				"quicksight": {
-					id: "quicksight",
-				},
-				"ram": {
-					id: "ram",
-				},
-				"rbin": {
-					id: "rbin",
-				},
-				"rds": {
-					id: "rds",
-				},
-				"redshift": {
-					id: "redshift",
-				},
-				"redshift-serverless": {
-					id: "redshift-serverless",
-				},
-				"rekognition": {
-					id: "rekognition",
-				},
-				"resource-groups": {
-					id: "resource-groups",
-				},
-				"rolesanywhere": {
-					id: "rolesanywhere",
-				},
-				"route53": {
-					id: "route53",
-				},
-				"route53profiles": {
-					id: "route53profiles",
-				},
-				"route53resolver": {
-					id: "route53resolver",
-				},
-				"runtime.sagemaker": {
-					id: "runtime.sagemaker",
-				},
-				"s3": {
-					id: "s3",
-				},
-				"savingsplans": {
-					id: "savingsplans",
-				},
-				"scheduler": {
-					id: "scheduler",
-				},
-				"schemas": {
-					id: "schemas",
-				},
-				"secretsmanager": {
-					id: "secretsmanager",
-				},
-				"servicediscovery": {
-					id: "servicediscovery",
-				},
-				"servicequotas": {
-					id: "servicequotas",
-				},
-				"sns": {
-					id: "sns",
-				},
-				"sqs": {
-					id: "sqs",
-				},
-				"ssm": {
-					id: "ssm",
-				},
-				"states": {
-					id: "states",
-				},
-				"streams.dynamodb": {
-					id: "streams.dynamodb",
-				},
-				"sts": {
-					id: "sts",
-				},
-				"swf": {
-					id: "swf",
-				},
-				"synthetics": {
-					id: "synthetics",
-				},
-				"tagging": {
-					id: "tagging",
-				},
-				"textract": {
-					id: "textract",
-				},
-				"transcribe": {
-					id: "transcribe",
-				},
-				"transcribestreaming": {
-					id: "transcribestreaming",
-				},
-				"translate": {
-					id: "translate",
-				},
-				"trustedadvisor": {
-					id: "trustedadvisor",
-				},
-				"xray": {
-					id: "xray",
-				},
-			},
-		},
-		AwsUsGovPartitionID: {
-			id:          AwsUsGovPartitionID,
-			name:        "AWS GovCloud (US)",
-			dnsSuffix:   "amazonaws.com",
-			regionRegex: regexp.MustCompile(`^us\-gov\-\w+\-\d+$`),
-			regions: map[string]Region{
-				UsGovEast1RegionID: {
-					id:          UsGovEast1RegionID,
-					description: "AWS GovCloud (US-East)",
-				},
-				UsGovWest1RegionID: {
-					id:          UsGovWest1RegionID,
-					description: "AWS GovCloud (US-West)",
-				},
-			},
-			services: map[string]Service{
-				"access-analyzer": {
-					id: "access-analyzer",
-				},
-				"acm": {
-					id: "acm",
-				},
-				"acm-pca": {
-					id: "acm-pca",
-				},
-				"aoss": {
-					id: "aoss",
-				},
-				"api.detective": {
-					id: "api.detective",
-				},
-				"api.ecr": {
-					id: "api.ecr",
-				},
-				"api.sagemaker": {
-					id: "api.sagemaker",
-				},
-				"api.tunneling.iot": {
-					id: "api.tunneling.iot",
-				},
-				"apigateway": {
-					id: "apigateway",
-				},
-				"appconfig": {
-					id: "appconfig",
-				},
-				"appconfigdata": {
-					id: "appconfigdata",
-				},
-				"application-autoscaling": {
-					id: "application-autoscaling",
-				},
-				"applicationinsights": {
-					id: "applicationinsights",
-				},
-				"appstream2": {
-					id: "appstream2",
-				},
-				"aps": {
-					id: "aps",
-				},
-				"arc-zonal-shift": {
-					id: "arc-zonal-shift",
-				},
-				"athena": {
-					id: "athena",
-				},
-				"autoscaling": {
-					id: "autoscaling",
-				},
-				"autoscaling-plans": {
-					id: "autoscaling-plans",
-				},
-				"backup": {
-					id: "backup",
-				},
-				"backup-gateway": {
-					id: "backup-gateway",
-				},
-				"batch": {
-					id: "batch",
-				},
-				"bedrock": {
-					id: "bedrock",
-				},
-				"cassandra": {
-					id: "cassandra",
-				},
-				"cloudcontrolapi": {
-					id: "cloudcontrolapi",
-				},
-				"clouddirectory": {
-					id: "clouddirectory",
-				},
-				"cloudformation": {
-					id: "cloudformation",
-				},
-				"cloudhsm": {
-					id: "cloudhsm",
-				},
-				"cloudhsmv2": {
-					id: "cloudhsmv2",
-				},
-				"cloudtrail": {
-					id: "cloudtrail",
-				},
-				"codebuild": {
-					id: "codebuild",
-				},
-				"codecommit": {
-					id: "codecommit",
-				},
-				"codedeploy": {
-					id: "codedeploy",
-				},
-				"codepipeline": {
-					id: "codepipeline",
-				},
-				"codestar-connections": {
-					id: "codestar-connections",
-				},
-				"cognito-identity": {
-					id: "cognito-identity",
-				},
-				"cognito-idp": {
-					id: "cognito-idp",
-				},
-				"comprehend": {
-					id: "comprehend",
-				},
-				"comprehendmedical": {
-					id: "comprehendmedical",
-				},
-				"compute-optimizer": {
-					id: "compute-optimizer",
-				},
-				"config": {
-					id: "config",
-				},
-				"connect": {
-					id: "connect",
-				},
-				"controltower": {
-					id: "controltower",
-				},
-				"data-ats.iot": {
-					id: "data-ats.iot",
-				},
-				"data.iot": {
-					id: "data.iot",
-				},
-				"data.jobs.iot": {
-					id: "data.jobs.iot",
-				},
-				"databrew": {
-					id: "databrew",
-				},
-				"datasync": {
-					id: "datasync",
-				},
-				"datazone": {
-					id: "datazone",
-				},
-				"directconnect": {
-					id: "directconnect",
-				},
-				"dlm": {
-					id: "dlm",
-				},
-				"dms": {
-					id: "dms",
-				},
-				"docdb": {
-					id: "docdb",
-				},
-				"drs": {
-					id: "drs",
-				},
-				"ds": {
-					id: "ds",
-				},
-				"dynamodb": {
-					id: "dynamodb",
-				},
-				"ebs": {
-					id: "ebs",
-				},
-				"ec2": {
-					id: "ec2",
-				},
-				"ecs": {
-					id: "ecs",
-				},
-				"eks": {
-					id: "eks",
-				},
-				"eks-auth": {
-					id: "eks-auth",
-				},
-				"elasticache": {
-					id: "elasticache",
-				},
-				"elasticbeanstalk": {
-					id: "elasticbeanstalk",
-				},
-				"elasticfilesystem": {
-					id: "elasticfilesystem",
-				},
-				"elasticloadbalancing": {
-					id: "elasticloadbalancing",
-				},
-				"elasticmapreduce": {
-					id: "elasticmapreduce",
-				},
-				"email": {
-					id: "email",
-				},
-				"emr-containers": {
-					id: "emr-containers",
-				},
-				"emr-serverless": {
-					id: "emr-serverless",
-				},
-				"es": {
-					id: "es",
-				},
-				"events": {
-					id: "events",
-				},
-				"firehose": {
-					id: "firehose",
-				},
-				"fms": {
-					id: "fms",
-				},
-				"fsx": {
-					id: "fsx",
-				},
-				"gameliftstreams": {
-					id: "gameliftstreams",
-				},
-				"geo": {
-					id: "geo",
-				},
-				"glacier": {
-					id: "glacier",
-				},
-				"glue": {
-					id: "glue",
-				},
-				"grafana": {
-					id: "grafana",
-				},
-				"greengrass": {
-					id: "greengrass",
-				},
-				"guardduty": {
-					id: "guardduty",
-				},
-				"health": {
-					id: "health",
-				},
-				"iam": {
-					id: "iam",
-				},
-				"identitystore": {
-					id: "identitystore",
-				},
-				"ingest.timestream": {
-					id: "ingest.timestream",
-				},
-				"inspector": {
-					id: "inspector",
-				},
-				"inspector2": {
-					id: "inspector2",
-				},
-				"internetmonitor": {
-					id: "internetmonitor",
-				},
-				"iot": {
-					id: "iot",
-				},
-				"iotevents": {
-					id: "iotevents",
-				},
-				"ioteventsdata": {
-					id: "ioteventsdata",
-				},
-				"iotsecuredtunneling": {
-					id: "iotsecuredtunneling",
-				},
-				"iotsitewise": {
-					id: "iotsitewise",
-				},
-				"iottwinmaker": {
-					id: "iottwinmaker",
-				},
-				"kafka": {
-					id: "kafka",
-				},
-				"kafkaconnect": {
-					id: "kafkaconnect",
-				},
-				"kendra": {
-					id: "kendra",
-				},
-				"kendra-ranking": {
-					id: "kendra-ranking",
-				},
-				"kinesis": {
-					id: "kinesis",
-				},
-				"kinesisanalytics": {
-					id: "kinesisanalytics",
-				},
-				"kinesisvideo": {
-					id: "kinesisvideo",
-				},
-				"kms": {
-					id: "kms",
-				},
-				"lakeformation": {
-					id: "lakeformation",
-				},
-				"lambda": {
-					id: "lambda",
-				},
-				"license-manager": {
-					id: "license-manager",
-				},
-				"license-manager-linux-subscriptions": {
-					id: "license-manager-linux-subscriptions",
-				},
-				"license-manager-user-subscriptions": {
-					id: "license-manager-user-subscriptions",
-				},
-				"logs": {
-					id: "logs",
-				},
-				"m2": {
-					id: "m2",
-				},
-				"managedblockchain": {
-					id: "managedblockchain",
-				},
-				"mediaconvert": {
-					id: "mediaconvert",
-				},
-				"meetings-chime": {
-					id: "meetings-chime",
-				},
-				"memory-db": {
-					id: "memory-db",
-				},
-				"metering.marketplace": {
-					id: "metering.marketplace",
-				},
-				"metrics.sagemaker": {
-					id: "metrics.sagemaker",
-				},
-				"mgn": {
-					id: "mgn",
-				},
-				"models-v2-lex": {
-					id: "models-v2-lex",
-				},
-				"models.lex": {
-					id: "models.lex",
-				},
-				"monitoring": {
-					id: "monitoring",
-				},
-				"mq": {
-					id: "mq",
-				},
-				"neptune": {
-					id: "neptune",
-				},
-				"network-firewall": {
-					id: "network-firewall",
-				},
-				"networkmanager": {
-					id: "networkmanager",
-				},
-				"notifications": {
-					id: "notifications",
-				},
-				"oam": {
-					id: "oam",
-				},
-				"oidc": {
-					id: "oidc",
-				},
-				"organizations": {
-					id: "organizations",
-				},
-				"outposts": {
-					id: "outposts",
-				},
-				"participant.connect": {
-					id: "participant.connect",
-				},
-				"pi": {
-					id: "pi",
-				},
-				"pinpoint": {
-					id: "pinpoint",
-				},
-				"polly": {
-					id: "polly",
-				},
-				"portal.sso": {
-					id: "portal.sso",
-				},
-				"qbusiness": {
-					id: "qbusiness",
-				},
-				"query.timestream": {
-					id: "query.timestream",
-				},
-				"quicksight": {
-					id: "quicksight",
-				},
-				"ram": {
-					id: "ram",
-				},
-				"rbin": {
-					id: "rbin",
-				},
-				"rds": {
-					id: "rds",
-				},
-				"redshift": {
-					id: "redshift",
-				},
-				"redshift-serverless": {
-					id: "redshift-serverless",
-				},
-				"rekognition": {
-					id: "rekognition",
-				},
-				"resiliencehub": {
-					id: "resiliencehub",
-				},
-				"resource-explorer-2": {
-					id: "resource-explorer-2",
-				},
-				"resource-groups": {
-					id: "resource-groups",
-				},
-				"rolesanywhere": {
-					id: "rolesanywhere",
-				},
-				"route53": {
-					id: "route53",
-				},
-				"route53profiles": {
-					id: "route53profiles",
-				},
-				"route53resolver": {
-					id: "route53resolver",
-				},
-				"rum": {
-					id: "rum",
-				},
-				"runtime-v2-lex": {
-					id: "runtime-v2-lex",
-				},
-				"runtime.lex": {
-					id: "runtime.lex",
-				},
-				"runtime.sagemaker": {
-					id: "runtime.sagemaker",
-				},
-				"s3": {
-					id: "s3",
-				},
-				"s3-control": {
-					id: "s3-control",
-				},
-				"s3-outposts": {
-					id: "s3-outposts",
-				},
-				"scheduler": {
-					id: "scheduler",
-				},
-				"schemas": {
-					id: "schemas",
-				},
-				"secretsmanager": {
-					id: "secretsmanager",
-				},
-				"securityhub": {
-					id: "securityhub",
-				},
-				"securitylake": {
-					id: "securitylake",
-				},
-				"serverlessrepo": {
-					id: "serverlessrepo",
-				},
-				"servicecatalog": {
-					id: "servicecatalog",
-				},
-				"servicecatalog-appregistry": {
-					id: "servicecatalog-appregistry",
-				},
-				"servicediscovery": {
-					id: "servicediscovery",
-				},
-				"servicequotas": {
-					id: "servicequotas",
-				},
-				"signer": {
-					id: "signer",
-				},
-				"simspaceweaver": {
-					id: "simspaceweaver",
-				},
-				"sms-voice": {
-					id: "sms-voice",
-				},
-				"snowball": {
-					id: "snowball",
-				},
-				"sns": {
-					id: "sns",
-				},
-				"sqs": {
-					id: "sqs",
-				},
-				"ssm": {
-					id: "ssm",
-				},
-				"sso": {
-					id: "sso",
-				},
-				"states": {
-					id: "states",
-				},
-				"storagegateway": {
-					id: "storagegateway",
-				},
-				"streams.dynamodb": {
-					id: "streams.dynamodb",
-				},
-				"sts": {
-					id: "sts",
-				},
-				"support": {
-					id: "support",
-				},
-				"swf": {
-					id: "swf",
-				},
-				"synthetics": {
-					id: "synthetics",
-				},
-				"tagging": {
-					id: "tagging",
-				},
-				"textract": {
-					id: "textract",
-				},
-				"transcribe": {
-					id: "transcribe",
-				},
-				"transcribestreaming": {
-					id: "transcribestreaming",
-				},
-				"transfer": {
-					id: "transfer",
-				},
-				"translate": {
-					id: "translate",
-				},
-				"verifiedpermissions": {
-					id: "verifiedpermissions",
-				},
-				"waf-regional": {
-					id: "waf-regional",
-				},
-				"wafv2": {
-					id: "wafv2",
-				},
-				"wellarchitected": {
-					id: "wellarchitected",
-				},
-				"workspaces": {
-					id: "workspaces",
-				},
-				"xray": {
-					id: "xray",
+			},
+			services: map[string]Service{
+				AccessAnalyzerServiceID: {
+					id: AccessAnalyzerServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						UsGovEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "access-analyzer.us-gov-east-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-east-1-fips": {
+							hostname: "access-analyzer.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "access-analyzer.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-west-1-fips": {
+							hostname: "access-analyzer.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				AcmServiceID: {
+					id: AcmServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "acm.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+				},
+				AcmPcaServiceID: {
+					id: AcmPcaServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "acm-pca.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "acm-pca.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "acm-pca.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				AossServiceID: {
+					id: AossServiceID,
+				},
+				ApiDetectiveServiceID: {
+					id: ApiDetectiveServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-gov-east-1-fips": {
+							hostname: "api.detective-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-gov-west-1-fips": {
+							hostname: "api.detective-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ApiEcrServiceID: {
+					id: ApiEcrServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "ecr-fips.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"dkr-us-gov-east-1": {
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "ecr-fips.us-gov-east-1.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"dkr-us-gov-west-1": {
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "ecr-fips.us-gov-west-1.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"fips-dkr-us-gov-east-1": {
+							hostname: "ecr-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-dkr-us-gov-west-1": {
+							hostname: "ecr-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-east-1": {
+							hostname: "ecr-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "ecr-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ApiSagemakerServiceID: {
+					id: ApiSagemakerServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "api-fips.sagemaker.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-gov-west-1-fips": {
+							hostname: "api-fips.sagemaker.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-gov-west-1-fips-secondary": {
+							hostname: "api.sagemaker.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-gov-west-1-secondary": {
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "api.sagemaker.us-gov-west-1.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ApiTunnelingIotServiceID: {
+					id: ApiTunnelingIotServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "api.tunneling.iot-fips.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "api.tunneling.iot-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "api.tunneling.iot-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ApigatewayServiceID: {
+					id: ApigatewayServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+				},
+				AppconfigServiceID: {
+					id: AppconfigServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "appconfig.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "appconfig.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "appconfig.us-gov-east-1.amazonaws.com",
+								},
+							},
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "appconfig.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+				AppconfigdataServiceID: {
+					id: AppconfigdataServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "appconfigdata.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "appconfigdata.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "appconfigdata.us-gov-east-1.amazonaws.com",
+								},
+							},
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "appconfigdata.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+				ApplicationAutoscalingServiceID: {
+					id: ApplicationAutoscalingServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						UsGovEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "application-autoscaling.us-gov-east-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-east-1-fips": {
+							hostname:   "application-autoscaling.us-gov-east-1.amazonaws.com",
+							deprecated: true,
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "application-autoscaling.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-west-1-fips": {
+							hostname:   "application-autoscaling.us-gov-west-1.amazonaws.com",
+							deprecated: true,
+						},
+					},
+				},
+				ApplicationinsightsServiceID: {
+					id: ApplicationinsightsServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+				},
+				Appstream2ServiceID: {
+					id: Appstream2ServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "appstream",
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips": {
+							hostname: "appstream2-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-gov-east-1-fips": {
+							hostname: "appstream2-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-gov-west-1-fips": {
+							hostname: "appstream2-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ApsServiceID: {
+					id: ApsServiceID,
+				},
+				ArcZonalShiftServiceID: {
+					id: ArcZonalShiftServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+				},
+				AthenaServiceID: {
+					id: AthenaServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "athena-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "athena-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				AutoscalingServiceID: {
+					id: AutoscalingServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "autoscaling.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+				},
+				AutoscalingPlansServiceID: {
+					id: AutoscalingPlansServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						UsGovEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "autoscaling-plans.us-gov-east-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-east-1-fips": {
+							hostname:   "autoscaling-plans.us-gov-east-1.amazonaws.com",
+							deprecated: true,
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "autoscaling-plans.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-west-1-fips": {
+							hostname:   "autoscaling-plans.us-gov-west-1.amazonaws.com",
+							deprecated: true,
+						},
+					},
+				},
+				BackupServiceID: {
+					id: BackupServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+				},
+				BackupGatewayServiceID: {
+					id: BackupGatewayServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+				},
+				BatchServiceID: {
+					id: BatchServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "batch.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "batch.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "batch.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				BedrockServiceID: {
+					id: BedrockServiceID,
+					regions: []string{
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"bedrock-fips-us-gov-west-1": {
+							hostname: "bedrock-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+						},
+						"bedrock-runtime-fips-us-gov-west-1": {
+							hostname: "bedrock-runtime-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+						},
+						"bedrock-runtime-us-gov-west-1": {
+							hostname: "bedrock-runtime.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+						},
+						"bedrock-us-gov-west-1": {
+							hostname: "bedrock.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+						},
+					},
+				},
+				CassandraServiceID: {
+					id: CassandraServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						UsGovEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "cassandra.us-gov-east-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-east-1-fips": {
+							hostname: "cassandra.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "cassandra.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-west-1-fips": {
+							hostname: "cassandra.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				CloudcontrolapiServiceID: {
+					id: CloudcontrolapiServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "cloudcontrolapi-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "cloudcontrolapi-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ClouddirectoryServiceID: {
+					id: ClouddirectoryServiceID,
+					regions: []string{
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "clouddirectory.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-west-1-fips": {
+							hostname: "clouddirectory.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				CloudformationServiceID: {
+					id: CloudformationServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						UsGovEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "cloudformation.us-gov-east-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-east-1-fips": {
+							hostname: "cloudformation.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "cloudformation.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-west-1-fips": {
+							hostname: "cloudformation.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				CloudhsmServiceID: {
+					id: CloudhsmServiceID,
+					regions: []string{
+						UsGovWest1RegionID,
+					},
+				},
+				Cloudhsmv2ServiceID: {
+					id: Cloudhsmv2ServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "cloudhsm",
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+				},
+				CloudtrailServiceID: {
+					id: CloudtrailServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "cloudtrail.us-gov-west-1.amazonaws.com",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "cloudtrail.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "cloudtrail.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "cloudtrail.us-gov-east-1.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+				CodebuildServiceID: {
+					id: CodebuildServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-gov-east-1-fips": {
+							hostname: "codebuild-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-gov-west-1-fips": {
+							hostname: "codebuild-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				CodecommitServiceID: {
+					id: CodecommitServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips": {
+							hostname: "codecommit-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-gov-east-1-fips": {
+							hostname: "codecommit-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-gov-west-1-fips": {
+							hostname: "codecommit-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				CodedeployServiceID: {
+					id: CodedeployServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-gov-east-1-fips": {
+							hostname: "codedeploy-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-gov-west-1-fips": {
+							hostname: "codedeploy-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				CodepipelineServiceID: {
+					id: CodepipelineServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "codepipeline-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "codepipeline-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				CodestarConnectionsServiceID: {
+					id: CodestarConnectionsServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+					},
+				},
+				CognitoIdentityServiceID: {
+					id: CognitoIdentityServiceID,
+					regions: []string{
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-west-1": {
+							hostname: "cognito-identity-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				CognitoIdpServiceID: {
+					id: CognitoIdpServiceID,
+					regions: []string{
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-west-1": {
+							hostname: "cognito-idp-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ComprehendServiceID: {
+					id: ComprehendServiceID,
+					regions: []string{
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-west-1": {
+							hostname: "comprehend-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ComprehendmedicalServiceID: {
+					id: ComprehendmedicalServiceID,
+					regions: []string{
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-west-1": {
+							hostname: "comprehendmedical-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ComputeOptimizerServiceID: {
+					id: ComputeOptimizerServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						UsGovEast1RegionID: {
+							hostname: "compute-optimizer-fips.us-gov-east-1.amazonaws.com",
+						},
+						UsGovWest1RegionID: {
+							hostname: "compute-optimizer-fips.us-gov-west-1.amazonaws.com",
+						},
+					},
+				},
+				ConfigServiceID: {
+					id: ConfigServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "config.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "config.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "config.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ConnectServiceID: {
+					id: ConnectServiceID,
+					regions: []string{
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-west-1": {
+							hostname: "connect.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "connect.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+				ControltowerServiceID: {
+					id: ControltowerServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-gov-east-1-fips": {
+							hostname: "controltower-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-gov-west-1-fips": {
+							hostname: "controltower-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				DataAtsIotServiceID: {
+					id: DataAtsIotServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "iotdata",
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "data.iot-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								service: "iotdata",
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "data.iot-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								service: "iotdata",
+							},
+							deprecated: true,
+						},
+						UsGovEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "data.iot-fips.us-gov-east-1.amazonaws.com",
+								},
+							},
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "data.iot-fips.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+				DataIotServiceID: {
+					id: DataIotServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "iotdata",
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "data.iot-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								service: "iotdata",
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "data.iot-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								service: "iotdata",
+							},
+							deprecated: true,
+						},
+					},
+				},
+				DataJobsIotServiceID: {
+					id: DataJobsIotServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "data.jobs.iot-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "data.jobs.iot-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				DatabrewServiceID: {
+					id: DatabrewServiceID,
+					regions: []string{
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-west-1": {
+							hostname: "databrew.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "databrew.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+				DatasyncServiceID: {
+					id: DatasyncServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "datasync-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "datasync-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				DatazoneServiceID: {
+					id: DatazoneServiceID,
+					defaults: endpoint{
+						dnsSuffix: "api.aws",
+						variants: []endpointVariant{
+							{
+								variant:   FIPSVariant,
+								hostname:  "{service}-fips.{region}.{dnsSuffix}",
+								dnsSuffix: "api.aws",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+				},
+				DirectconnectServiceID: {
+					id: DirectconnectServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "directconnect-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "directconnect-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				DlmServiceID: {
+					id: DlmServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						UsGovEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "dlm.us-gov-east-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-east-1-fips": {
+							hostname: "dlm.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "dlm.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-west-1-fips": {
+							hostname: "dlm.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				DmsServiceID: {
+					id: DmsServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "dms.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"dms": {
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "dms.us-gov-west-1.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"dms-fips": {
+							hostname: "dms.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-gov-east-1-fips": {
+							hostname: "dms.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-gov-west-1-fips": {
+							hostname: "dms.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				DocdbServiceID: {
+					id: DocdbServiceID,
+					regions: []string{
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						UsGovWest1RegionID: {
+							hostname: "rds.us-gov-west-1.amazonaws.com",
+						},
+					},
+				},
+				DrsServiceID: {
+					id: DrsServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "drs-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "drs-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				DsServiceID: {
+					id: DsServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "ds-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "ds-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				DynamodbServiceID: {
+					id: DynamodbServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "dynamodb.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-gov-east-1-fips": {
+							hostname: "dynamodb.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-gov-west-1-fips": {
+							hostname: "dynamodb.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				EbsServiceID: {
+					id: EbsServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+				},
+				Ec2ServiceID: {
+					id: Ec2ServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "ec2.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+				},
+				EcsServiceID: {
+					id: EcsServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "ecs-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "ecs-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				EksServiceID: {
+					id: EksServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "eks.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "eks.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "eks.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				EksAuthServiceID: {
+					id: EksAuthServiceID,
+					defaults: endpoint{
+						dnsSuffix: "api.aws",
+						variants: []endpointVariant{
+							{
+								variant:   FIPSVariant,
+								hostname:  "{service}-fips.{region}.{dnsSuffix}",
+								dnsSuffix: "api.aws",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+				},
+				ElasticacheServiceID: {
+					id: ElasticacheServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "elasticache.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips": {
+							hostname: "elasticache.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-gov-west-1-fips": {
+							hostname: "elasticache.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ElasticbeanstalkServiceID: {
+					id: ElasticbeanstalkServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						UsGovEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "elasticbeanstalk.us-gov-east-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-east-1-fips": {
+							hostname: "elasticbeanstalk.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "elasticbeanstalk.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-west-1-fips": {
+							hostname: "elasticbeanstalk.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ElasticfilesystemServiceID: {
+					id: ElasticfilesystemServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "elasticfilesystem-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "elasticfilesystem-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ElasticloadbalancingServiceID: {
+					id: ElasticloadbalancingServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "elasticloadbalancing.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "elasticloadbalancing.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "elasticloadbalancing.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ElasticmapreduceServiceID: {
+					id: ElasticmapreduceServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "elasticmapreduce.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "elasticmapreduce.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "elasticmapreduce.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				EmailServiceID: {
+					id: EmailServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "email-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "email-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				EmrContainersServiceID: {
+					id: EmrContainersServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "emr-containers.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "emr-containers.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "emr-containers.us-gov-east-1.amazonaws.com",
+								},
+							},
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "emr-containers.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+				EmrServerlessServiceID: {
+					id: EmrServerlessServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "emr-serverless.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "emr-serverless.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "emr-serverless.us-gov-east-1.amazonaws.com",
+								},
+							},
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "emr-serverless.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+				EsServiceID: {
+					id: EsServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips": {
+							hostname: "es-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.us-gov-east-1.api.aws",
+								},
+							},
+						},
+						"us-gov-east-1-fips": {
+							hostname: "es-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  DualStackVariant,
+									hostname: "aos.us-gov-west-1.api.aws",
+								},
+							},
+						},
+						"us-gov-west-1-fips": {
+							hostname: "es-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				EventsServiceID: {
+					id: EventsServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "events.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "events.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "events.us-gov-east-1.amazonaws.com",
+								},
+							},
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "events.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+				FirehoseServiceID: {
+					id: FirehoseServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "firehose-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "firehose-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				FmsServiceID: {
+					id: FmsServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "fms-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "fms-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				FsxServiceID: {
+					id: FsxServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-prod-us-gov-east-1": {
+							hostname: "fsx-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-prod-us-gov-west-1": {
+							hostname: "fsx-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-east-1": {
+							hostname: "fsx-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "fsx-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"prod-us-gov-east-1": {
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "fsx-fips.us-gov-east-1.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"prod-us-gov-west-1": {
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "fsx-fips.us-gov-west-1.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+					},
+				},
+				GameliftstreamsServiceID: {
+					id: GameliftstreamsServiceID,
+				},
+				GeoServiceID: {
+					id: GeoServiceID,
+					regions: []string{
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-west-1": {
+							hostname: "geo-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				GlacierServiceID: {
+					id: GlacierServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "glacier.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "glacier.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "glacier.us-gov-east-1.amazonaws.com",
+								},
+							},
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "glacier.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+				GlueServiceID: {
+					id: GlueServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "glue-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "glue-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				GrafanaServiceID: {
+					id: GrafanaServiceID,
+				},
+				GreengrassServiceID: {
+					id: GreengrassServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"dataplane-us-gov-east-1": {
+							hostname: "greengrass-ats.iot.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+						},
+						"dataplane-us-gov-west-1": {
+							hostname: "greengrass-ats.iot.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+						},
+						"fips-us-gov-east-1": {
+							hostname: "greengrass.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "greengrass.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "greengrass.us-gov-east-1.amazonaws.com",
+								},
+							},
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "greengrass.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+				GuarddutyServiceID: {
+					id: GuarddutyServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "guardduty.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-gov-east-1-fips": {
+							hostname: "guardduty.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-gov-west-1-fips": {
+							hostname: "guardduty.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				HealthServiceID: {
+					id: HealthServiceID,
+					endpoints: map[string]endpoint{
+						"aws-us-gov-global": {
+							hostname: "global.health.us-gov.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+						},
+						"fips-us-gov-west-1": {
+							hostname: "health-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovWest1RegionID: {
+							deprecated: true,
+						},
+					},
+				},
+				IamServiceID: {
+					id:                IamServiceID,
+					partitionEndpoint: "aws-us-gov-global",
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						"aws-us-gov-global": {
+							hostname: "iam.us-gov.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "iam.us-gov.amazonaws.com",
+								},
+							},
+						},
+						"aws-us-gov-global-fips": {
+							hostname: "iam.us-gov.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"iam-govcloud": {
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "iam.us-gov.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"iam-govcloud-fips": {
+							hostname: "iam.us-gov.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				IdentitystoreServiceID: {
+					id: IdentitystoreServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "identitystore.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "identitystore.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "identitystore.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				IngestTimestreamServiceID: {
+					id: IngestTimestreamServiceID,
+					regions: []string{
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "ingest.timestream.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-west-1-fips": {
+							hostname: "ingest.timestream.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				InspectorServiceID: {
+					id: InspectorServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "inspector-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "inspector-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				Inspector2ServiceID: {
+					id: Inspector2ServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "inspector2-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "inspector2-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				InternetmonitorServiceID: {
+					id: InternetmonitorServiceID,
+					defaults: endpoint{
+						dnsSuffix: "api.aws",
+						variants: []endpointVariant{
+							{
+								variant:   FIPSVariant,
+								hostname:  "{service}-fips.{region}.{dnsSuffix}",
+								dnsSuffix: "api.aws",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+				},
+				IotServiceID: {
+					id: IotServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname:   "iot-fips.us-gov-east-1.amazonaws.com",
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname:   "iot-fips.us-gov-west-1.amazonaws.com",
+							deprecated: true,
+						},
+					},
+				},
+				IoteventsServiceID: {
+					id: IoteventsServiceID,
+					regions: []string{
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-west-1": {
+							hostname: "iotevents-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				IoteventsdataServiceID: {
+					id: IoteventsdataServiceID,
+					regions: []string{
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-west-1": {
+							hostname: "data.iotevents-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovWest1RegionID: {
+							hostname: "data.iotevents.us-gov-west-1.amazonaws.com",
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "data.iotevents-fips.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+				IotsecuredtunnelingServiceID: {
+					id: IotsecuredtunnelingServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "api.tunneling.iot-fips.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "api.tunneling.iot-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "api.tunneling.iot-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				IotsitewiseServiceID: {
+					id: IotsitewiseServiceID,
+					regions: []string{
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-west-1": {
+							hostname: "iotsitewise-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				IottwinmakerServiceID: {
+					id: IottwinmakerServiceID,
+					regions: []string{
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"api-us-gov-west-1": {
+							hostname: "api.iottwinmaker.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+						},
+						"data-us-gov-west-1": {
+							hostname: "data.iottwinmaker.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+						},
+						"fips-api-us-gov-west-1": {
+							hostname: "api.iottwinmaker-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+						},
+						"fips-data-us-gov-west-1": {
+							hostname: "data.iottwinmaker-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+						},
+						"fips-us-gov-west-1": {
+							hostname: "iottwinmaker-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				KafkaServiceID: {
+					id: KafkaServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						UsGovEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "kafka.us-gov-east-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-east-1-fips": {
+							hostname: "kafka.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "kafka.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-west-1-fips": {
+							hostname: "kafka.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				KafkaconnectServiceID: {
+					id: KafkaconnectServiceID,
+				},
+				KendraServiceID: {
+					id: KendraServiceID,
+					regions: []string{
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-west-1": {
+							hostname: "kendra-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				KendraRankingServiceID: {
+					id: KendraRankingServiceID,
+					defaults: endpoint{
+						dnsSuffix: "api.aws",
+						variants: []endpointVariant{
+							{
+								variant:   FIPSVariant,
+								hostname:  "{service}-fips.{region}.{dnsSuffix}",
+								dnsSuffix: "api.aws",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+				},
+				KinesisServiceID: {
+					id: KinesisServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "kinesis.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "kinesis.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "kinesis.us-gov-east-1.amazonaws.com",
+								},
+							},
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "kinesis.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+				KinesisanalyticsServiceID: {
+					id: KinesisanalyticsServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+				},
+				KinesisvideoServiceID: {
+					id: KinesisvideoServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "kinesisvideo-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "kinesisvideo-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovEast1RegionID: {
+							hostname: "kinesisvideo-fips.us-gov-east-1.amazonaws.com",
+						},
+						UsGovWest1RegionID: {
+							hostname: "kinesisvideo-fips.us-gov-west-1.amazonaws.com",
+						},
+					},
+				},
+				KmsServiceID: {
+					id: KmsServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"ProdFips": {
+							hostname: "kms-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-gov-east-1-fips": {
+							hostname: "kms-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-gov-west-1-fips": {
+							hostname: "kms-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				LakeformationServiceID: {
+					id: LakeformationServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "lakeformation-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "lakeformation-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				LambdaServiceID: {
+					id: LambdaServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "lambda-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "lambda-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				LicenseManagerServiceID: {
+					id: LicenseManagerServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "license-manager-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "license-manager-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				LicenseManagerLinuxSubscriptionsServiceID: {
+					id: LicenseManagerLinuxSubscriptionsServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+				},
+				LicenseManagerUserSubscriptionsServiceID: {
+					id: LicenseManagerUserSubscriptionsServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+				},
+				LogsServiceID: {
+					id: LogsServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "logs.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "logs.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "logs.us-gov-east-1.amazonaws.com",
+								},
+							},
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "logs.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+				M2ServiceID: {
+					id: M2ServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							deprecated: true,
+						},
+					},
+				},
+				ManagedblockchainServiceID: {
+					id: ManagedblockchainServiceID,
+					regions: []string{
+						UsGovWest1RegionID,
+					},
+				},
+				MediaconvertServiceID: {
+					id: MediaconvertServiceID,
+					regions: []string{
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-west-1": {
+							hostname: "mediaconvert.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "mediaconvert.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+				MeetingsChimeServiceID: {
+					id: MeetingsChimeServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-gov-east-1-fips": {
+							hostname: "meetings-chime-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-gov-west-1-fips": {
+							hostname: "meetings-chime-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				MemoryDbServiceID: {
+					id: MemoryDbServiceID,
+				},
+				MeteringMarketplaceServiceID: {
+					id: MeteringMarketplaceServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "aws-marketplace",
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+				},
+				MetricsSagemakerServiceID: {
+					id: MetricsSagemakerServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+				},
+				MgnServiceID: {
+					id: MgnServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "mgn-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "mgn-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ModelsV2LexServiceID: {
+					id: ModelsV2LexServiceID,
+					regions: []string{
+						UsGovWest1RegionID,
+					},
+				},
+				ModelsLexServiceID: {
+					id: ModelsLexServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "lex",
+						},
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "models-fips.lex.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-gov-west-1-fips": {
+							hostname: "models-fips.lex.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				MonitoringServiceID: {
+					id: MonitoringServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "monitoring.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "monitoring.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "monitoring.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				MqServiceID: {
+					id: MqServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "mq-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "mq-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				NeptuneServiceID: {
+					id: NeptuneServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						UsGovEast1RegionID: {
+							hostname: "rds.us-gov-east-1.amazonaws.com",
+						},
+						UsGovWest1RegionID: {
+							hostname: "rds.us-gov-west-1.amazonaws.com",
+						},
+					},
+				},
+				NetworkFirewallServiceID: {
+					id: NetworkFirewallServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "network-firewall-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "network-firewall-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				NetworkmanagerServiceID: {
+					id:                NetworkmanagerServiceID,
+					partitionEndpoint: "aws-us-gov-global",
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						"aws-us-gov-global": {
+							hostname: "networkmanager.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "networkmanager.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+						"fips-aws-us-gov-global": {
+							hostname: "networkmanager.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				NotificationsServiceID: {
+					id: NotificationsServiceID,
+				},
+				OamServiceID: {
+					id: OamServiceID,
+				},
+				OidcServiceID: {
+					id: OidcServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+				},
+				OrganizationsServiceID: {
+					id:                OrganizationsServiceID,
+					partitionEndpoint: "aws-us-gov-global",
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						"aws-us-gov-global": {
+							hostname: "organizations.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "organizations.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+						"fips-aws-us-gov-global": {
+							hostname: "organizations.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				OutpostsServiceID: {
+					id: OutpostsServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "outposts.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "outposts.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "outposts.us-gov-east-1.amazonaws.com",
+								},
+							},
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "outposts.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+				ParticipantConnectServiceID: {
+					id: ParticipantConnectServiceID,
+					regions: []string{
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-west-1": {
+							hostname: "participant.connect.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "participant.connect.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+				PiServiceID: {
+					id: PiServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "pi-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "pi-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				PinpointServiceID: {
+					id: PinpointServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "mobiletargeting",
+						},
+					},
+					regions: []string{
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-west-1": {
+							hostname: "pinpoint-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				PollyServiceID: {
+					id: PollyServiceID,
+					regions: []string{
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-west-1": {
+							hostname: "polly-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				PortalSsoServiceID: {
+					id: PortalSsoServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+				},
+				QbusinessServiceID: {
+					id: QbusinessServiceID,
+					defaults: endpoint{
+						dnsSuffix: "api.aws",
+						variants: []endpointVariant{
+							{
+								variant:   FIPSVariant,
+								hostname:  "{service}-fips.{region}.{dnsSuffix}",
+								dnsSuffix: "api.aws",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+				},
+				QueryTimestreamServiceID: {
+					id: QueryTimestreamServiceID,
+				},
+				QuicksightServiceID: {
+					id: QuicksightServiceID,
+					regions: []string{
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"api": {},
+					},
+				},
+				RamServiceID: {
+					id: RamServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						UsGovEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "ram.us-gov-east-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-east-1-fips": {
+							hostname: "ram.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "ram.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-west-1-fips": {
+							hostname: "ram.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				RbinServiceID: {
+					id: RbinServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "rbin-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "rbin-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				RdsServiceID: {
+					id: RdsServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "rds.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"rds.us-gov-east-1": {
+							hostname: "rds.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"rds.us-gov-west-1": {
+							hostname: "rds.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-gov-east-1-fips": {
+							hostname: "rds.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-gov-west-1-fips": {
+							hostname: "rds.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				RedshiftServiceID: {
+					id: RedshiftServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+				},
+				RedshiftServerlessServiceID: {
+					id: RedshiftServerlessServiceID,
+				},
+				RekognitionServiceID: {
+					id: RekognitionServiceID,
+					regions: []string{
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"rekognition-fips.us-gov-west-1": {
+							hostname: "rekognition-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"rekognition.us-gov-west-1": {
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "rekognition-fips.us-gov-west-1.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"us-gov-west-1-fips": {
+							hostname: "rekognition-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ResiliencehubServiceID: {
+					id: ResiliencehubServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "resiliencehub-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "resiliencehub-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ResourceExplorer2ServiceID: {
+					id: ResourceExplorer2ServiceID,
+				},
+				ResourceGroupsServiceID: {
+					id: ResourceGroupsServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "resource-groups.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "resource-groups.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "resource-groups.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				RolesanywhereServiceID: {
+					id: RolesanywhereServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "rolesanywhere-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "rolesanywhere-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				Route53ServiceID: {
+					id:                Route53ServiceID,
+					partitionEndpoint: "aws-us-gov-global",
+					isGlobal:          true,
+					endpoints: map[string]endpoint{
+						"aws-us-gov-global": {
+							hostname: "route53.us-gov.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "route53.us-gov.amazonaws.com",
+								},
+							},
+						},
+						"fips-aws-us-gov-global": {
+							hostname: "route53.us-gov.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				Route53profilesServiceID: {
+					id: Route53profilesServiceID,
+				},
+				Route53resolverServiceID: {
+					id: Route53resolverServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						UsGovEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "route53resolver.us-gov-east-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-east-1-fips": {
+							hostname:   "route53resolver.us-gov-east-1.amazonaws.com",
+							deprecated: true,
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "route53resolver.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-west-1-fips": {
+							hostname:   "route53resolver.us-gov-west-1.amazonaws.com",
+							deprecated: true,
+						},
+					},
+				},
+				RumServiceID: {
+					id: RumServiceID,
+				},
+				RuntimeV2LexServiceID: {
+					id: RuntimeV2LexServiceID,
+					regions: []string{
+						UsGovWest1RegionID,
+					},
+				},
+				RuntimeLexServiceID: {
+					id: RuntimeLexServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "lex",
+						},
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "runtime-fips.lex.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-gov-west-1-fips": {
+							hostname: "runtime-fips.lex.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				RuntimeSagemakerServiceID: {
+					id: RuntimeSagemakerServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "runtime.sagemaker.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-gov-west-1-fips": {
+							hostname: "runtime.sagemaker.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				S3ServiceID: {
+					id: S3ServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:   DualStackVariant,
+								hostname:  "{service}.dualstack.{region}.{dnsSuffix}",
+								dnsSuffix: "amazonaws.com",
+							},
+							{
+								variant:   DualStackVariant | FIPSVariant,
+								hostname:  "{service}-fips.dualstack.{region}.{dnsSuffix}",
+								dnsSuffix: "amazonaws.com",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "s3-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "s3-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				S3ControlServiceID: {
+					id: S3ControlServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:   DualStackVariant,
+								hostname:  "{service}.dualstack.{region}.{dnsSuffix}",
+								dnsSuffix: "amazonaws.com",
+							},
+							{
+								variant:   DualStackVariant | FIPSVariant,
+								hostname:  "{service}-fips.dualstack.{region}.{dnsSuffix}",
+								dnsSuffix: "amazonaws.com",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-gov-east-1-fips": {
+							hostname: "s3-control-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-gov-west-1-fips": {
+							hostname: "s3-control-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				S3OutpostsServiceID: {
+					id: S3OutpostsServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							deprecated: true,
+						},
+					},
+				},
+				SchedulerServiceID: {
+					id: SchedulerServiceID,
+				},
+				SchemasServiceID: {
+					id: SchemasServiceID,
+				},
+				SecretsmanagerServiceID: {
+					id: SecretsmanagerServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-gov-east-1-fips": {
+							deprecated: true,
+						},
+						"us-gov-west-1-fips": {
+							deprecated: true,
+						},
+					},
+				},
+				SecurityhubServiceID: {
+					id: SecurityhubServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "securityhub-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "securityhub-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				SecuritylakeServiceID: {
+					id: SecuritylakeServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						UsGovEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "securitylake.us-gov-east-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-east-1-fips": {
+							hostname: "securitylake.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "securitylake.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-west-1-fips": {
+							hostname: "securitylake.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ServerlessrepoServiceID: {
+					id: ServerlessrepoServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						UsGovEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "serverlessrepo.us-gov-east-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-east-1-fips": {
+							hostname: "serverlessrepo.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "serverlessrepo.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-west-1-fips": {
+							hostname: "serverlessrepo.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ServicecatalogServiceID: {
+					id: ServicecatalogServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-gov-east-1-fips": {
+							hostname: "servicecatalog-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-gov-west-1-fips": {
+							hostname: "servicecatalog-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ServicecatalogAppregistryServiceID: {
+					id: ServicecatalogAppregistryServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "servicecatalog-appregistry.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+				},
+				ServicediscoveryServiceID: {
+					id: ServicediscoveryServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"servicediscovery": {
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "servicediscovery-fips.us-gov-west-1.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+						"servicediscovery-fips": {
+							hostname: "servicediscovery-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-gov-east-1-fips": {
+							hostname: "servicediscovery-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-gov-west-1-fips": {
+							hostname: "servicediscovery-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				ServicequotasServiceID: {
+					id: ServicequotasServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "servicequotas.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "servicequotas.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "servicequotas.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				SignerServiceID: {
+					id: SignerServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "signer-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "signer-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-verification-us-gov-east-1": {
+							hostname: "verification.signer-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+						},
+						"fips-verification-us-gov-west-1": {
+							hostname: "verification.signer-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+						},
+						"verification-us-gov-east-1": {
+							hostname: "verification.signer.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+						},
+						"verification-us-gov-west-1": {
+							hostname: "verification.signer.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+						},
+					},
+				},
+				SimspaceweaverServiceID: {
+					id: SimspaceweaverServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "simspaceweaver.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "simspaceweaver.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "simspaceweaver.us-gov-east-1.amazonaws.com",
+								},
+							},
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "simspaceweaver.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+				SmsVoiceServiceID: {
+					id: SmsVoiceServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "sms-voice-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "sms-voice-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				SnowballServiceID: {
+					id: SnowballServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "snowball-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "snowball-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				SnsServiceID: {
+					id: SnsServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "sns.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "sns.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "sns.us-gov-east-1.amazonaws.com",
+								},
+							},
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "sns.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+				SqsServiceID: {
+					id: SqsServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "sqs.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+				},
+				SsmServiceID: {
+					id: SsmServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "ssm.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "ssm.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "ssm.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				SsoServiceID: {
+					id: SsoServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						UsGovEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "sso.us-gov-east-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-east-1-fips": {
+							hostname: "sso.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "sso.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-west-1-fips": {
+							hostname: "sso.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				StatesServiceID: {
+					id: StatesServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "states-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "states.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "states.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+				StoragegatewayServiceID: {
+					id: StoragegatewayServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips": {
+							hostname: "storagegateway-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-gov-east-1-fips": {
+							hostname: "storagegateway-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-gov-west-1-fips": {
+							hostname: "storagegateway-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				StreamsDynamodbServiceID: {
+					id: StreamsDynamodbServiceID,
+					defaults: endpoint{
+						credentialScope: credentialScope{
+							service: "dynamodb",
+						},
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "streams.dynamodb.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-gov-east-1-fips": {
+							hostname: "streams.dynamodb.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-gov-west-1-fips": {
+							hostname: "streams.dynamodb.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				StsServiceID: {
+					id: StsServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "sts.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-gov-east-1-fips": {
+							hostname: "sts.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"us-gov-west-1-fips": {
+							hostname: "sts.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				SupportServiceID: {
+					id:                SupportServiceID,
+					partitionEndpoint: "aws-us-gov-global",
+					endpoints: map[string]endpoint{
+						"aws-us-gov-global": {
+							hostname: "support.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+						},
+						"fips-us-gov-west-1": {
+							hostname: "support.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "support.us-gov-west-1.amazonaws.com",
+								},
+							},
+							deprecated: true,
+						},
+					},
+				},
+				SwfServiceID: {
+					id: SwfServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						UsGovEast1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "swf.us-gov-east-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-east-1-fips": {
+							hostname: "swf.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						UsGovWest1RegionID: {
+							variants: []endpointVariant{
+								{
+									variant:  FIPSVariant,
+									hostname: "swf.us-gov-west-1.amazonaws.com",
+								},
+							},
+						},
+						"us-gov-west-1-fips": {
+							hostname: "swf.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				SyntheticsServiceID: {
+					id: SyntheticsServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "synthetics-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "synthetics-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				TaggingServiceID: {
+					id: TaggingServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+				},
+				TextractServiceID: {
+					id: TextractServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "textract-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "textract-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				TranscribeServiceID: {
+					id: TranscribeServiceID,
+					defaults: endpoint{
+						variants: []endpointVariant{
+							{
+								variant:  FIPSVariant,
+								hostname: "fips.transcribe.{region}.{dnsSuffix}",
+							},
+						},
+					},
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "fips.transcribe.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "fips.transcribe.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				TranscribestreamingServiceID: {
+					id: TranscribestreamingServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+				},
+				TransferServiceID: {
+					id: TransferServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "transfer-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "transfer-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				TranslateServiceID: {
+					id: TranslateServiceID,
+					regions: []string{
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"us-gov-west-1-fips": {
+							hostname: "translate-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				VerifiedpermissionsServiceID: {
+					id: VerifiedpermissionsServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "verifiedpermissions-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "verifiedpermissions-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				WafRegionalServiceID: {
+					id: WafRegionalServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "waf-regional-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "waf-regional-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				Wafv2ServiceID: {
+					id: Wafv2ServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "wafv2-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "wafv2-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				WellarchitectedServiceID: {
+					id: WellarchitectedServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+				},
+				WorkspacesServiceID: {
+					id: WorkspacesServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "workspaces-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "workspaces-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
+				},
+				XrayServiceID: {
+					id: XrayServiceID,
+					regions: []string{
+						UsGovEast1RegionID,
+						UsGovWest1RegionID,
+					},
+					endpoints: map[string]endpoint{
+						"fips-us-gov-east-1": {
+							hostname: "xray-fips.us-gov-east-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovEast1RegionID,
+							},
+							deprecated: true,
+						},
+						"fips-us-gov-west-1": {
+							hostname: "xray-fips.us-gov-west-1.amazonaws.com",
+							credentialScope: credentialScope{
+								region: UsGovWest1RegionID,
+							},
+							deprecated: true,
+						},
+					},
 				},
 			},
 		},
 	}
+
+	regionPartitionIDs = map[string]string{
+		AfSouth1RegionID:     AwsPartitionID,
+		ApEast1RegionID:      AwsPartitionID,
+		ApEast2RegionID:      AwsPartitionID,
+		ApNortheast1RegionID: AwsPartitionID,
+		ApNortheast2RegionID: AwsPartitionID,
+		ApNortheast3RegionID: AwsPartitionID,
+		ApSouth1RegionID:     AwsPartitionID,
+		ApSouth2RegionID:     AwsPartitionID,
+		ApSoutheast1RegionID: AwsPartitionID,
+		ApSoutheast2RegionID: AwsPartitionID,
+		ApSoutheast3RegionID: AwsPartitionID,
+		ApSoutheast4RegionID: AwsPartitionID,
+		ApSoutheast5RegionID: AwsPartitionID,
+		ApSoutheast6RegionID: AwsPartitionID,
+		ApSoutheast7RegionID: AwsPartitionID,
+		CaCentral1RegionID:   AwsPartitionID,
+		CaWest1RegionID:      AwsPartitionID,
+		EuCentral1RegionID:   AwsPartitionID,
+		EuCentral2RegionID:   AwsPartitionID,
+		EuNorth1RegionID:     AwsPartitionID,
+		EuSouth1RegionID:     AwsPartitionID,
+		EuSouth2RegionID:     AwsPartitionID,
+		EuWest1RegionID:      AwsPartitionID,
+		EuWest2RegionID:      AwsPartitionID,
+		EuWest3RegionID:      AwsPartitionID,
+		IlCentral1RegionID:   AwsPartitionID,
+		MeCentral1RegionID:   AwsPartitionID,
+		MeSouth1RegionID:     AwsPartitionID,
+		MxCentral1RegionID:   AwsPartitionID,
+		SaEast1RegionID:      AwsPartitionID,
+		UsEast1RegionID:      AwsPartitionID,
+		UsEast2RegionID:      AwsPartitionID,
+		UsWest1RegionID:      AwsPartitionID,
+		UsWest2RegionID:      AwsPartitionID,
+		CnNorth1RegionID:     AwsCnPartitionID,
+		CnNorthwest1RegionID: AwsCnPartitionID,
+		EuscDeEast1RegionID:  AwsEuscPartitionID,
+		UsIsoEast1RegionID:   AwsIsoPartitionID,
+		UsIsoWest1RegionID:   AwsIsoPartitionID,
+		UsIsobEast1RegionID:  AwsIsoBPartitionID,
+		UsIsobWest1RegionID:  AwsIsoBPartitionID,
+		EuIsoeWest1RegionID:  AwsIsoEPartitionID,
+		UsIsofEast1RegionID:  AwsIsoFPartitionID,
+		UsIsofSouth1RegionID: AwsIsoFPartitionID,
+		UsGovEast1RegionID:   AwsUsGovPartitionID,
+		UsGovWest1RegionID:   AwsUsGovPartitionID,
+	}
 )
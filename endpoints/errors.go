@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints
+
+import (
+	"fmt"
+)
+
+// ServiceNotFoundError is returned when a service is not known in a partition.
+type ServiceNotFoundError struct {
+	PartitionID string
+	ServiceID   string
+}
+
+func (e *ServiceNotFoundError) Error() string {
+	return fmt.Sprintf("service %q not found in partition %q", e.ServiceID, e.PartitionID)
+}
+
+// RegionNotFoundError is returned when a Region is not part of a partition.
+type RegionNotFoundError struct {
+	PartitionID string
+	RegionID    string
+}
+
+func (e *RegionNotFoundError) Error() string {
+	return fmt.Sprintf("region %q not found in partition %q", e.RegionID, e.PartitionID)
+}
+
+// VariantNotSupportedError is returned when an endpoint variant is not supported for a service in a partition.
+type VariantNotSupportedError struct {
+	PartitionID string
+	ServiceID   string
+	RegionID    string
+	Variant     EndpointVariant
+}
+
+func (e *VariantNotSupportedError) Error() string {
+	return fmt.Sprintf("endpoint variant %q not supported for service %q in region %q (partition %q)", e.Variant, e.ServiceID, e.RegionID, e.PartitionID)
+}
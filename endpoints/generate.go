@@ -1,6 +1,6 @@
 // Copyright IBM Corp. 2015, 2025
 // SPDX-License-Identifier: MPL-2.0
 
-//go:generate go run ../internal/generate/endpoints/main.go -- https://raw.githubusercontent.com/aws/aws-sdk-go-v2/main/codegen/smithy-aws-go-codegen/src/main/resources/software/amazon/smithy/aws/go/codegen/endpoints.json
+//go:generate go run -tags generate ../internal/generate/endpoints -- https://raw.githubusercontent.com/aws/aws-sdk-go-v2/main/codegen/smithy-aws-go-codegen/src/main/resources/software/amazon/smithy/aws/go/codegen/endpoints.json https://raw.githubusercontent.com/aws/aws-sdk-go-v2/main/internal/endpoints/awsrulesfn/partitions.json https://raw.githubusercontent.com/aws/aws-sdk-go-v2/main/codegen/smithy-aws-go-codegen/src/main/resources/software/amazon/smithy/aws/go/codegen/endpoint-prefix.json
 
 package endpoints
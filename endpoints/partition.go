@@ -4,6 +4,7 @@
 package endpoints
 
 import (
+	"cmp"
 	"maps"
 	"regexp"
 )
@@ -11,12 +12,19 @@ import (
 // Partition represents an AWS partition.
 // See https://docs.aws.amazon.com/whitepapers/latest/aws-fault-isolation-boundaries/partitions.html.
 type Partition struct {
-	id          string
-	name        string
-	dnsSuffix   string
-	regionRegex *regexp.Regexp
-	regions     map[string]Region
-	services    map[string]Service
+	id                     string
+	name                   string
+	dnsSuffix              string
+	dualStackDNSSuffix     string
+	fipsDNSSuffix          string
+	fipsDualStackDNSSuffix string
+	implicitGlobalRegion   string
+	supportsFIPS           bool
+	supportsDualStack      bool
+	regionRegex            *regexp.Regexp
+	defaults               endpoint
+	regions                map[string]Region
+	services               map[string]Service
 }
 
 // ID returns the identifier of the partition.
@@ -34,6 +42,39 @@ func (p Partition) DNSSuffix() string {
 	return p.dnsSuffix
 }
 
+// DualStackDNSSuffix returns the base domain name of the partition's dual-stack endpoints.
+// Returns an empty string if the partition does not support dual-stack endpoints.
+func (p Partition) DualStackDNSSuffix() string {
+	return p.dualStackDNSSuffix
+}
+
+// FIPSDNSSuffix returns the base domain name of the partition's FIPS endpoints.
+// Returns an empty string if the partition does not support FIPS endpoints.
+func (p Partition) FIPSDNSSuffix() string {
+	return p.fipsDNSSuffix
+}
+
+// FIPSDualStackDNSSuffix returns the base domain name of the partition's FIPS dual-stack endpoints.
+// Returns an empty string if the partition does not support FIPS dual-stack endpoints.
+func (p Partition) FIPSDualStackDNSSuffix() string {
+	return p.fipsDualStackDNSSuffix
+}
+
+// ImplicitGlobalRegion returns the Region used to sign requests to global endpoints in the partition.
+func (p Partition) ImplicitGlobalRegion() string {
+	return p.implicitGlobalRegion
+}
+
+// SupportsFIPS returns whether the partition supports FIPS endpoints.
+func (p Partition) SupportsFIPS() bool {
+	return p.supportsFIPS
+}
+
+// SupportsDualStack returns whether the partition supports dual-stack endpoints.
+func (p Partition) SupportsDualStack() bool {
+	return p.supportsDualStack
+}
+
 // RegionRegex return the regular expression that matches Region IDs for the partition.
 func (p Partition) RegionRegex() *regexp.Regexp {
 	return p.regionRegex
@@ -49,6 +90,90 @@ func (p Partition) Services() map[string]Service {
 	return maps.Clone(p.services)
 }
 
+// Service returns the service endpoint with the specified ID.
+func (p Partition) Service(serviceID string) (Service, bool) {
+	s, ok := p.services[serviceID]
+	return s, ok
+}
+
+// IsServiceAvailable returns whether the service is available in the Region.
+// Global services are available in all of the partition's Regions.
+func (p Partition) IsServiceAvailable(serviceID, regionID string) bool {
+	s, ok := p.services[serviceID]
+	if !ok {
+		return false
+	}
+
+	if e, ok := s.endpoints[regionID]; ok {
+		return !e.deprecated
+	}
+
+	return p.includesRegion(regionID) && s.availableIn(regionID)
+}
+
+// ResolveEndpoint returns the endpoint for the service in the Region using the specified variant.
+//
+// Regions which are part of the partition but are not modeled for the service resolve to the
+// partition's default endpoint pattern. For global services, such as IAM, all Regions resolve
+// to the service's partition endpoint.
+func (p Partition) ResolveEndpoint(serviceID, regionID string, variant EndpointVariant) (ResolvedEndpoint, error) {
+	s, ok := p.services[serviceID]
+	if !ok {
+		return ResolvedEndpoint{}, &ServiceNotFoundError{
+			PartitionID: p.id,
+			ServiceID:   serviceID,
+		}
+	}
+
+	key := regionID
+	e, ok := s.endpoint(regionID)
+	if !ok {
+		if s.isGlobal && s.partitionEndpoint != "" {
+			key = s.partitionEndpoint
+			e, _ = s.endpoint(key)
+		} else if !p.includesRegion(regionID) {
+			return ResolvedEndpoint{}, &RegionNotFoundError{
+				PartitionID: p.id,
+				RegionID:    regionID,
+			}
+		}
+	}
+
+	hostname := cmp.Or(e.hostname, s.defaults.hostname, p.defaults.hostname)
+	dnsSuffix := cmp.Or(e.dnsSuffix, s.defaults.dnsSuffix, p.dnsSuffix)
+	if variant != 0 {
+		ev, eok := e.variant(variant)
+		sv, sok := s.defaults.variant(variant)
+		pv, pok := p.defaults.variant(variant)
+		if !eok && !sok && !pok {
+			return ResolvedEndpoint{}, &VariantNotSupportedError{
+				PartitionID: p.id,
+				ServiceID:   serviceID,
+				RegionID:    regionID,
+				Variant:     variant,
+			}
+		}
+		hostname = cmp.Or(ev.hostname, sv.hostname, pv.hostname)
+		dnsSuffix = cmp.Or(ev.dnsSuffix, sv.dnsSuffix, pv.dnsSuffix, dnsSuffix)
+	}
+
+	hostname = expandHostname(hostname, serviceID, key, dnsSuffix)
+
+	return ResolvedEndpoint{
+		URL:           "https://" + hostname,
+		Hostname:      hostname,
+		SigningRegion: cmp.Or(e.credentialScope.region, s.defaults.credentialScope.region, key),
+		SigningName:   cmp.Or(e.credentialScope.service, s.defaults.credentialScope.service, serviceID),
+	}, nil
+}
+
+func (p Partition) includesRegion(regionID string) bool {
+	if _, ok := p.regions[regionID]; ok {
+		return true
+	}
+	return p.regionRegex != nil && p.regionRegex.MatchString(regionID)
+}
+
 // DefaultPartitions returns a list of the partitions.
 func DefaultPartitions() []Partition {
 	ps := make([]Partition, 0, len(partitions))
@@ -61,9 +186,16 @@ func DefaultPartitions() []Partition {
 }
 
 // PartitionForRegion returns the first partition which includes the specific Region.
+// Partitions which list the Region take precedence over partitions whose Region regular expression matches it.
 func PartitionForRegion(ps []Partition, regionID string) (Partition, bool) {
 	for _, p := range ps {
-		if _, ok := p.regions[regionID]; ok || p.regionRegex.MatchString(regionID) {
+		if _, ok := p.regions[regionID]; ok {
+			return p, true
+		}
+	}
+
+	for _, p := range ps {
+		if p.includesRegion(regionID) {
 			return p, true
 		}
 	}
@@ -4,8 +4,11 @@
 package endpoints_test
 
 import (
+	"slices"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 )
 
@@ -108,3 +111,334 @@ func TestPartitionServices(t *testing.T) {
 		}
 	}
 }
+
+func TestPartitionResolveEndpoint(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		partitionID      string
+		serviceID        string
+		regionID         string
+		variant          endpoints.EndpointVariant
+		expectedEndpoint endpoints.ResolvedEndpoint
+		expectedErr      error
+	}{
+		"regional service": {
+			partitionID: endpoints.AwsPartitionID,
+			serviceID:   "sts",
+			regionID:    endpoints.UsWest2RegionID,
+			expectedEndpoint: endpoints.ResolvedEndpoint{
+				URL:           "https://sts.us-west-2.amazonaws.com",
+				Hostname:      "sts.us-west-2.amazonaws.com",
+				SigningRegion: endpoints.UsWest2RegionID,
+				SigningName:   "sts",
+			},
+		},
+		"regional service FIPS": {
+			partitionID: endpoints.AwsPartitionID,
+			serviceID:   "sts",
+			regionID:    endpoints.UsWest2RegionID,
+			variant:     endpoints.FIPSVariant,
+			expectedEndpoint: endpoints.ResolvedEndpoint{
+				URL:           "https://sts-fips.us-west-2.amazonaws.com",
+				Hostname:      "sts-fips.us-west-2.amazonaws.com",
+				SigningRegion: endpoints.UsWest2RegionID,
+				SigningName:   "sts",
+			},
+		},
+		"regional service dual-stack": {
+			partitionID: endpoints.AwsPartitionID,
+			serviceID:   "ec2",
+			regionID:    endpoints.EuWest1RegionID,
+			variant:     endpoints.DualStackVariant,
+			expectedEndpoint: endpoints.ResolvedEndpoint{
+				URL:           "https://ec2.eu-west-1.api.aws",
+				Hostname:      "ec2.eu-west-1.api.aws",
+				SigningRegion: endpoints.EuWest1RegionID,
+				SigningName:   "ec2",
+			},
+		},
+		"unmodeled Region in partition": {
+			partitionID: endpoints.AwsPartitionID,
+			serviceID:   "sts",
+			regionID:    "us-east-17",
+			expectedEndpoint: endpoints.ResolvedEndpoint{
+				URL:           "https://sts.us-east-17.amazonaws.com",
+				Hostname:      "sts.us-east-17.amazonaws.com",
+				SigningRegion: "us-east-17",
+				SigningName:   "sts",
+			},
+		},
+		"China partition": {
+			partitionID: endpoints.AwsCnPartitionID,
+			serviceID:   "sts",
+			regionID:    endpoints.CnNorth1RegionID,
+			expectedEndpoint: endpoints.ResolvedEndpoint{
+				URL:           "https://sts.cn-north-1.amazonaws.com.cn",
+				Hostname:      "sts.cn-north-1.amazonaws.com.cn",
+				SigningRegion: endpoints.CnNorth1RegionID,
+				SigningName:   "sts",
+			},
+		},
+		"global service": {
+			partitionID: endpoints.AwsPartitionID,
+			serviceID:   "iam",
+			regionID:    endpoints.UsWest2RegionID,
+			expectedEndpoint: endpoints.ResolvedEndpoint{
+				URL:           "https://iam.amazonaws.com",
+				Hostname:      "iam.amazonaws.com",
+				SigningRegion: endpoints.UsEast1RegionID,
+				SigningName:   "iam",
+			},
+		},
+		"global service FIPS": {
+			partitionID: endpoints.AwsPartitionID,
+			serviceID:   "iam",
+			regionID:    endpoints.UsWest2RegionID,
+			variant:     endpoints.FIPSVariant,
+			expectedEndpoint: endpoints.ResolvedEndpoint{
+				URL:           "https://iam-fips.amazonaws.com",
+				Hostname:      "iam-fips.amazonaws.com",
+				SigningRegion: endpoints.UsEast1RegionID,
+				SigningName:   "iam",
+			},
+		},
+		"service not found": {
+			partitionID: endpoints.AwsPartitionID,
+			serviceID:   "not-a-service",
+			regionID:    endpoints.UsWest2RegionID,
+			expectedErr: &endpoints.ServiceNotFoundError{
+				PartitionID: endpoints.AwsPartitionID,
+				ServiceID:   "not-a-service",
+			},
+		},
+		"Region not found": {
+			partitionID: endpoints.AwsPartitionID,
+			serviceID:   "sts",
+			regionID:    endpoints.CnNorth1RegionID,
+			expectedErr: &endpoints.RegionNotFoundError{
+				PartitionID: endpoints.AwsPartitionID,
+				RegionID:    endpoints.CnNorth1RegionID,
+			},
+		},
+	}
+
+	partitions := make(map[string]endpoints.Partition)
+	for _, p := range endpoints.DefaultPartitions() {
+		partitions[p.ID()] = p
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			p, ok := partitions[testcase.partitionID]
+			if !ok {
+				t.Fatalf("partition %q not found", testcase.partitionID)
+			}
+
+			got, err := p.ResolveEndpoint(testcase.serviceID, testcase.regionID, testcase.variant)
+
+			if testcase.expectedErr != nil {
+				if err == nil {
+					t.Fatalf("expected error %q, got none", testcase.expectedErr)
+				}
+				if diff := cmp.Diff(err, testcase.expectedErr); diff != "" {
+					t.Errorf("unexpected error (+wanted, -got): %s", diff)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testcase.expectedEndpoint); diff != "" {
+				t.Errorf("unexpected endpoint (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestEndpointVariantString(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[endpoints.EndpointVariant]string{
+		0:                          "default",
+		endpoints.FIPSVariant:      "fips",
+		endpoints.DualStackVariant: "dualstack",
+		endpoints.FIPSVariant | endpoints.DualStackVariant: "fips|dualstack",
+	}
+
+	for variant, expected := range testcases {
+		if got := variant.String(); got != expected {
+			t.Errorf("expected %q, got %q", expected, got)
+		}
+	}
+}
+
+func TestPartitionIsServiceAvailable(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		serviceID string
+		regionID  string
+		expected  bool
+	}{
+		"regional service": {
+			serviceID: "datapipeline",
+			regionID:  endpoints.UsWest2RegionID,
+			expected:  true,
+		},
+		"regional service not in Region": {
+			serviceID: "datapipeline",
+			regionID:  endpoints.UsWest1RegionID,
+			expected:  false,
+		},
+		"global service": {
+			serviceID: "iam",
+			regionID:  endpoints.EuWest1RegionID,
+			expected:  true,
+		},
+		"global service partition endpoint": {
+			serviceID: "iam",
+			regionID:  endpoints.AwsGlobalRegionID,
+			expected:  true,
+		},
+		"deprecated endpoint": {
+			serviceID: "sts",
+			regionID:  "fips-us-east-1",
+			expected:  false,
+		},
+		"Region in other partition": {
+			serviceID: "sts",
+			regionID:  endpoints.CnNorth1RegionID,
+			expected:  false,
+		},
+		"service not found": {
+			serviceID: "not-a-service",
+			regionID:  endpoints.UsWest2RegionID,
+			expected:  false,
+		},
+	}
+
+	p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), endpoints.UsEast1RegionID)
+	if !ok {
+		t.Fatalf("partition for %q not found", endpoints.UsEast1RegionID)
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := p.IsServiceAvailable(testcase.serviceID, testcase.regionID), testcase.expected; got != want {
+				t.Errorf("expected %t, got %t", want, got)
+			}
+		})
+	}
+}
+
+func TestServiceRegions(t *testing.T) {
+	t.Parallel()
+
+	p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), endpoints.UsEast1RegionID)
+	if !ok {
+		t.Fatalf("partition for %q not found", endpoints.UsEast1RegionID)
+	}
+
+	iam, ok := p.Service("iam")
+	if !ok {
+		t.Fatal("service \"iam\" not found")
+	}
+	if !iam.IsGlobal() {
+		t.Error("expected service \"iam\" to be global")
+	}
+	if got, want := iam.PartitionEndpoint(), endpoints.AwsGlobalRegionID; got != want {
+		t.Errorf("expected partition endpoint %q, got %q", want, got)
+	}
+	if got := iam.Regions(); len(got) != 0 {
+		t.Errorf("expected no Regions for service \"iam\", got %v", got)
+	}
+
+	sts, ok := p.Service("sts")
+	if !ok {
+		t.Fatal("service \"sts\" not found")
+	}
+	if sts.IsGlobal() {
+		t.Error("expected service \"sts\" not to be global")
+	}
+	if got := sts.Regions(); !slices.Contains(got, endpoints.UsWest2RegionID) || slices.Contains(got, endpoints.AwsGlobalRegionID) {
+		t.Errorf("unexpected Regions for service \"sts\": %v", got)
+	}
+}
+
+func TestPartitionMetadata(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		expectedDNSSuffix              string
+		expectedDualStackDNSSuffix     string
+		expectedFIPSDNSSuffix          string
+		expectedFIPSDualStackDNSSuffix string
+		expectedImplicitGlobalRegion   string
+	}{
+		endpoints.AwsPartitionID: {
+			expectedDNSSuffix:              "amazonaws.com",
+			expectedDualStackDNSSuffix:     "api.aws",
+			expectedFIPSDNSSuffix:          "amazonaws.com",
+			expectedFIPSDualStackDNSSuffix: "api.aws",
+			expectedImplicitGlobalRegion:   endpoints.UsEast1RegionID,
+		},
+		endpoints.AwsCnPartitionID: {
+			expectedDNSSuffix:              "amazonaws.com.cn",
+			expectedDualStackDNSSuffix:     "api.amazonwebservices.com.cn",
+			expectedFIPSDNSSuffix:          "amazonaws.com.cn",
+			expectedFIPSDualStackDNSSuffix: "api.amazonwebservices.com.cn",
+			expectedImplicitGlobalRegion:   endpoints.CnNorthwest1RegionID,
+		},
+		endpoints.AwsUsGovPartitionID: {
+			expectedDNSSuffix:              "amazonaws.com",
+			expectedDualStackDNSSuffix:     "api.aws",
+			expectedFIPSDNSSuffix:          "amazonaws.com",
+			expectedFIPSDualStackDNSSuffix: "api.aws",
+			expectedImplicitGlobalRegion:   endpoints.UsGovWest1RegionID,
+		},
+	}
+
+	partitions := make(map[string]endpoints.Partition)
+	for _, p := range endpoints.DefaultPartitions() {
+		partitions[p.ID()] = p
+	}
+
+	for id, testcase := range testcases {
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			p, ok := partitions[id]
+			if !ok {
+				t.Fatalf("partition %q not found", id)
+			}
+
+			if got, want := p.DNSSuffix(), testcase.expectedDNSSuffix; got != want {
+				t.Errorf("expected DNS suffix %q, got %q", want, got)
+			}
+			if got, want := p.DualStackDNSSuffix(), testcase.expectedDualStackDNSSuffix; got != want {
+				t.Errorf("expected dual-stack DNS suffix %q, got %q", want, got)
+			}
+			if got, want := p.FIPSDNSSuffix(), testcase.expectedFIPSDNSSuffix; got != want {
+				t.Errorf("expected FIPS DNS suffix %q, got %q", want, got)
+			}
+			if got, want := p.FIPSDualStackDNSSuffix(), testcase.expectedFIPSDualStackDNSSuffix; got != want {
+				t.Errorf("expected FIPS dual-stack DNS suffix %q, got %q", want, got)
+			}
+			if got, want := p.ImplicitGlobalRegion(), testcase.expectedImplicitGlobalRegion; got != want {
+				t.Errorf("expected implicit global Region %q, got %q", want, got)
+			}
+			if !p.SupportsFIPS() {
+				t.Error("expected partition to support FIPS endpoints")
+			}
+			if !p.SupportsDualStack() {
+				t.Error("expected partition to support dual-stack endpoints")
+			}
+		})
+	}
+}
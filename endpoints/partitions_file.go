@@ -0,0 +1,358 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"regexp"
+	"slices"
+	"sync"
+	"time"
+)
+
+// PartitionsFileEnvVar is the environment variable containing the path to a file of additional partitions.
+// The file uses the same format as the AWS SDK endpoints model (endpoints.json), with an optional
+// "implicitGlobalRegion" partition attribute.
+const PartitionsFileEnvVar = "TF_AWS_PARTITIONS_FILE"
+
+const defaultHostnameTemplate = "{service}.{region}.{dnsSuffix}"
+
+// Partitions returns the default partitions merged with any partitions from the file named
+// in the PartitionsFileEnvVar environment variable.
+// The file is only read again if its modification time or size changes. Failed reads are not cached.
+func Partitions() ([]Partition, error) {
+	path := os.Getenv(PartitionsFileEnvVar)
+	if path == "" {
+		return DefaultPartitions(), nil
+	}
+
+	ps, err := readPartitionsFileCached(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return MergePartitions(DefaultPartitions(), ps...), nil
+}
+
+type cachedPartitionsFile struct {
+	modTime    time.Time
+	size       int64
+	partitions []Partition
+}
+
+var partitionsFiles sync.Map // map[string]cachedPartitionsFile
+
+func readPartitionsFileCached(path string) ([]Partition, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading partitions file: %w", err)
+	}
+
+	if v, ok := partitionsFiles.Load(path); ok {
+		if cached := v.(cachedPartitionsFile); cached.modTime.Equal(fi.ModTime()) && cached.size == fi.Size() {
+			return cached.partitions, nil
+		}
+	}
+
+	ps, err := ReadPartitionsFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	partitionsFiles.Store(path, cachedPartitionsFile{
+		modTime:    fi.ModTime(),
+		size:       fi.Size(),
+		partitions: ps,
+	})
+
+	return ps, nil
+}
+
+// ReadPartitionsFile reads partitions from a file in the AWS SDK endpoints model format.
+func ReadPartitionsFile(path string) ([]Partition, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading partitions file: %w", err)
+	}
+	defer f.Close()
+
+	ps, err := DecodePartitions(f)
+	if err != nil {
+		return nil, fmt.Errorf("reading partitions file (%s): %w", path, err)
+	}
+
+	return ps, nil
+}
+
+// DecodePartitions decodes partitions in the AWS SDK endpoints model format.
+func DecodePartitions(r io.Reader) ([]Partition, error) {
+	var data partitionsData
+	if err := json.NewDecoder(r).Decode(&data); err != nil {
+		return nil, fmt.Errorf("decoding partitions: %w", err)
+	}
+
+	ps := make([]Partition, 0, len(data.Partitions))
+	for _, pd := range data.Partitions {
+		p, err := pd.partition()
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, p)
+	}
+
+	return ps, nil
+}
+
+// MergePartitions returns the partitions with the additional partitions merged in.
+// An additional partition with the same ID as an existing partition adds to or replaces its Regions and services,
+// and replaces any partition attributes that it sets. Other additional partitions are appended.
+func MergePartitions(ps []Partition, additional ...Partition) []Partition {
+	result := slices.Clone(ps)
+
+	for _, a := range additional {
+		if i := slices.IndexFunc(result, func(p Partition) bool { return p.id == a.id }); i >= 0 {
+			result[i] = result[i].merge(a)
+		} else {
+			if a.defaults.hostname == "" {
+				a.defaults.hostname = defaultHostnameTemplate
+			}
+			result = append(result, a)
+		}
+	}
+
+	return result
+}
+
+func (p Partition) merge(other Partition) Partition {
+	p.name = cmp.Or(other.name, p.name)
+	p.dnsSuffix = cmp.Or(other.dnsSuffix, p.dnsSuffix)
+	p.dualStackDNSSuffix = cmp.Or(other.dualStackDNSSuffix, p.dualStackDNSSuffix)
+	p.fipsDNSSuffix = cmp.Or(other.fipsDNSSuffix, p.fipsDNSSuffix)
+	p.fipsDualStackDNSSuffix = cmp.Or(other.fipsDualStackDNSSuffix, p.fipsDualStackDNSSuffix)
+	p.implicitGlobalRegion = cmp.Or(other.implicitGlobalRegion, p.implicitGlobalRegion)
+	p.supportsFIPS = p.supportsFIPS || other.supportsFIPS
+	p.supportsDualStack = p.supportsDualStack || other.supportsDualStack
+	if other.regionRegex != nil {
+		p.regionRegex = other.regionRegex
+	}
+	p.defaults = p.defaults.merge(other.defaults)
+
+	p.regions = maps.Clone(p.regions)
+	if p.regions == nil {
+		p.regions = make(map[string]Region, len(other.regions))
+	}
+	maps.Copy(p.regions, other.regions)
+
+	p.services = maps.Clone(p.services)
+	if p.services == nil {
+		p.services = make(map[string]Service, len(other.services))
+	}
+	for id, s := range other.services {
+		if existing, ok := p.services[id]; ok {
+			s = existing.merge(s)
+		}
+		p.services[id] = s
+	}
+
+	return p
+}
+
+func (s Service) merge(other Service) Service {
+	s.defaults = s.defaults.merge(other.defaults)
+	s.partitionEndpoint = cmp.Or(other.partitionEndpoint, s.partitionEndpoint)
+	s.isGlobal = s.isGlobal || other.isGlobal
+
+	regions := slices.Concat(s.regions, other.regions)
+	slices.Sort(regions)
+	s.regions = slices.Compact(regions)
+
+	s.endpoints = maps.Clone(s.endpoints)
+	if s.endpoints == nil {
+		s.endpoints = make(map[string]endpoint, len(other.endpoints))
+	}
+	maps.Copy(s.endpoints, other.endpoints)
+
+	return s
+}
+
+func (e endpoint) merge(other endpoint) endpoint {
+	e.hostname = cmp.Or(other.hostname, e.hostname)
+	e.dnsSuffix = cmp.Or(other.dnsSuffix, e.dnsSuffix)
+	e.credentialScope.region = cmp.Or(other.credentialScope.region, e.credentialScope.region)
+	e.credentialScope.service = cmp.Or(other.credentialScope.service, e.credentialScope.service)
+	if len(other.variants) > 0 {
+		e.variants = other.variants
+	}
+	e.deprecated = e.deprecated || other.deprecated
+
+	return e
+}
+
+type partitionsData struct {
+	Partitions []partitionData `json:"partitions"`
+}
+
+type partitionData struct {
+	ID                   string                 `json:"partition"`
+	ImplicitGlobalRegion string                 `json:"implicitGlobalRegion"`
+	Name                 string                 `json:"partitionName"`
+	DNSSuffix            string                 `json:"dnsSuffix"`
+	RegionRegex          string                 `json:"regionRegex"`
+	Defaults             endpointData           `json:"defaults"`
+	Regions              map[string]regionData  `json:"regions"`
+	Services             map[string]serviceData `json:"services"`
+}
+
+type regionData struct {
+	Description string `json:"description"`
+}
+
+type serviceData struct {
+	Defaults          endpointData            `json:"defaults"`
+	PartitionEndpoint string                  `json:"partitionEndpoint"`
+	IsRegionalized    *bool                   `json:"isRegionalized"`
+	Endpoints         map[string]endpointData `json:"endpoints"`
+}
+
+type endpointData struct {
+	Hostname        string `json:"hostname"`
+	DNSSuffix       string `json:"dnsSuffix"`
+	CredentialScope struct {
+		Region  string `json:"region"`
+		Service string `json:"service"`
+	} `json:"credentialScope"`
+	Variants   []variantData `json:"variants"`
+	Deprecated bool          `json:"deprecated"`
+}
+
+type variantData struct {
+	Hostname  string   `json:"hostname"`
+	DNSSuffix string   `json:"dnsSuffix"`
+	Tags      []string `json:"tags"`
+}
+
+func (pd partitionData) partition() (Partition, error) {
+	if pd.ID == "" {
+		return Partition{}, fmt.Errorf("partition ID is required")
+	}
+
+	p := Partition{
+		id:        pd.ID,
+		name:      pd.Name,
+		dnsSuffix: pd.DNSSuffix,
+		regions:   make(map[string]Region, len(pd.Regions)),
+		services:  make(map[string]Service, len(pd.Services)),
+	}
+
+	if pd.RegionRegex != "" {
+		re, err := regexp.Compile(pd.RegionRegex)
+		if err != nil {
+			return Partition{}, fmt.Errorf("partition (%s): parsing Region regex: %w", pd.ID, err)
+		}
+		p.regionRegex = re
+	}
+
+	defaults, err := pd.Defaults.endpoint()
+	if err != nil {
+		return Partition{}, fmt.Errorf("partition (%s): %w", pd.ID, err)
+	}
+	p.defaults = defaults
+	p.implicitGlobalRegion = pd.ImplicitGlobalRegion
+	if v, ok := defaults.variant(FIPSVariant); ok {
+		p.supportsFIPS = true
+		p.fipsDNSSuffix = cmp.Or(v.dnsSuffix, p.dnsSuffix)
+	}
+	if v, ok := defaults.variant(DualStackVariant); ok {
+		p.supportsDualStack = true
+		p.dualStackDNSSuffix = cmp.Or(v.dnsSuffix, p.dnsSuffix)
+	}
+	if v, ok := defaults.variant(FIPSVariant | DualStackVariant); ok {
+		p.fipsDualStackDNSSuffix = cmp.Or(v.dnsSuffix, p.dnsSuffix)
+	}
+
+	for id, rd := range pd.Regions {
+		p.regions[id] = Region{
+			id:          id,
+			description: rd.Description,
+		}
+	}
+
+	for id, sd := range pd.Services {
+		s, err := sd.service(id, p)
+		if err != nil {
+			return Partition{}, fmt.Errorf("partition (%s): service (%s): %w", pd.ID, id, err)
+		}
+		p.services[id] = s
+	}
+
+	return p, nil
+}
+
+func (sd serviceData) service(id string, p Partition) (Service, error) {
+	s := Service{
+		id:                id,
+		partitionEndpoint: sd.PartitionEndpoint,
+		isGlobal:          sd.IsRegionalized != nil && !*sd.IsRegionalized && sd.PartitionEndpoint != "",
+		endpoints:         make(map[string]endpoint, len(sd.Endpoints)),
+	}
+
+	defaults, err := sd.Defaults.endpoint()
+	if err != nil {
+		return Service{}, err
+	}
+	s.defaults = defaults
+
+	for regionID, ed := range sd.Endpoints {
+		e, err := ed.endpoint()
+		if err != nil {
+			return Service{}, fmt.Errorf("endpoint (%s): %w", regionID, err)
+		}
+		s.endpoints[regionID] = e
+
+		if !e.deprecated && p.includesRegion(regionID) {
+			s.regions = append(s.regions, regionID)
+		}
+	}
+	slices.Sort(s.regions)
+
+	return s, nil
+}
+
+func (ed endpointData) endpoint() (endpoint, error) {
+	e := endpoint{
+		hostname:  ed.Hostname,
+		dnsSuffix: ed.DNSSuffix,
+		credentialScope: credentialScope{
+			region:  ed.CredentialScope.Region,
+			service: ed.CredentialScope.Service,
+		},
+		deprecated: ed.Deprecated,
+	}
+
+	for _, vd := range ed.Variants {
+		var variant EndpointVariant
+		for _, tag := range vd.Tags {
+			switch tag {
+			case "dualstack":
+				variant |= DualStackVariant
+			case "fips":
+				variant |= FIPSVariant
+			default:
+				return endpoint{}, fmt.Errorf("unsupported endpoint variant tag: %q", tag)
+			}
+		}
+		e.variants = append(e.variants, endpointVariant{
+			variant:   variant,
+			hostname:  vd.Hostname,
+			dnsSuffix: vd.DNSSuffix,
+		})
+	}
+
+	return e, nil
+}
@@ -0,0 +1,257 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+)
+
+const testPartitionsFile = `{
+  "partitions": [
+    {
+      "partition": "aws-test",
+      "partitionName": "AWS Test",
+      "implicitGlobalRegion": "xx-test-1",
+      "dnsSuffix": "test.example.com",
+      "regionRegex": "^xx\\-\\w+\\-\\d+$",
+      "defaults": {
+        "hostname": "{service}.{region}.{dnsSuffix}",
+        "variants": [{
+          "hostname": "{service}-fips.{region}.{dnsSuffix}",
+          "tags": ["fips"]
+        }]
+      },
+      "regions": {
+        "xx-test-1": {"description": "Test Region"}
+      },
+      "services": {
+        "sts": {
+          "endpoints": {
+            "xx-test-1": {}
+          }
+        },
+        "iam": {
+          "isRegionalized": false,
+          "partitionEndpoint": "aws-test-global",
+          "endpoints": {
+            "aws-test-global": {
+              "hostname": "iam.test.example.com",
+              "credentialScope": {"region": "xx-test-1"}
+            }
+          }
+        }
+      }
+    },
+    {
+      "partition": "aws",
+      "regions": {
+        "zz-future-1": {"description": "Future Region"}
+      },
+      "services": {
+        "sts": {
+          "endpoints": {
+            "zz-future-1": {}
+          }
+        }
+      }
+    }
+  ]
+}`
+
+func TestReadPartitionsFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "partitions.json")
+	if err := os.WriteFile(path, []byte(testPartitionsFile), 0600); err != nil {
+		t.Fatalf("writing partitions file: %s", err)
+	}
+
+	additional, err := endpoints.ReadPartitionsFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := len(additional), 2; got != want {
+		t.Fatalf("expected %d partitions, got %d", want, got)
+	}
+
+	ps := endpoints.MergePartitions(endpoints.DefaultPartitions(), additional...)
+	if got, want := len(ps), len(endpoints.DefaultPartitions())+1; got != want {
+		t.Errorf("expected %d merged partitions, got %d", want, got)
+	}
+
+	p, ok := endpoints.PartitionForRegion(ps, "xx-test-1")
+	if !ok {
+		t.Fatal("partition for Region \"xx-test-1\" not found")
+	}
+	if got, want := p.ID(), "aws-test"; got != want {
+		t.Errorf("expected partition %q, got %q", want, got)
+	}
+	if got, want := p.ImplicitGlobalRegion(), "xx-test-1"; got != want {
+		t.Errorf("expected implicit global Region %q, got %q", want, got)
+	}
+	if !p.SupportsFIPS() {
+		t.Error("expected partition to support FIPS endpoints")
+	}
+	if got, want := p.FIPSDNSSuffix(), "test.example.com"; got != want {
+		t.Errorf("expected FIPS DNS suffix %q, got %q", want, got)
+	}
+	if p.SupportsDualStack() {
+		t.Error("expected partition not to support dual-stack endpoints")
+	}
+
+	got, err := p.ResolveEndpoint("sts", "xx-test-1", endpoints.FIPSVariant)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(got, endpoints.ResolvedEndpoint{
+		URL:           "https://sts-fips.xx-test-1.test.example.com",
+		Hostname:      "sts-fips.xx-test-1.test.example.com",
+		SigningRegion: "xx-test-1",
+		SigningName:   "sts",
+	}); diff != "" {
+		t.Errorf("unexpected endpoint (+wanted, -got): %s", diff)
+	}
+
+	got, err = p.ResolveEndpoint("iam", "xx-test-1", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(got, endpoints.ResolvedEndpoint{
+		URL:           "https://iam.test.example.com",
+		Hostname:      "iam.test.example.com",
+		SigningRegion: "xx-test-1",
+		SigningName:   "iam",
+	}); diff != "" {
+		t.Errorf("unexpected endpoint (+wanted, -got): %s", diff)
+	}
+
+	// Regions added to an existing partition.
+	p, ok = endpoints.PartitionForRegion(ps, "zz-future-1")
+	if !ok {
+		t.Fatal("partition for Region \"zz-future-1\" not found")
+	}
+	if got, want := p.ID(), endpoints.AwsPartitionID; got != want {
+		t.Errorf("expected partition %q, got %q", want, got)
+	}
+	if got, want := p.Name(), "AWS Standard"; got != want {
+		t.Errorf("expected partition name %q, got %q", want, got)
+	}
+	if _, ok := p.Regions()[endpoints.UsEast1RegionID]; !ok {
+		t.Errorf("expected Region %q in merged partition", endpoints.UsEast1RegionID)
+	}
+	if !p.IsServiceAvailable("sts", "zz-future-1") {
+		t.Error("expected service \"sts\" to be available in Region \"zz-future-1\"")
+	}
+	if !p.IsServiceAvailable("sts", endpoints.UsWest2RegionID) {
+		t.Errorf("expected service \"sts\" to be available in Region %q", endpoints.UsWest2RegionID)
+	}
+
+	// The default partitions are not modified.
+	p, _ = endpoints.PartitionForRegion(endpoints.DefaultPartitions(), endpoints.UsEast1RegionID)
+	if _, ok := p.Regions()["zz-future-1"]; ok {
+		t.Error("expected default partitions to be unmodified")
+	}
+}
+
+func TestDecodePartitions_errors(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		data        string
+		expectedErr string
+	}{
+		"invalid JSON": {
+			data:        `{`,
+			expectedErr: "decoding partitions",
+		},
+		"missing partition ID": {
+			data:        `{"partitions": [{"partitionName": "Test"}]}`,
+			expectedErr: "partition ID is required",
+		},
+		"invalid Region regex": {
+			data:        `{"partitions": [{"partition": "aws-test", "regionRegex": "("}]}`,
+			expectedErr: "parsing Region regex",
+		},
+		"unsupported variant": {
+			data:        `{"partitions": [{"partition": "aws-test", "defaults": {"variants": [{"tags": ["ipv6"]}]}}]}`,
+			expectedErr: "unsupported endpoint variant tag",
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := endpoints.DecodePartitions(strings.NewReader(testcase.data))
+			if err == nil {
+				t.Fatalf("expected error containing %q, got none", testcase.expectedErr)
+			}
+			if !strings.Contains(err.Error(), testcase.expectedErr) {
+				t.Errorf("expected error containing %q, got %q", testcase.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestPartitions_envVar(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "partitions.json")
+	if err := os.WriteFile(path, []byte(testPartitionsFile), 0600); err != nil {
+		t.Fatalf("writing partitions file: %s", err)
+	}
+	t.Setenv(endpoints.PartitionsFileEnvVar, path)
+
+	ps, err := endpoints.Partitions()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	p, ok := endpoints.PartitionForRegion(ps, "xx-test-1")
+	if !ok {
+		t.Fatal("partition for Region \"xx-test-1\" not found")
+	}
+	if got, want := p.ID(), "aws-test"; got != want {
+		t.Errorf("expected partition %q, got %q", want, got)
+	}
+}
+
+func TestPartitions_envVarReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "partitions.json")
+	t.Setenv(endpoints.PartitionsFileEnvVar, path)
+
+	// The file has not been written yet.
+	if _, err := endpoints.Partitions(); err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	if err := os.WriteFile(path, []byte(testPartitionsFile), 0600); err != nil {
+		t.Fatalf("writing partitions file: %s", err)
+	}
+
+	ps, err := endpoints.Partitions()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, ok := endpoints.PartitionForRegion(ps, "xx-test-1"); !ok {
+		t.Fatal("partition for Region \"xx-test-1\" not found")
+	}
+
+	updated := strings.ReplaceAll(testPartitionsFile, "xx-test-1", "xx-updated-1")
+	if err := os.WriteFile(path, []byte(updated), 0600); err != nil {
+		t.Fatalf("writing partitions file: %s", err)
+	}
+
+	ps, err = endpoints.Partitions()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, ok := endpoints.PartitionForRegion(ps, "xx-updated-1"); !ok {
+		t.Fatal("partition for Region \"xx-updated-1\" not found")
+	}
+}
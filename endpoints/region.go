@@ -19,3 +19,10 @@ func (r Region) ID() string {
 func (r Region) Description() string {
 	return r.description
 }
+
+// PartitionIDForRegion returns the ID of the default partition which lists the Region.
+// Unlike PartitionForRegion, Regions are not matched against the partitions' Region regular expressions.
+func PartitionIDForRegion(regionID string) (string, bool) {
+	id, ok := regionPartitionIDs[regionID]
+	return id, ok
+}
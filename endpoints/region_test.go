@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+)
+
+func TestPartitionIDForRegion(t *testing.T) {
+	t.Parallel()
+
+	for _, p := range endpoints.DefaultPartitions() {
+		for id := range p.Regions() {
+			got, ok := endpoints.PartitionIDForRegion(id)
+			if !ok {
+				t.Errorf("expected partition for Region %q, got none", id)
+				continue
+			}
+			if got != p.ID() {
+				t.Errorf("expected partition %q for Region %q, got %q", p.ID(), id, got)
+			}
+		}
+	}
+
+	testcases := map[string]struct {
+		expectedFound bool
+		expectedID    string
+	}{
+		endpoints.UsEast1RegionID: {
+			expectedFound: true,
+			expectedID:    endpoints.AwsPartitionID,
+		},
+		endpoints.CnNorthwest1RegionID: {
+			expectedFound: true,
+			expectedID:    endpoints.AwsCnPartitionID,
+		},
+		endpoints.UsIsoEast1RegionID: {
+			expectedFound: true,
+			expectedID:    endpoints.AwsIsoPartitionID,
+		},
+		// Matches the partition's Region regular expression but is not listed.
+		"us-east-17": {
+			expectedFound: false,
+		},
+		"not-found": {
+			expectedFound: false,
+		},
+	}
+
+	for region, testcase := range testcases {
+		gotID, gotFound := endpoints.PartitionIDForRegion(region)
+
+		if gotFound != testcase.expectedFound {
+			t.Errorf("expected PartitionFound %t for Region %q, got %t", testcase.expectedFound, region, gotFound)
+		}
+		if gotID != testcase.expectedID {
+			t.Errorf("expected PartitionID %q for Region %q, got %q", testcase.expectedID, region, gotID)
+		}
+	}
+}
+
+func TestServiceIDs(t *testing.T) {
+	t.Parallel()
+
+	p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), endpoints.UsEast1RegionID)
+	if !ok {
+		t.Fatalf("partition for %q not found", endpoints.UsEast1RegionID)
+	}
+
+	for _, id := range []string{
+		endpoints.IamServiceID,
+		endpoints.StsServiceID,
+		endpoints.S3ServiceID,
+		endpoints.RuntimeLexServiceID,
+	} {
+		s, ok := p.Service(id)
+		if !ok {
+			t.Errorf("service %q not found", id)
+			continue
+		}
+		if got := s.ID(); got != id {
+			t.Errorf("expected service ID %q, got %q", id, got)
+		}
+	}
+}
@@ -3,12 +3,64 @@
 
 package endpoints
 
+import (
+	"slices"
+)
+
+// SDKServiceIDs returns the known AWS SDK for Go v2 service IDs, such as `sts.ServiceID`.
+// These are the keys used for service endpoint overrides, and differ from the service endpoint IDs.
+func SDKServiceIDs() []string {
+	return slices.Clone(sdkServiceIDs)
+}
+
 // Service represents an AWS service endpoint.
 type Service struct {
-	id string
+	id                string
+	defaults          endpoint
+	partitionEndpoint string
+	isGlobal          bool
+	regions           []string
+	endpoints         map[string]endpoint
 }
 
 // ID returns the service endpoint's identifier.
 func (s Service) ID() string {
 	return s.id
 }
+
+// Regions returns the IDs of the Regions in which the service has a regional endpoint.
+// Global services, such as IAM, have no regional endpoints and are available in all of a partition's Regions.
+func (s Service) Regions() []string {
+	return slices.Clone(s.regions)
+}
+
+// IsGlobal returns whether the service has a single, global endpoint for the partition.
+func (s Service) IsGlobal() bool {
+	return s.isGlobal
+}
+
+// PartitionEndpoint returns the ID of the pseudo-Region, such as "aws-global", of the service's global endpoint.
+// Returns an empty string if the service has no global endpoint.
+func (s Service) PartitionEndpoint() string {
+	return s.partitionEndpoint
+}
+
+// availableIn returns whether the service is available in a Region of the service's partition.
+// Services without modeled Regions are assumed to be available in all of the partition's Regions.
+func (s Service) availableIn(regionID string) bool {
+	if s.isGlobal || len(s.regions) == 0 {
+		return true
+	}
+
+	return slices.Contains(s.regions, regionID)
+}
+
+// endpoint returns the modeled endpoint for the Region.
+// Regions without endpoint-specific attributes return the zero endpoint, which inherits all defaults.
+func (s Service) endpoint(regionID string) (endpoint, bool) {
+	if e, ok := s.endpoints[regionID]; ok {
+		return e, true
+	}
+
+	return endpoint{}, slices.Contains(s.regions, regionID)
+}
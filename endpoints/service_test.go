@@ -0,0 +1,27 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/sso"
+	"github.com/aws/aws-sdk-go-v2/service/ssooidc"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+)
+
+func TestSDKServiceIDs(t *testing.T) {
+	t.Parallel()
+
+	ids := endpoints.SDKServiceIDs()
+
+	for _, id := range []string{iam.ServiceID, sso.ServiceID, ssooidc.ServiceID, sts.ServiceID} {
+		if !slices.Contains(ids, id) {
+			t.Errorf("expected SDK service ID %q", id)
+		}
+	}
+}
@@ -78,3 +78,57 @@ func IsNoValidCredentialSourcesError(diag diag.Diagnostic) bool {
 func ContainsNoValidCredentialSourcesError(diags diag.Diagnostics) bool {
 	return slices.ContainsFunc(diags, IsNoValidCredentialSourcesError)
 }
+
+// cannotCreateRolesAnywhereSessionError occurs when an IAM Roles Anywhere session cannot be created.
+type cannotCreateRolesAnywhereSessionError struct {
+	ra  config.IAMRolesAnywhere
+	err error
+}
+
+func (e cannotCreateRolesAnywhereSessionError) Severity() diag.Severity {
+	return diag.SeverityError
+}
+
+func (e cannotCreateRolesAnywhereSessionError) Summary() string {
+	return "Cannot create IAM Roles Anywhere session"
+}
+
+func (e cannotCreateRolesAnywhereSessionError) Detail() string {
+	return fmt.Sprintf(`IAM Role (%s) cannot be assumed with IAM Roles Anywhere.
+
+There are a number of possible causes of this - the most common are:
+  * The certificate is not issued by the trust anchor's certificate authority, or has expired
+  * The trust anchor or profile is disabled, or is in a different Region
+  * The role is not in the profile, or does not trust IAM Roles Anywhere
+
+Error: %s
+`, e.ra.RoleARN, e.err)
+}
+
+func (e cannotCreateRolesAnywhereSessionError) Equal(other diag.Diagnostic) bool {
+	ed, ok := other.(cannotCreateRolesAnywhereSessionError)
+	if !ok {
+		return false
+	}
+
+	return ed.Summary() == e.Summary() && ed.Detail() == e.Detail()
+}
+
+func (e cannotCreateRolesAnywhereSessionError) Err() error {
+	return e.err
+}
+
+func newCannotCreateRolesAnywhereSessionError(ra IAMRolesAnywhere, err error) cannotCreateRolesAnywhereSessionError {
+	return cannotCreateRolesAnywhereSessionError{
+		ra:  ra,
+		err: err,
+	}
+}
+
+var _ diag.DiagnosticWithErr = cannotCreateRolesAnywhereSessionError{}
+
+// IsCannotCreateRolesAnywhereSessionError returns true if the diagnostic is a CannotCreateRolesAnywhereSessionError.
+func IsCannotCreateRolesAnywhereSessionError(diag diag.Diagnostic) bool {
+	_, ok := diag.(cannotCreateRolesAnywhereSessionError)
+	return ok
+}
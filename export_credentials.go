@@ -0,0 +1,292 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package awsbase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/aws-sdk-go-base/v2/logging"
+)
+
+const (
+	// credentialProcessVersion is the version of the credential_process output format.
+	credentialProcessVersion = 1
+
+	// exportRefreshRetryInterval is the time to wait before retrying a failed refresh of exported credentials.
+	exportRefreshRetryInterval = 30 * time.Second
+)
+
+// ExportCredentialsEnv returns the credentials and Region of the aws.Config as environment variables
+// in "key=value" form, suitable for exec.Cmd.Env.
+// The environment variables are not refreshed when the credentials expire.
+func ExportCredentialsEnv(ctx context.Context, awsConfig aws.Config) ([]string, error) {
+	creds, err := retrieveExportCredentials(ctx, awsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	env := []string{
+		"AWS_ACCESS_KEY_ID=" + creds.AccessKeyID,
+		"AWS_SECRET_ACCESS_KEY=" + creds.SecretAccessKey,
+	}
+	if creds.SessionToken != "" {
+		env = append(env, "AWS_SESSION_TOKEN="+creds.SessionToken)
+	}
+	if creds.CanExpire {
+		env = append(env, "AWS_CREDENTIAL_EXPIRATION="+creds.Expires.UTC().Format(time.RFC3339))
+	}
+	if awsConfig.Region != "" {
+		env = append(env, "AWS_REGION="+awsConfig.Region, "AWS_DEFAULT_REGION="+awsConfig.Region)
+	}
+
+	return env, nil
+}
+
+// CredentialProcessOutput is the output of a `credential_process` command.
+//
+// See https://docs.aws.amazon.com/sdkref/latest/guide/feature-process-credentials.html.
+type CredentialProcessOutput struct {
+	Version         int
+	AccessKeyID     string     `json:"AccessKeyId"`
+	SecretAccessKey string     `json:"SecretAccessKey"`
+	SessionToken    string     `json:"SessionToken,omitempty"`
+	Expiration      *time.Time `json:"Expiration,omitempty"`
+}
+
+// ExportCredentialProcessJSON returns the credentials of the aws.Config in the JSON format output by a `credential_process` command.
+func ExportCredentialProcessJSON(ctx context.Context, awsConfig aws.Config) ([]byte, error) {
+	creds, err := retrieveExportCredentials(ctx, awsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	output := CredentialProcessOutput{
+		Version:         credentialProcessVersion,
+		AccessKeyID:     creds.AccessKeyID,
+		SecretAccessKey: creds.SecretAccessKey,
+		SessionToken:    creds.SessionToken,
+	}
+	if creds.CanExpire {
+		expires := creds.Expires.UTC()
+		output.Expiration = &expires
+	}
+
+	return json.Marshal(output)
+}
+
+// ExportSharedConfigFilesOptions are options for ExportSharedConfigFiles.
+type ExportSharedConfigFilesOptions struct {
+	// Dir is the directory in which the temporary directory containing the files is created.
+	// If empty, the default directory for temporary files is used.
+	Dir string
+
+	// Profile is the name of the profile written to the files. Defaults to "default".
+	Profile string
+
+	// RefreshBefore, if set, keeps the files refreshed with new credentials this long before the credentials expire.
+	RefreshBefore time.Duration
+}
+
+// ExportedSharedConfigFiles is a temporary shared config and credentials file pair created by ExportSharedConfigFiles.
+type ExportedSharedConfigFiles struct {
+	ConfigFile      string
+	CredentialsFile string
+	Profile         string
+
+	dir       string
+	awsConfig aws.Config
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+}
+
+// ExportSharedConfigFiles writes the credentials and Region of the aws.Config to a temporary shared config
+// and credentials file pair. The files are only readable by the current user.
+// Close must be called to stop any refreshing and remove the files.
+func ExportSharedConfigFiles(ctx context.Context, awsConfig aws.Config, optFns ...func(*ExportSharedConfigFilesOptions)) (*ExportedSharedConfigFiles, error) {
+	var opts ExportSharedConfigFilesOptions
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+	if opts.Profile == "" {
+		opts.Profile = "default"
+	}
+
+	creds, err := retrieveExportCredentials(ctx, awsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// os.MkdirTemp creates the directory with mode 0700.
+	dir, err := os.MkdirTemp(opts.Dir, "aws-sdk-go-base-credentials")
+	if err != nil {
+		return nil, fmt.Errorf("creating temporary directory: %w", err)
+	}
+
+	e := &ExportedSharedConfigFiles{
+		ConfigFile:      filepath.Join(dir, "config"),
+		CredentialsFile: filepath.Join(dir, "credentials"),
+		Profile:         opts.Profile,
+		dir:             dir,
+		awsConfig:       awsConfig,
+	}
+
+	if err := e.write(creds); err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+
+	ctx, e.cancel = context.WithCancel(context.WithoutCancel(ctx))
+	if opts.RefreshBefore > 0 && creds.CanExpire {
+		e.wg.Add(1)
+		go e.refresh(ctx, creds, opts.RefreshBefore)
+	}
+
+	return e, nil
+}
+
+// Env returns environment variables, in "key=value" form, that point the AWS CLI and SDKs at the files.
+func (e *ExportedSharedConfigFiles) Env() []string {
+	return []string{
+		"AWS_CONFIG_FILE=" + e.ConfigFile,
+		"AWS_SHARED_CREDENTIALS_FILE=" + e.CredentialsFile,
+		"AWS_PROFILE=" + e.Profile,
+	}
+}
+
+// Close stops refreshing the files and removes them.
+func (e *ExportedSharedConfigFiles) Close() error {
+	e.cancel()
+	e.wg.Wait()
+
+	return os.RemoveAll(e.dir)
+}
+
+func (e *ExportedSharedConfigFiles) refresh(ctx context.Context, creds aws.Credentials, refreshBefore time.Duration) {
+	defer e.wg.Done()
+
+	logger := logging.RetrieveLogger(ctx)
+
+	next := creds.Expires.Add(-refreshBefore)
+	for {
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		newCreds, err := e.retrieveNewCredentials(ctx, creds, refreshBefore)
+		if err == nil {
+			err = e.write(newCreds)
+		}
+		if err != nil {
+			logger.Warn(ctx, "Refreshing exported credentials", map[string]any{
+				"error": err,
+			})
+			next = time.Now().Add(exportRefreshRetryInterval)
+			continue
+		}
+
+		creds = newCreds
+		if !creds.CanExpire {
+			return
+		}
+
+		// Credentials that expire sooner than refreshBefore are refreshed no more often than the retry interval.
+		next = creds.Expires.Add(-refreshBefore)
+		if earliest := time.Now().Add(exportRefreshRetryInterval); next.Before(earliest) {
+			next = earliest
+		}
+	}
+}
+
+// retrieveNewCredentials retrieves credentials that do not expire within refreshBefore.
+// Cached credentials that are due to be refreshed are invalidated.
+func (e *ExportedSharedConfigFiles) retrieveNewCredentials(ctx context.Context, old aws.Credentials, refreshBefore time.Duration) (aws.Credentials, error) {
+	creds, err := retrieveExportCredentials(ctx, e.awsConfig)
+	if err != nil {
+		return aws.Credentials{}, err
+	}
+
+	if creds.CanExpire && !creds.Expires.After(old.Expires) && time.Until(creds.Expires) <= refreshBefore {
+		if cache, ok := e.awsConfig.Credentials.(*aws.CredentialsCache); ok {
+			cache.Invalidate()
+			return retrieveExportCredentials(ctx, e.awsConfig)
+		}
+	}
+
+	return creds, nil
+}
+
+// write writes the files, replacing any existing files so that readers never see partially written files.
+func (e *ExportedSharedConfigFiles) write(creds aws.Credentials) error {
+	var config strings.Builder
+	if e.Profile == "default" {
+		config.WriteString("[default]\n")
+	} else {
+		fmt.Fprintf(&config, "[profile %s]\n", e.Profile)
+	}
+	if e.awsConfig.Region != "" {
+		fmt.Fprintf(&config, "region = %s\n", e.awsConfig.Region)
+	}
+
+	var credentials strings.Builder
+	fmt.Fprintf(&credentials, "[%s]\n", e.Profile)
+	fmt.Fprintf(&credentials, "aws_access_key_id = %s\n", creds.AccessKeyID)
+	fmt.Fprintf(&credentials, "aws_secret_access_key = %s\n", creds.SecretAccessKey)
+	if creds.SessionToken != "" {
+		fmt.Fprintf(&credentials, "aws_session_token = %s\n", creds.SessionToken)
+	}
+
+	if err := writeFileAtomic(e.ConfigFile, config.String()); err != nil {
+		return fmt.Errorf("writing shared config file: %w", err)
+	}
+	if err := writeFileAtomic(e.CredentialsFile, credentials.String()); err != nil {
+		return fmt.Errorf("writing shared credentials file: %w", err)
+	}
+
+	return nil
+}
+
+func writeFileAtomic(name, data string) error {
+	f, err := os.CreateTemp(filepath.Dir(name), filepath.Base(name)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	// os.CreateTemp creates the file with mode 0600.
+	if _, err := f.WriteString(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), name)
+}
+
+func retrieveExportCredentials(ctx context.Context, awsConfig aws.Config) (aws.Credentials, error) {
+	if awsConfig.Credentials == nil {
+		return aws.Credentials{}, errors.New("exporting credentials: no credentials provider")
+	}
+
+	creds, err := awsConfig.Credentials.Retrieve(ctx)
+	if err != nil {
+		return aws.Credentials{}, fmt.Errorf("exporting credentials: %w", err)
+	}
+
+	return creds, nil
+}
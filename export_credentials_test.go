@@ -0,0 +1,214 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package awsbase
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExportCredentialsEnv(t *testing.T) {
+	expires := time.Date(2026, time.January, 2, 3, 4, 5, 0, time.UTC)
+
+	testCases := map[string]struct {
+		AwsConfig   aws.Config
+		ExpectedEnv []string
+	}{
+		"static": {
+			AwsConfig: aws.Config{
+				Credentials: credentials.NewStaticCredentialsProvider("AccessKey", "SecretKey", ""),
+			},
+			ExpectedEnv: []string{
+				"AWS_ACCESS_KEY_ID=AccessKey",
+				"AWS_SECRET_ACCESS_KEY=SecretKey",
+			},
+		},
+
+		"temporary with Region": {
+			AwsConfig: aws.Config{
+				Credentials: aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+					return aws.Credentials{
+						AccessKeyID:     "AccessKey",
+						SecretAccessKey: "SecretKey",
+						SessionToken:    "Token",
+						CanExpire:       true,
+						Expires:         expires,
+					}, nil
+				}),
+				Region: "us-west-2",
+			},
+			ExpectedEnv: []string{
+				"AWS_ACCESS_KEY_ID=AccessKey",
+				"AWS_SECRET_ACCESS_KEY=SecretKey",
+				"AWS_SESSION_TOKEN=Token",
+				"AWS_CREDENTIAL_EXPIRATION=2026-01-02T03:04:05Z",
+				"AWS_REGION=us-west-2",
+				"AWS_DEFAULT_REGION=us-west-2",
+			},
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			env, err := ExportCredentialsEnv(t.Context(), testCase.AwsConfig)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(env, testCase.ExpectedEnv); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExportCredentialProcessJSON(t *testing.T) {
+	expires := time.Date(2026, time.January, 2, 3, 4, 5, 0, time.UTC)
+
+	testCases := map[string]struct {
+		Credentials  aws.CredentialsProvider
+		ExpectedJSON string
+	}{
+		"static": {
+			Credentials:  credentials.NewStaticCredentialsProvider("AccessKey", "SecretKey", ""),
+			ExpectedJSON: `{"Version":1,"AccessKeyId":"AccessKey","SecretAccessKey":"SecretKey"}`,
+		},
+
+		"temporary": {
+			Credentials: aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+				return aws.Credentials{
+					AccessKeyID:     "AccessKey",
+					SecretAccessKey: "SecretKey",
+					SessionToken:    "Token",
+					CanExpire:       true,
+					Expires:         expires,
+				}, nil
+			}),
+			ExpectedJSON: `{"Version":1,"AccessKeyId":"AccessKey","SecretAccessKey":"SecretKey","SessionToken":"Token","Expiration":"2026-01-02T03:04:05Z"}`,
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			b, err := ExportCredentialProcessJSON(t.Context(), aws.Config{Credentials: testCase.Credentials})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if a, e := string(b), testCase.ExpectedJSON; a != e {
+				t.Errorf("expected JSON %s, got: %s", e, a)
+			}
+		})
+	}
+}
+
+func TestExportSharedConfigFiles(t *testing.T) {
+	awsConfig := aws.Config{
+		Credentials: credentials.NewStaticCredentialsProvider("AccessKey", "SecretKey", "Token"),
+		Region:      "us-west-2",
+	}
+
+	e, err := ExportSharedConfigFiles(t.Context(), awsConfig, func(opts *ExportSharedConfigFilesOptions) {
+		opts.Dir = t.TempDir()
+		opts.Profile = "exported"
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if runtime.GOOS != "windows" {
+		for _, name := range []string{e.ConfigFile, e.CredentialsFile} {
+			fi, err := os.Stat(name)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if a, e := fi.Mode().Perm(), os.FileMode(0600); a != e {
+				t.Errorf("expected file %q mode %s, got: %s", name, e, a)
+			}
+		}
+	}
+
+	sharedConfig, err := config.LoadSharedConfigProfile(t.Context(), e.Profile, func(opts *config.LoadSharedConfigOptions) {
+		opts.ConfigFiles = []string{e.ConfigFile}
+		opts.CredentialsFiles = []string{e.CredentialsFile}
+	})
+	if err != nil {
+		t.Fatalf("unexpected error loading exported files: %s", err)
+	}
+	if a, e := sharedConfig.Region, "us-west-2"; a != e {
+		t.Errorf("expected Region %q, got: %q", e, a)
+	}
+	if a, e := sharedConfig.Credentials.AccessKeyID, "AccessKey"; a != e {
+		t.Errorf("expected access key ID %q, got: %q", e, a)
+	}
+	if a, e := sharedConfig.Credentials.SessionToken, "Token"; a != e {
+		t.Errorf("expected session token %q, got: %q", e, a)
+	}
+
+	expectedEnv := []string{
+		"AWS_CONFIG_FILE=" + e.ConfigFile,
+		"AWS_SHARED_CREDENTIALS_FILE=" + e.CredentialsFile,
+		"AWS_PROFILE=exported",
+	}
+	if diff := cmp.Diff(e.Env(), expectedEnv); diff != "" {
+		t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+	}
+
+	if err := e.Close(); err != nil {
+		t.Fatalf("unexpected error closing: %s", err)
+	}
+	if _, err := os.Stat(e.CredentialsFile); !os.IsNotExist(err) {
+		t.Errorf("expected credentials file to be removed, got: %v", err)
+	}
+}
+
+func TestExportSharedConfigFiles_Refresh(t *testing.T) {
+	var count atomic.Int32
+	awsConfig := aws.Config{
+		Credentials: aws.NewCredentialsCache(aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+			n := count.Add(1)
+			return aws.Credentials{
+				AccessKeyID:     fmt.Sprintf("AccessKey%d", n),
+				SecretAccessKey: "SecretKey",
+				CanExpire:       true,
+				Expires:         time.Now().Add(time.Minute),
+			}, nil
+		})),
+	}
+
+	e, err := ExportSharedConfigFiles(t.Context(), awsConfig, func(opts *ExportSharedConfigFilesOptions) {
+		opts.Dir = t.TempDir()
+		opts.RefreshBefore = time.Minute - 100*time.Millisecond
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer e.Close()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		b, err := os.ReadFile(e.CredentialsFile)
+		if err != nil {
+			t.Fatalf("unexpected error reading credentials file: %s", err)
+		}
+		if strings.Contains(string(b), "aws_access_key_id = AccessKey2\n") {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("credentials file was not refreshed: %s", b)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -4,8 +4,14 @@
 package awsbase
 
 import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/hashicorp/aws-sdk-go-base/v2/internal/config"
+	"github.com/hashicorp/aws-sdk-go-base/v2/logging"
 )
 
 func defaultHttpClient(c *config.Config) (*awshttp.BuildableClient, error) {
@@ -18,3 +24,56 @@ func defaultHttpClient(c *config.Config) (*awshttp.BuildableClient, error) {
 
 	return httpClient, err
 }
+
+// proxyLogFields returns the configured proxy settings as log fields.
+// Proxy URLs are masked and proxy credentials are never included.
+func proxyLogFields(c *config.Config) map[string]any {
+	fields := make(map[string]any)
+
+	if c.HTTPProxy != nil {
+		fields["tf_aws.http_proxy"] = logging.MaskURLCredentials(aws.ToString(c.HTTPProxy))
+	}
+	if c.HTTPSProxy != nil {
+		fields["tf_aws.https_proxy"] = logging.MaskURLCredentials(aws.ToString(c.HTTPSProxy))
+	}
+	if c.NoProxy != "" {
+		fields["tf_aws.no_proxy"] = c.NoProxy
+	}
+	if len(c.ProxyRules) > 0 {
+		rules := make([]string, len(c.ProxyRules))
+		for i, rule := range c.ProxyRules {
+			proxy := "DIRECT"
+			if rule.Proxy != "" {
+				proxy = logging.MaskURLCredentials(rule.Proxy)
+			}
+			rules[i] = strings.Join(rule.Hosts, ",") + "=" + proxy
+		}
+		fields["tf_aws.proxy_rules"] = rules
+	}
+	if c.ProxyCredentials != nil {
+		fields["tf_aws.proxy_credentials"] = true
+	}
+
+	return fields
+}
+
+// hostOverrideLogFields returns the configured host and DNS overrides as log fields.
+func hostOverrideLogFields(c *config.Config) map[string]any {
+	fields := make(map[string]any)
+
+	if len(c.HostOverrides) > 0 {
+		overrides := make([]string, 0, len(c.HostOverrides))
+		for _, host := range slices.Sorted(maps.Keys(c.HostOverrides)) {
+			overrides = append(overrides, host+"="+c.HostOverrides[host])
+		}
+		fields["tf_aws.host_overrides"] = overrides
+	}
+	if c.DNSResolver != nil {
+		fields["tf_aws.dns_resolver"] = "custom"
+	}
+	if c.DialContext != nil {
+		fields["tf_aws.dialer"] = "custom"
+	}
+
+	return fields
+}
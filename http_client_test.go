@@ -4,7 +4,16 @@
 package awsbase
 
 import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
 	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/internal/config"
@@ -49,3 +58,68 @@ func transport(t *testing.T, config *config.Config) *http.Transport {
 
 	return client.GetTransport()
 }
+
+func TestHTTPClientConfiguration_hostOverrides(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, r.TLS.ServerName)
+	}))
+	defer ts.Close()
+
+	_, port, err := net.SplitHostPort(ts.Listener.Addr().String())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var dialed []string
+	tr := transport(t, &config.Config{
+		DialContext: func(ctx context.Context, network, address string) (net.Conn, error) {
+			dialed = append(dialed, address)
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, network, address)
+		},
+		HostOverrides: map[string]string{
+			"Example.com": "127.0.0.1",
+		},
+	})
+	certPool := x509.NewCertPool()
+	certPool.AddCert(ts.Certificate())
+	tr.TLSClientConfig.RootCAs = certPool
+
+	client := &http.Client{Transport: tr}
+	resp, err := client.Get("https://example.com:" + port)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if a, e := string(body), "example.com"; a != e {
+		t.Errorf("expected TLS server name %q, got %q", e, a)
+	}
+	if a, e := dialed, []string{net.JoinHostPort("127.0.0.1", port)}; !slices.Equal(a, e) {
+		t.Errorf("expected dialed addresses %q, got %q", e, a)
+	}
+}
+
+func TestHTTPClientConfiguration_dnsResolver(t *testing.T) {
+	tr := transport(t, &config.Config{
+		DNSResolver: &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+				return nil, errors.New("no DNS server")
+			},
+		},
+	})
+
+	client := &http.Client{Transport: tr}
+	_, err := client.Get("https://sts.example.test")
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+	if !strings.Contains(err.Error(), `resolving host "sts.example.test" with custom DNS resolver`) {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package httpreplay
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// CassetteVersion is the version of the on-disk cassette format.
+const CassetteVersion = 1
+
+// Cassette is a recorded sequence of HTTP interactions.
+//
+// Cassettes are stored as indented JSON. Header names are canonicalized and
+// object keys are sorted, so re-recording the same interactions produces the
+// same file.
+type Cassette struct {
+	Version      int           `json:"version"`
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a single recorded HTTP request and its response.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// bodyEncodingBase64 is the BodyEncoding of base64-encoded bodies.
+const bodyEncodingBase64 = "base64"
+
+// Request is a recorded HTTP request.
+type Request struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+	// BodyEncoding is "base64" if the body is not text and is base64-encoded, and empty otherwise.
+	BodyEncoding string `json:"body_encoding,omitempty"`
+}
+
+// Response is a recorded HTTP response.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+	// BodyEncoding is "base64" if the body is not text and is base64-encoded, and empty otherwise.
+	BodyEncoding string `json:"body_encoding,omitempty"`
+}
+
+// body returns the decoded response body.
+func (r Response) body() ([]byte, error) {
+	switch r.BodyEncoding {
+	case "":
+		return []byte(r.Body), nil
+	case bodyEncodingBase64:
+		return base64.StdEncoding.DecodeString(r.Body)
+	default:
+		return nil, fmt.Errorf("unsupported body encoding %q", r.BodyEncoding)
+	}
+}
+
+// LoadCassette reads a cassette from the file at path.
+func LoadCassette(path string) (*Cassette, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading cassette: %w", err)
+	}
+
+	var cassette Cassette
+	if err := json.Unmarshal(b, &cassette); err != nil {
+		return nil, fmt.Errorf("parsing cassette (%s): %w", path, err)
+	}
+
+	if cassette.Version != CassetteVersion {
+		return nil, fmt.Errorf("parsing cassette (%s): unsupported version %d", path, cassette.Version)
+	}
+
+	return &cassette, nil
+}
+
+// Save writes the cassette to the file at path, creating parent directories as needed.
+func (c *Cassette) Save(path string) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(c); err != nil {
+		return fmt.Errorf("encoding cassette: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating cassette directory: %w", err)
+	}
+
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("writing cassette: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package httpreplay
+
+import (
+	"encoding/json"
+	"mime"
+	"net/url"
+	"reflect"
+)
+
+// MatchOptions controls which parts of a request must match a recorded request
+// for the recorded response to be replayed.
+type MatchOptions struct {
+	// Method matches the HTTP method.
+	Method bool
+
+	// Host matches the URL host.
+	Host bool
+
+	// Path matches the URL path.
+	Path bool
+
+	// Query matches the URL query parameters, ignoring order.
+	Query bool
+
+	// Body matches the request body.
+	// Form-encoded bodies are compared ignoring parameter order and JSON bodies are compared structurally.
+	Body bool
+
+	// Headers lists request headers whose values must match.
+	Headers []string
+}
+
+// DefaultMatchOptions matches on method, path, query, and body.
+// The host is not matched so that recordings can be replayed against a local test server.
+var DefaultMatchOptions = MatchOptions{
+	Method: true,
+	Path:   true,
+	Query:  true,
+	Body:   true,
+}
+
+func (o MatchOptions) matches(recorded, incoming Request) bool {
+	if o.Method && recorded.Method != incoming.Method {
+		return false
+	}
+
+	ru, err := url.Parse(recorded.URL)
+	if err != nil {
+		return false
+	}
+	iu, err := url.Parse(incoming.URL)
+	if err != nil {
+		return false
+	}
+
+	if o.Host && ru.Host != iu.Host {
+		return false
+	}
+	if o.Path && ru.Path != iu.Path {
+		return false
+	}
+	if o.Query && ru.Query().Encode() != iu.Query().Encode() {
+		return false
+	}
+
+	if o.Body && !bodiesEqual(recorded, incoming) {
+		return false
+	}
+
+	for _, k := range o.Headers {
+		if !reflect.DeepEqual(recorded.Headers.Values(k), incoming.Headers.Values(k)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func bodiesEqual(recorded, incoming Request) bool {
+	if recorded.Body == incoming.Body {
+		return true
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(incoming.Headers.Get("Content-Type"))
+	switch mediaType {
+	case "application/x-www-form-urlencoded":
+		rv, err := url.ParseQuery(recorded.Body)
+		if err != nil {
+			return false
+		}
+		iv, err := url.ParseQuery(incoming.Body)
+		if err != nil {
+			return false
+		}
+		return rv.Encode() == iv.Encode()
+
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		var rv, iv any
+		if err := json.Unmarshal([]byte(recorded.Body), &rv); err != nil {
+			return false
+		}
+		if err := json.Unmarshal([]byte(incoming.Body), &iv); err != nil {
+			return false
+		}
+		return reflect.DeepEqual(rv, iv)
+	}
+
+	return false
+}
@@ -0,0 +1,257 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package httpreplay provides an HTTP transport that records HTTP interactions
+// to a cassette file and replays them in later test runs.
+//
+// A Recorder is used as the transport of `Config.HTTPClient`. In ModeRecord,
+// requests are sent to the real endpoint and the exchanges are captured with
+// credentials, signatures, and session tokens masked. In ModeReplay, no
+// network requests are made and responses are served from the cassette.
+package httpreplay
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// Mode is the operating mode of a Recorder.
+type Mode int
+
+const (
+	// ModeReplay serves responses from an existing cassette.
+	ModeReplay Mode = iota
+
+	// ModeRecord sends requests to the real endpoint and records the interactions.
+	ModeRecord
+)
+
+func (m Mode) String() string {
+	switch m {
+	case ModeReplay:
+		return "replay"
+	case ModeRecord:
+		return "record"
+	default:
+		return fmt.Sprintf("Mode(%d)", int(m))
+	}
+}
+
+// Options configures a Recorder.
+type Options struct {
+	// Transport is used to send requests in ModeRecord. Defaults to `http.DefaultTransport`.
+	Transport http.RoundTripper
+
+	// Match controls how requests are matched to recorded interactions in ModeReplay.
+	// Defaults to DefaultMatchOptions.
+	Match MatchOptions
+}
+
+// Recorder is an `http.RoundTripper` that records or replays HTTP interactions.
+type Recorder struct {
+	path    string
+	mode    Mode
+	options Options
+
+	mu       sync.Mutex
+	cassette *Cassette
+	used     []bool
+}
+
+// NewRecorder returns a Recorder using the cassette at path.
+// In ModeReplay, the cassette must exist. In ModeRecord, any existing cassette is replaced when Stop is called.
+func NewRecorder(path string, mode Mode, optFns ...func(*Options)) (*Recorder, error) {
+	options := Options{
+		Transport: http.DefaultTransport,
+		Match:     DefaultMatchOptions,
+	}
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	r := &Recorder{
+		path:    path,
+		mode:    mode,
+		options: options,
+	}
+
+	switch mode {
+	case ModeReplay:
+		cassette, err := LoadCassette(path)
+		if err != nil {
+			return nil, err
+		}
+		r.cassette = cassette
+		r.used = make([]bool, len(cassette.Interactions))
+
+	case ModeRecord:
+		r.cassette = &Cassette{
+			Version: CassetteVersion,
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported mode: %s", mode)
+	}
+
+	return r, nil
+}
+
+// Mode returns the operating mode of the Recorder.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Client returns an HTTP client that uses the Recorder as its transport.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{
+		Transport: r,
+	}
+}
+
+// Stop finishes the recording. In ModeRecord, the cassette is written to disk.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.cassette.Save(r.path)
+}
+
+// RoundTrip implements `http.RoundTripper`.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, header := decodeBody(body, req.Header)
+	recorded := Request{
+		Method:  req.Method,
+		URL:     scrubURL(req.URL),
+		Headers: scrubHeaders(header),
+	}
+	recorded.Body, recorded.BodyEncoding = scrubBody(body, header.Get("Content-Type"))
+
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+	return r.record(req, recorded)
+}
+
+func (r *Recorder) record(req *http.Request, recorded Request) (*http.Response, error) {
+	resp, err := r.options.Transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	body, header := decodeBody(body, resp.Header)
+	response := Response{
+		StatusCode: resp.StatusCode,
+		Headers:    scrubResponseHeaders(header),
+	}
+	response.Body, response.BodyEncoding = scrubBody(body, header.Get("Content-Type"))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request:  recorded,
+		Response: response,
+	})
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, recorded Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Prefer the first unused matching interaction, so that repeated identical requests are
+	// replayed in the order they were recorded. Once all matches have been used, the last
+	// matching interaction is replayed again.
+	idx := -1
+	for i, interaction := range r.cassette.Interactions {
+		if !r.options.Match.matches(interaction.Request, recorded) {
+			continue
+		}
+		if !r.used[i] {
+			idx = i
+			break
+		}
+		idx = i
+	}
+	if idx == -1 {
+		return nil, &NoMatchError{
+			Method: req.Method,
+			URL:    recorded.URL,
+		}
+	}
+	r.used[idx] = true
+
+	response := r.cassette.Interactions[idx].Response
+	body, err := response.body()
+	if err != nil {
+		return nil, fmt.Errorf("replaying response: %w", err)
+	}
+	header := response.Headers.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", response.StatusCode, http.StatusText(response.StatusCode)),
+		StatusCode:    response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("reading request body: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+// NoMatchError is returned in ModeReplay when no recorded interaction matches a request.
+type NoMatchError struct {
+	Method string
+	URL    string
+}
+
+func (e *NoMatchError) Error() string {
+	return fmt.Sprintf("no recorded interaction matches request: %s %s", e.Method, e.URL)
+}
+
+// IsNoMatchError returns true if the error is a NoMatchError.
+func IsNoMatchError(err error) bool {
+	var e *NoMatchError
+	return errors.As(err, &e)
+}
@@ -0,0 +1,203 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package httpreplay
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/logging"
+)
+
+const masked = "*****"
+
+// volatileRequestHeaders change on every request and are not recorded.
+var volatileRequestHeaders = []string{
+	"Amz-Sdk-Invocation-Id",
+	"Amz-Sdk-Request",
+	"Date",
+	"User-Agent",
+	"X-Amz-Date",
+}
+
+// sensitiveHeaders are always masked.
+var sensitiveHeaders = []string{
+	"X-Amz-Security-Token",
+	"X-Amz-Sso_bearer_token",
+	"X-Aws-Ec2-Metadata-Token",
+	"X-Aws-Ec2-Metadata-Token-Ttl-Seconds",
+}
+
+// sensitiveQueryParameters are masked in presigned URLs and query-protocol requests.
+var sensitiveQueryParameters = []string{
+	"X-Amz-Credential",
+	"X-Amz-Security-Token",
+	"X-Amz-Signature",
+}
+
+// sensitiveFormFields are masked in form-encoded bodies, such as STS query-protocol requests.
+var sensitiveFormFields = []string{
+	"SAMLAssertion",
+	"TokenCode",
+	"WebIdentityToken",
+}
+
+// credentialFieldsRegex matches credential values in XML and JSON bodies,
+// such as the STS AssumeRole and SSO GetRoleCredentials responses.
+var credentialFieldsRegex = regexp.MustCompile(
+	`(?i)(<(?:SecretAccessKey|SessionToken|Token|WebIdentityToken)>)[^<]*(</)` +
+		`|("(?:SecretAccessKey|SessionToken|Token|AccessToken|WebIdentityToken)"\s*:\s*")[^"]*(")`,
+)
+
+func scrubHeaders(header http.Header) http.Header {
+	header = header.Clone()
+	if header == nil {
+		return nil
+	}
+
+	for _, k := range volatileRequestHeaders {
+		header.Del(k)
+	}
+
+	if v := header.Get("Authorization"); v != "" {
+		if m, ok := logging.MaskAuthorizationHeader(v); ok {
+			header.Set("Authorization", m)
+		} else {
+			header.Set("Authorization", masked)
+		}
+	}
+
+	for _, k := range sensitiveHeaders {
+		if header.Get(k) != "" {
+			header.Set(k, masked)
+		}
+	}
+
+	if len(header) == 0 {
+		return nil
+	}
+	return header
+}
+
+func scrubResponseHeaders(header http.Header) http.Header {
+	header = header.Clone()
+	if header == nil {
+		return nil
+	}
+
+	// The body may be modified by scrubbing, so the length is recomputed on replay.
+	header.Del("Content-Length")
+	header.Del("Date")
+
+	for _, k := range sensitiveHeaders {
+		if header.Get(k) != "" {
+			header.Set(k, masked)
+		}
+	}
+
+	if len(header) == 0 {
+		return nil
+	}
+	return header
+}
+
+func scrubURL(u *url.URL) string {
+	u = cloneURL(u)
+
+	if u.User != nil {
+		u.User = url.User(masked)
+	}
+
+	query := u.Query()
+	if len(query) > 0 {
+		for _, k := range sensitiveQueryParameters {
+			if query.Has(k) {
+				query.Set(k, masked)
+			}
+		}
+		u.RawQuery = query.Encode()
+	}
+
+	return u.String()
+}
+
+// decodeBody decompresses a gzip-encoded body, so that it can be scrubbed, and removes the
+// Content-Encoding header, as the decoded body is recorded.
+// Bodies with other or invalid encodings are returned unchanged.
+func decodeBody(body []byte, header http.Header) ([]byte, http.Header) {
+	switch strings.ToLower(header.Get("Content-Encoding")) {
+	case "gzip", "x-gzip":
+	default:
+		return body, header
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(body))
+	if err != nil {
+		return body, header
+	}
+	decoded, err := io.ReadAll(r)
+	if err != nil {
+		return body, header
+	}
+
+	header = header.Clone()
+	header.Del("Content-Encoding")
+	return decoded, header
+}
+
+// scrubBody returns the body to record and its encoding.
+// Bodies that are not valid UTF-8 text can't be stored losslessly as JSON strings, so they are base64-encoded.
+func scrubBody(body []byte, contentType string) (string, string) {
+	if !utf8.Valid(body) {
+		return base64.StdEncoding.EncodeToString(body), bodyEncodingBase64
+	}
+
+	s := string(body)
+
+	if mediaType, _, _ := mime.ParseMediaType(contentType); mediaType == "application/x-www-form-urlencoded" {
+		s = scrubFormBody(s)
+	}
+
+	s = credentialFieldsRegex.ReplaceAllString(s, "${1}${3}"+masked+"${2}${4}")
+	return logging.MaskAWSSensitiveValues(s), ""
+}
+
+// scrubFormBody masks sensitive fields in a form-encoded body.
+// Bodies are matched ignoring parameter order, so re-encoding does not affect replay.
+func scrubFormBody(s string) string {
+	values, err := url.ParseQuery(s)
+	if err != nil {
+		return s
+	}
+
+	var changed bool
+	for _, k := range sensitiveFormFields {
+		if values.Has(k) {
+			values.Set(k, masked)
+			changed = true
+		}
+	}
+	if !changed {
+		return s
+	}
+
+	return values.Encode()
+}
+
+func cloneURL(u *url.URL) *url.URL {
+	c := *u
+	if u.User != nil {
+		user := *u.User
+		c.User = &user
+	}
+	return &c
+}
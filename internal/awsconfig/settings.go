@@ -0,0 +1,312 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package awsconfig
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+)
+
+// Kinds of setting source.
+const (
+	SourceKindProviderConfig      = "provider"
+	SourceKindEnvironmentVariable = "envvar"
+	SourceKindSharedConfig        = "sharedconfig"
+	SourceKindEC2InstanceMetadata = "imds"
+	SourceKindCredentialsProvider = "credentials"
+	SourceKindDefault             = "default"
+)
+
+// Source is where an effective setting was resolved from.
+type Source struct {
+	Kind string
+	// EnvVar is the name of the environment variable, if Kind is SourceKindEnvironmentVariable.
+	EnvVar string
+	// File and Profile are the shared config file and profile, if Kind is SourceKindSharedConfig.
+	// File is empty if the file could not be determined.
+	File    string
+	Profile string
+	// Provider is the name of the credentials provider, if Kind is SourceKindCredentialsProvider.
+	Provider string
+}
+
+func (s Source) String() string {
+	switch s.Kind {
+	case SourceKindEnvironmentVariable:
+		return fmt.Sprintf("envvar(%q)", s.EnvVar)
+	case SourceKindSharedConfig:
+		if s.File == "" {
+			return fmt.Sprintf("sharedconfig(profile=%q)", s.Profile)
+		}
+		return fmt.Sprintf("sharedconfig(file=%q, profile=%q)", s.File, s.Profile)
+	case SourceKindCredentialsProvider:
+		return fmt.Sprintf("credentials(%q)", s.Provider)
+	}
+	return s.Kind
+}
+
+// Setting is an effective setting and its source.
+type Setting struct {
+	// Name is the shared config file key for the setting, such as "region".
+	Name   string
+	Value  string
+	Source Source
+}
+
+// setting describes how to find a setting in each kind of config source.
+type setting struct {
+	name         string
+	envVars      []string
+	defaultValue string
+	loadOptions  func(config.LoadOptions) (string, bool)
+	envConfig    func(config.EnvConfig) (string, bool)
+	sharedConfig func(config.SharedConfig) (string, bool)
+}
+
+func nonEmpty(s string) (string, bool) {
+	return s, s != ""
+}
+
+func fipsState(v aws.FIPSEndpointState) (string, bool) {
+	if v == aws.FIPSEndpointStateUnset {
+		return "", false
+	}
+	return strconv.FormatBool(v == aws.FIPSEndpointStateEnabled), true
+}
+
+func dualStackState(v aws.DualStackEndpointState) (string, bool) {
+	if v == aws.DualStackEndpointStateUnset {
+		return "", false
+	}
+	return strconv.FormatBool(v == aws.DualStackEndpointStateEnabled), true
+}
+
+func endpointModeState(v imds.EndpointModeState) (string, bool) {
+	switch v {
+	case imds.EndpointModeStateIPv4:
+		return "IPv4", true
+	case imds.EndpointModeStateIPv6:
+		return "IPv6", true
+	}
+	return "", false
+}
+
+func clientEnableState(v imds.ClientEnableState) (string, bool) {
+	switch v {
+	case imds.ClientEnabled:
+		return "enabled", true
+	case imds.ClientDisabled:
+		return "disabled", true
+	}
+	return "", false
+}
+
+func maxAttempts(v int) (string, bool) {
+	if v == 0 {
+		return "", false
+	}
+	return strconv.Itoa(v), true
+}
+
+var settings = []setting{
+	{
+		name:         "region",
+		envVars:      []string{"AWS_REGION", "AWS_DEFAULT_REGION"},
+		loadOptions:  func(o config.LoadOptions) (string, bool) { return nonEmpty(o.Region) },
+		envConfig:    func(e config.EnvConfig) (string, bool) { return nonEmpty(e.Region) },
+		sharedConfig: func(s config.SharedConfig) (string, bool) { return nonEmpty(s.Region) },
+	},
+	{
+		name:         "retry_mode",
+		envVars:      []string{"AWS_RETRY_MODE"},
+		defaultValue: string(aws.RetryModeStandard),
+		loadOptions:  func(o config.LoadOptions) (string, bool) { return nonEmpty(string(o.RetryMode)) },
+		envConfig:    func(e config.EnvConfig) (string, bool) { return nonEmpty(string(e.RetryMode)) },
+		sharedConfig: func(s config.SharedConfig) (string, bool) { return nonEmpty(string(s.RetryMode)) },
+	},
+	{
+		name:         "max_attempts",
+		envVars:      []string{"AWS_MAX_ATTEMPTS"},
+		loadOptions:  func(o config.LoadOptions) (string, bool) { return maxAttempts(o.RetryMaxAttempts) },
+		envConfig:    func(e config.EnvConfig) (string, bool) { return maxAttempts(e.RetryMaxAttempts) },
+		sharedConfig: func(s config.SharedConfig) (string, bool) { return maxAttempts(s.RetryMaxAttempts) },
+	},
+	{
+		name:         "use_fips_endpoint",
+		envVars:      []string{"AWS_USE_FIPS_ENDPOINT"},
+		defaultValue: "false",
+		loadOptions:  func(o config.LoadOptions) (string, bool) { return fipsState(o.UseFIPSEndpoint) },
+		envConfig:    func(e config.EnvConfig) (string, bool) { return fipsState(e.UseFIPSEndpoint) },
+		sharedConfig: func(s config.SharedConfig) (string, bool) { return fipsState(s.UseFIPSEndpoint) },
+	},
+	{
+		name:         "use_dualstack_endpoint",
+		envVars:      []string{"AWS_USE_DUALSTACK_ENDPOINT"},
+		defaultValue: "false",
+		loadOptions:  func(o config.LoadOptions) (string, bool) { return dualStackState(o.UseDualStackEndpoint) },
+		envConfig:    func(e config.EnvConfig) (string, bool) { return dualStackState(e.UseDualStackEndpoint) },
+		sharedConfig: func(s config.SharedConfig) (string, bool) { return dualStackState(s.UseDualStackEndpoint) },
+	},
+	{
+		name:    "ca_bundle",
+		envVars: []string{"AWS_CA_BUNDLE"},
+		// The CA bundle is passed to the SDK as a reader, so the file name is not available.
+		loadOptions:  func(o config.LoadOptions) (string, bool) { return "", o.CustomCABundle != nil },
+		envConfig:    func(e config.EnvConfig) (string, bool) { return nonEmpty(e.CustomCABundle) },
+		sharedConfig: func(s config.SharedConfig) (string, bool) { return nonEmpty(s.CustomCABundle) },
+	},
+	{
+		name:         "ec2_metadata_service_endpoint",
+		envVars:      []string{"AWS_EC2_METADATA_SERVICE_ENDPOINT"},
+		loadOptions:  func(o config.LoadOptions) (string, bool) { return nonEmpty(o.EC2IMDSEndpoint) },
+		envConfig:    func(e config.EnvConfig) (string, bool) { return nonEmpty(e.EC2IMDSEndpoint) },
+		sharedConfig: func(s config.SharedConfig) (string, bool) { return nonEmpty(s.EC2IMDSEndpoint) },
+	},
+	{
+		name:         "ec2_metadata_service_endpoint_mode",
+		envVars:      []string{"AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE"},
+		defaultValue: "IPv4",
+		loadOptions:  func(o config.LoadOptions) (string, bool) { return endpointModeState(o.EC2IMDSEndpointMode) },
+		envConfig:    func(e config.EnvConfig) (string, bool) { return endpointModeState(e.EC2IMDSEndpointMode) },
+		sharedConfig: func(s config.SharedConfig) (string, bool) { return endpointModeState(s.EC2IMDSEndpointMode) },
+	},
+	{
+		name:         "ec2_metadata_service_enable_state",
+		envVars:      []string{"AWS_EC2_METADATA_DISABLED"},
+		defaultValue: "enabled",
+		loadOptions:  func(o config.LoadOptions) (string, bool) { return clientEnableState(o.EC2IMDSClientEnableState) },
+		envConfig:    func(e config.EnvConfig) (string, bool) { return clientEnableState(e.EC2IMDSClientEnableState) },
+		sharedConfig: func(config.SharedConfig) (string, bool) { return "", false },
+	},
+}
+
+// ResolveSettings returns the effective settings in the configuration sources together with their sources.
+// Sources are checked in order, as the AWS SDK for Go v2 does.
+// Settings that are not found in any source have their default value, if any, and a SourceKindDefault source.
+func ResolveSettings(configSources []any) []Setting {
+	var (
+		sharedConfigFiles      []string
+		sharedCredentialsFiles []string
+	)
+	for _, source := range configSources {
+		if o, ok := source.(config.LoadOptions); ok {
+			sharedConfigFiles = o.SharedConfigFiles
+			sharedCredentialsFiles = o.SharedCredentialsFiles
+		}
+	}
+	if len(sharedConfigFiles) == 0 {
+		sharedConfigFiles = []string{config.DefaultSharedConfigFilename()}
+	}
+	if len(sharedCredentialsFiles) == 0 {
+		sharedCredentialsFiles = []string{config.DefaultSharedCredentialsFilename()}
+	}
+
+	result := make([]Setting, 0, len(settings))
+	for _, s := range settings {
+		resolved := Setting{
+			Name:   s.name,
+			Value:  s.defaultValue,
+			Source: Source{Kind: SourceKindDefault},
+		}
+
+	sources:
+		for _, source := range configSources {
+			switch source := source.(type) {
+			case config.LoadOptions:
+				if v, ok := s.loadOptions(source); ok {
+					resolved.Value, resolved.Source = v, Source{Kind: SourceKindProviderConfig}
+					break sources
+				}
+			case config.EnvConfig:
+				if v, ok := s.envConfig(source); ok {
+					resolved.Value, resolved.Source = v, Source{Kind: SourceKindEnvironmentVariable, EnvVar: envVarName(s.envVars)}
+					break sources
+				}
+			case config.SharedConfig:
+				if v, ok := s.sharedConfig(source); ok {
+					resolved.Value, resolved.Source = v, Source{
+						Kind:    SourceKindSharedConfig,
+						File:    SharedConfigFileForKey(sharedConfigFiles, sharedCredentialsFiles, source.Profile, s.name),
+						Profile: source.Profile,
+					}
+					break sources
+				}
+			}
+		}
+
+		result = append(result, resolved)
+	}
+
+	return result
+}
+
+// envVarName returns the first of the environment variables that is set.
+func envVarName(names []string) string {
+	for _, name := range names {
+		if os.Getenv(name) != "" {
+			return name
+		}
+	}
+	return names[0]
+}
+
+// SharedConfigFileForKey returns the shared config or credentials file that sets the key in the profile.
+// As with the AWS SDK for Go v2, values in later files take precedence and shared config files take precedence
+// over shared credentials files.
+// Returns an empty string if no file sets the key.
+func SharedConfigFileForKey(configFiles, credentialsFiles []string, profile, key string) string {
+	for _, f := range slices.Backward(configFiles) {
+		section := "profile " + profile
+		if profile == "default" {
+			section = "default"
+		}
+		if fileSetsKey(f, section, key) {
+			return f
+		}
+	}
+	for _, f := range slices.Backward(credentialsFiles) {
+		if fileSetsKey(f, profile, key) {
+			return f
+		}
+	}
+	return ""
+}
+
+// fileSetsKey returns whether the INI file sets the key in the section.
+func fileSetsKey(filename, section, key string) bool {
+	f, err := os.Open(filename)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+
+	var current string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			current = strings.Join(strings.Fields(line[1:len(line)-1]), " ")
+			continue
+		}
+		if current != section {
+			continue
+		}
+		if k, _, ok := strings.Cut(line, "="); ok && strings.TrimSpace(k) == key {
+			return true
+		}
+	}
+
+	return false
+}
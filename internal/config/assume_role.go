@@ -0,0 +1,226 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/diag"
+	"github.com/hashicorp/aws-sdk-go-base/v2/validation"
+)
+
+// Limits on AssumeRole and AssumeRoleWithWebIdentity parameters.
+//
+// See https://docs.aws.amazon.com/STS/latest/APIReference/API_AssumeRole.html.
+const (
+	assumeRoleMinDuration         = 15 * time.Minute
+	assumeRoleMaxDuration         = 12 * time.Hour
+	assumeRoleChainedMaxDuration  = 1 * time.Hour
+	assumeRoleMinExternalIDLength = 2
+	assumeRoleMaxExternalIDLength = 1224
+	assumeRoleMaxPolicyARNs       = 10
+	assumeRoleMaxTags             = 50
+	assumeRoleMaxTagKeyLength     = 128
+	assumeRoleMaxTagValueLength   = 256
+)
+
+var (
+	roleSessionNameRegex = regexp.MustCompile(`^[\w+=,.@-]{2,64}$`)
+	externalIDRegex      = regexp.MustCompile(`^[\w+=,.@:/-]+$`)
+	tagRegex             = regexp.MustCompile(`^[\p{L}\p{Z}\p{N}_.:/=+\-@]*$`)
+)
+
+// ValidateAssumeRoles validates each `AssumeRole` and the `AssumeRoleWithWebIdentity` configuration,
+// so that problems are reported before any calls to STS.
+func (c Config) ValidateAssumeRoles(diags *diag.Diagnostics) {
+	if len(c.AssumeRole) == 0 && c.AssumeRoleWithWebIdentity == nil {
+		return
+	}
+
+	ps, err := c.Partitions()
+	if err != nil {
+		*diags = diags.AddSimpleError(err)
+		return
+	}
+	arnOpts := func(opts *validation.ARNOptions) {
+		opts.Partitions = ps
+	}
+
+	if ar := c.AssumeRoleWithWebIdentity; ar != nil {
+		hop := "assume role with web identity"
+
+		if ar.RoleARN == "" {
+			*diags = diags.AddError("Assume Role With Web Identity", "Role ARN was not set")
+		}
+		if !ar.HasValidTokenSource() {
+			*diags = diags.AddError("Assume Role With Web Identity", "One of WebIdentityToken, WebIdentityTokenFile, WebIdentityTokenCommand, WebIdentityTokenCI, WebIdentityTokenFunc must be set")
+		} else if sources, hasExclusive := ar.tokenSources(); hasExclusive && len(sources) > 1 {
+			*diags = diags.AddError("Assume Role With Web Identity", fmt.Sprintf("WebIdentityTokenCommand, WebIdentityTokenCI, and WebIdentityTokenFunc cannot be set with another web identity token source, got %s", strings.Join(sources, ", ")))
+		}
+		if cmd := ar.WebIdentityTokenCommand; cmd != nil {
+			if len(cmd.Command) == 0 || cmd.Command[0] == "" {
+				*diags = diags.AddError("Assume Role With Web Identity", "WebIdentityTokenCommand was set without a command")
+			}
+			if cmd.Timeout < 0 {
+				*diags = diags.AddError("Assume Role With Web Identity", fmt.Sprintf("WebIdentityTokenCommand timeout must not be negative, got %s", cmd.Timeout))
+			}
+		}
+		if ci := ar.WebIdentityTokenCI; ci != nil {
+			switch ci.Provider {
+			case "", CIProviderGitHubActions, CIProviderGitLab, CIProviderTerraformCloud:
+			default:
+				*diags = diags.AddError("Assume Role With Web Identity", fmt.Sprintf("WebIdentityTokenCI provider must be one of %q, %q, %q, got %q", CIProviderGitHubActions, CIProviderGitLab, CIProviderTerraformCloud, ci.Provider))
+			}
+		}
+
+		validateRoleParameters(diags, hop, ar.RoleARN, ar.SessionName, ar.Duration, assumeRoleMaxDuration, ar.Policy, ar.PolicyARNs, arnOpts)
+	}
+
+	total := len(c.AssumeRole)
+	for i, ar := range c.AssumeRole {
+		hop := fmt.Sprintf("assume role %d of %d", i+1, total)
+
+		if ar.RoleARN == "" {
+			*diags = diags.AddError(
+				"Cannot assume IAM Role",
+				fmt.Sprintf("IAM Role ARN not set in %s", hop),
+			)
+		}
+
+		// Roles assumed using the credentials of another assumed role are limited to one hour sessions.
+		maxDuration := assumeRoleMaxDuration
+		if i > 0 || c.AssumeRoleWithWebIdentity != nil || c.IAMRolesAnywhere != nil {
+			maxDuration = assumeRoleChainedMaxDuration
+		}
+		validateRoleParameters(diags, hop, ar.RoleARN, ar.SessionName, ar.Duration, maxDuration, ar.Policy, ar.PolicyARNs, arnOpts)
+
+		if n := len(ar.ExternalID); n > 0 && (n < assumeRoleMinExternalIDLength || n > assumeRoleMaxExternalIDLength || !externalIDRegex.MatchString(ar.ExternalID)) {
+			*diags = diags.AddError(
+				"Invalid External ID",
+				fmt.Sprintf("External ID in %s must be %d to %d characters and contain only alphanumeric characters and the characters +=,.@:/-", hop, assumeRoleMinExternalIDLength, assumeRoleMaxExternalIDLength),
+			)
+		}
+
+		validateRoleTags(diags, hop, ar.Tags, ar.TransitiveTagKeys)
+	}
+}
+
+// validateSessionPolicies checks the grammar of each session policy.
+// Problems are reported as warnings, since STS may accept policies that fail the client-side checks.
+func (c Config) validateSessionPolicies(diags *diag.Diagnostics) {
+	type sessionPolicy struct {
+		hop, policy string
+	}
+	var policies []sessionPolicy
+	if ar := c.AssumeRoleWithWebIdentity; ar != nil {
+		policies = append(policies, sessionPolicy{"assume role with web identity", ar.Policy})
+	}
+	for i, ar := range c.AssumeRole {
+		policies = append(policies, sessionPolicy{fmt.Sprintf("assume role %d of %d", i+1, len(c.AssumeRole)), ar.Policy})
+	}
+
+	for _, v := range policies {
+		if v.policy == "" {
+			continue
+		}
+		var sizeErr *validation.PolicySizeError
+		if err := validation.SessionPolicy(v.policy); err != nil && !errors.As(err, &sizeErr) {
+			*diags = diags.AddWarning(
+				"Possibly Invalid Session Policy",
+				fmt.Sprintf("Session policy in %s: %s", v.hop, err),
+			)
+		}
+	}
+}
+
+func validateRoleParameters(diags *diag.Diagnostics, hop, roleARN, sessionName string, duration, maxDuration time.Duration, policy string, policyARNs []string, arnOpts func(*validation.ARNOptions)) {
+	if roleARN != "" {
+		if err := validation.IAMRoleARN(roleARN, arnOpts); err != nil {
+			*diags = diags.AddError(
+				"Invalid IAM Role ARN",
+				fmt.Sprintf("IAM Role ARN in %s: %s", hop, err),
+			)
+		}
+	}
+
+	if sessionName != "" && !roleSessionNameRegex.MatchString(sessionName) {
+		*diags = diags.AddError(
+			"Invalid Session Name",
+			fmt.Sprintf("Session name in %s must be 2 to 64 characters and contain only alphanumeric characters and the characters +=,.@-", hop),
+		)
+	}
+
+	if duration != 0 && (duration < assumeRoleMinDuration || duration > maxDuration) {
+		*diags = diags.AddError(
+			"Invalid Duration",
+			fmt.Sprintf("Duration in %s must be between %s and %s, got %s", hop, assumeRoleMinDuration, maxDuration, duration),
+		)
+	}
+
+	// Only the size limit is enforced here, as STS may accept policies that fail the client-side grammar checks.
+	if policy != "" {
+		var sizeErr *validation.PolicySizeError
+		if err := validation.SessionPolicySize(policy); errors.As(err, &sizeErr) {
+			*diags = diags.AddError(
+				"Invalid Session Policy",
+				fmt.Sprintf("Session policy in %s: %s", hop, err),
+			)
+		}
+	}
+
+	if len(policyARNs) > assumeRoleMaxPolicyARNs {
+		*diags = diags.AddError(
+			"Too Many IAM Policy ARNs",
+			fmt.Sprintf("%d IAM Policy ARNs set in %s, maximum is %d", len(policyARNs), hop, assumeRoleMaxPolicyARNs),
+		)
+	}
+	for _, policyARN := range policyARNs {
+		if err := validation.IAMPolicyARN(policyARN, arnOpts); err != nil {
+			*diags = diags.AddError(
+				"Invalid IAM Policy ARN",
+				fmt.Sprintf("IAM Policy ARN in %s: %s", hop, err),
+			)
+		}
+	}
+}
+
+func validateRoleTags(diags *diag.Diagnostics, hop string, tags map[string]string, transitiveTagKeys []string) {
+	if len(tags) > assumeRoleMaxTags {
+		*diags = diags.AddError(
+			"Too Many Session Tags",
+			fmt.Sprintf("%d session tags set in %s, maximum is %d", len(tags), hop, assumeRoleMaxTags),
+		)
+	}
+
+	for _, k := range slices.Sorted(maps.Keys(tags)) {
+		if n := utf8.RuneCountInString(k); n == 0 || n > assumeRoleMaxTagKeyLength || !tagRegex.MatchString(k) {
+			*diags = diags.AddError(
+				"Invalid Session Tag",
+				fmt.Sprintf("Session tag key %q in %s must be 1 to %d characters and contain only letters, numbers, spaces, and the characters _.:/=+-@", k, hop, assumeRoleMaxTagKeyLength),
+			)
+		}
+		if v := tags[k]; utf8.RuneCountInString(v) > assumeRoleMaxTagValueLength || !tagRegex.MatchString(v) {
+			*diags = diags.AddError(
+				"Invalid Session Tag",
+				fmt.Sprintf("Session tag value for key %q in %s must be at most %d characters and contain only letters, numbers, spaces, and the characters _.:/=+-@", k, hop, assumeRoleMaxTagValueLength),
+			)
+		}
+	}
+
+	for _, k := range transitiveTagKeys {
+		if _, ok := tags[k]; !ok {
+			*diags = diags.AddError(
+				"Invalid Transitive Tag Key",
+				fmt.Sprintf("Transitive tag key %q in %s is not a session tag", k, hop),
+			)
+		}
+	}
+}
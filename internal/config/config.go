@@ -5,8 +5,11 @@ package config
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
@@ -16,6 +19,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	"github.com/hashicorp/aws-sdk-go-base/v2/diag"
 	"github.com/hashicorp/aws-sdk-go-base/v2/internal/expand"
@@ -40,23 +44,32 @@ type Config struct {
 	CallerDocumentationURL         string
 	CallerName                     string
 	CustomCABundle                 string
+	DialContext                    func(ctx context.Context, network, address string) (net.Conn, error)
+	DNSResolver                    *net.Resolver
 	EC2MetadataServiceEnableState  imds.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
+	Endpoints                      map[string]string
 	ForbiddenAccountIds            []string
 	HTTPClient                     *http.Client
 	HTTPProxy                      *string
 	HTTPSProxy                     *string
+	HostOverrides                  map[string]string
+	IAMRolesAnywhere               *IAMRolesAnywhere
 	IamEndpoint                    string
 	Insecure                       bool
 	Logger                         logging.Logger
 	MaxBackoff                     time.Duration
 	MaxRetries                     int
 	NoProxy                        string
+	PartitionsFile                 string
 	Profile                        string
+	ProxyCredentials               *ProxyCredentials
+	ProxyRules                     []ProxyRule
 	HTTPProxyMode                  ProxyMode
 	Region                         string
 	RetryMode                      aws.RetryMode
+	RolesAnywhereEndpoint          string
 	SecretKey                      string
 	SharedCredentialsFiles         []string
 	SharedConfigFiles              []string
@@ -66,6 +79,7 @@ type Config struct {
 	StsEndpoint                    string
 	StsRegion                      string
 	SuppressDebugLog               bool
+	Timeouts                       Timeouts
 	Token                          string
 	TokenBucketRateLimiterCapacity int
 	UseDualStackEndpoint           bool
@@ -118,6 +132,21 @@ func (c Config) HTTPTransportOptions() (func(*http.Transport), error) {
 			return nil, fmt.Errorf("parsing HTTPS proxy URL: %w", err)
 		}
 	}
+	hostOverrides, err := c.resolveHostOverrides()
+	if err != nil {
+		return nil, fmt.Errorf("parsing host overrides: %w", err)
+	}
+	ruleProxyUrls := make([]*url.URL, len(c.ProxyRules))
+	for i, rule := range c.ProxyRules {
+		if rule.Proxy == "" {
+			continue
+		}
+		u, err := parseProxyURL(rule.Proxy)
+		if err != nil {
+			return nil, fmt.Errorf("parsing proxy rule %d URL: %w", i+1, err)
+		}
+		ruleProxyUrls[i] = withProxyCredentials(u, rule.Credentials)
+	}
 
 	opts := func(tr *http.Transport) {
 		tr.MaxIdleConnsPerHost = awshttp.DefaultHTTPTransportMaxIdleConnsPerHost
@@ -134,21 +163,31 @@ func (c Config) HTTPTransportOptions() (func(*http.Transport), error) {
 			tr.TLSClientConfig.InsecureSkipVerify = true
 		}
 
+		tr.DialContext = c.dialContext(tr.DialContext, hostOverrides)
+
+		// ProxyCredentials are only added to the configured proxies, not to proxies from the environment or proxy rules.
 		proxyConfig := httpproxy.FromEnvironment()
 		if httpProxyUrl != nil {
-			proxyConfig.HTTPProxy = httpProxyUrl.String()
+			proxyConfig.HTTPProxy = proxyStringWithCredentials(httpProxyUrl.String(), c.ProxyCredentials)
 			if c.HTTPProxyMode == HTTPProxyModeLegacy && proxyConfig.HTTPSProxy == "" {
-				proxyConfig.HTTPSProxy = httpProxyUrl.String()
+				proxyConfig.HTTPSProxy = proxyConfig.HTTPProxy
 			}
 		}
 		if httpsProxyUrl != nil {
-			proxyConfig.HTTPSProxy = httpsProxyUrl.String()
+			proxyConfig.HTTPSProxy = proxyStringWithCredentials(httpsProxyUrl.String(), c.ProxyCredentials)
 		}
 		if c.NoProxy != "" {
 			proxyConfig.NoProxy = c.NoProxy
 		}
+		proxyFunc := proxyConfig.ProxyFunc()
 		tr.Proxy = func(req *http.Request) (*url.URL, error) {
-			return proxyConfig.ProxyFunc()(req.URL)
+			if i := slices.IndexFunc(c.ProxyRules, func(rule ProxyRule) bool {
+				return rule.matches(req.URL.Hostname())
+			}); i >= 0 {
+				return ruleProxyUrls[i], nil
+			}
+
+			return proxyFunc(req.URL)
 		}
 	}
 
@@ -157,21 +196,11 @@ func (c Config) HTTPTransportOptions() (func(*http.Transport), error) {
 
 func (c Config) ValidateProxySettings(diags *diag.Diagnostics) {
 	if c.HTTPProxy != nil {
-		if _, err := url.Parse(aws.ToString(c.HTTPProxy)); err != nil {
-			*diags = diags.AddError(
-				"Invalid HTTP Proxy",
-				fmt.Sprintf("Unable to parse URL: %s", err),
-			)
-		}
+		validateProxyURL(diags, "Invalid HTTP Proxy", aws.ToString(c.HTTPProxy))
 	}
 
 	if c.HTTPSProxy != nil {
-		if _, err := url.Parse(aws.ToString(c.HTTPSProxy)); err != nil {
-			*diags = diags.AddError(
-				"Invalid HTTPS Proxy",
-				fmt.Sprintf("Unable to parse URL: %s", err),
-			)
-		}
+		validateProxyURL(diags, "Invalid HTTPS Proxy", aws.ToString(c.HTTPSProxy))
 	}
 
 	if c.HTTPProxy != nil && *c.HTTPProxy != "" && c.HTTPSProxy == nil && os.Getenv("HTTPS_PROXY") == "" && os.Getenv("https_proxy") == "" {
@@ -185,6 +214,68 @@ func (c Config) ValidateProxySettings(diags *diag.Diagnostics) {
 			)
 		}
 	}
+
+	for i, rule := range c.ProxyRules {
+		if err := validateProxyRule(rule); err != nil {
+			*diags = diags.AddError(
+				"Invalid Proxy Rule",
+				fmt.Sprintf("Proxy rule %d of %d: %s", i+1, len(c.ProxyRules), logging.MaskURLCredentials(err.Error())),
+			)
+		}
+	}
+
+	if c.ProxyCredentials != nil && c.ProxyCredentials.Username == "" {
+		*diags = diags.AddError(
+			"Invalid Proxy Credentials",
+			"A username is required when proxy credentials are set.",
+		)
+	}
+
+	// Credentials conflict when they are set separately for a proxy whose URL also contains credentials.
+	var urlHasCredentials, credentialsConflict bool
+	for _, proxy := range []string{aws.ToString(c.HTTPProxy), aws.ToString(c.HTTPSProxy)} {
+		if proxyURLHasCredentials(proxy) {
+			urlHasCredentials = true
+			credentialsConflict = credentialsConflict || c.ProxyCredentials != nil
+		}
+	}
+	for _, rule := range c.ProxyRules {
+		if proxyURLHasCredentials(rule.Proxy) {
+			urlHasCredentials = true
+			credentialsConflict = credentialsConflict || rule.Credentials != nil
+		}
+	}
+
+	if credentialsConflict {
+		*diags = diags.AddWarning(
+			"Conflicting Proxy Credentials",
+			"Proxy credentials were set and a proxy URL also contains credentials. "+
+				"The credentials in the proxy URL take precedence for that proxy.",
+		)
+	} else if urlHasCredentials {
+		*diags = diags.AddWarning(
+			"Proxy Credentials in URL",
+			"A proxy URL contains credentials. "+
+				"Set proxy credentials separately from the proxy URL to keep them out of logs and diagnostics.",
+		)
+	}
+}
+
+func validateProxyURL(diags *diag.Diagnostics, summary, s string) {
+	if _, err := url.Parse(s); err != nil {
+		*diags = diags.AddError(
+			summary,
+			fmt.Sprintf("Unable to parse URL: %s", logging.MaskURLCredentials(err.Error())),
+		)
+		return
+	}
+
+	if err := checkProxyScheme(s); err != nil {
+		*diags = diags.AddError(
+			summary,
+			err.Error(),
+		)
+	}
 }
 
 const (
@@ -199,7 +290,7 @@ func missingHttpsProxyLegacyWarningDiag(s string) diag.Diagnostic {
 		fmt.Sprintf(
 			missingHttpsProxyDetailProblem+" Using HTTP proxy %q for HTTPS requests. This behavior may change in future versions.\n\n"+
 				missingHttpsProxyDetailResolution,
-			s,
+			logging.MaskURLCredentials(s),
 		),
 	)
 }
@@ -256,6 +347,13 @@ type AssumeRoleWithWebIdentity struct {
 	SessionName          string
 	WebIdentityToken     string
 	WebIdentityTokenFile string
+	// WebIdentityTokenCommand is run to get a web identity token each time credentials are retrieved.
+	WebIdentityTokenCommand *WebIdentityTokenCommand
+	// WebIdentityTokenCI gets a web identity token from the CI system running the current process each time credentials are retrieved.
+	WebIdentityTokenCI *WebIdentityTokenCI
+	// WebIdentityTokenFunc is called to get a web identity token each time credentials are retrieved.
+	// The context is the context of the credentials retrieval.
+	WebIdentityTokenFunc func(context.Context) (string, error)
 }
 
 func (c AssumeRoleWithWebIdentity) resolveWebIdentityTokenFile() (string, error) {
@@ -267,23 +365,99 @@ func (c AssumeRoleWithWebIdentity) resolveWebIdentityTokenFile() (string, error)
 }
 
 func (c AssumeRoleWithWebIdentity) HasValidTokenSource() bool {
-	return c.WebIdentityToken != "" || c.WebIdentityTokenFile != ""
+	return c.WebIdentityToken != "" || c.WebIdentityTokenFile != "" || c.WebIdentityTokenCommand != nil || c.WebIdentityTokenCI != nil || c.WebIdentityTokenFunc != nil
+}
+
+// tokenSources returns the names of the token sources which are set.
+// hasExclusive is true if any of the sources which cannot be combined with another source is set.
+func (c AssumeRoleWithWebIdentity) tokenSources() (sources []string, hasExclusive bool) {
+	if c.WebIdentityToken != "" {
+		sources = append(sources, "WebIdentityToken")
+	}
+	if c.WebIdentityTokenFile != "" {
+		sources = append(sources, "WebIdentityTokenFile")
+	}
+	if c.WebIdentityTokenCommand != nil {
+		sources = append(sources, "WebIdentityTokenCommand")
+		hasExclusive = true
+	}
+	if c.WebIdentityTokenCI != nil {
+		sources = append(sources, "WebIdentityTokenCI")
+		hasExclusive = true
+	}
+	if c.WebIdentityTokenFunc != nil {
+		sources = append(sources, "WebIdentityTokenFunc")
+		hasExclusive = true
+	}
+	return sources, hasExclusive
 }
 
 // Implements `stscreds.IdentityTokenRetriever`
+//
+// If both are set, WebIdentityToken is used before WebIdentityTokenFile.
+// WebIdentityTokenCommand, WebIdentityTokenCI, and WebIdentityTokenFunc cannot be set with another token source; see ValidateAssumeRoles.
+// Token files, commands, CI tokens, and functions are read each time the token is retrieved, so that refreshed credentials use a fresh token.
 func (c AssumeRoleWithWebIdentity) GetIdentityToken() ([]byte, error) {
+	return c.getIdentityToken(context.Background(), nil)
+}
+
+// IdentityTokenRetriever returns a `stscreds.IdentityTokenRetriever` that uses ctx and httpClient
+// for any requests made to get a token, such as GitHub Actions token requests.
+func (c AssumeRoleWithWebIdentity) IdentityTokenRetriever(ctx context.Context, httpClient aws.HTTPClient) stscreds.IdentityTokenRetriever {
+	return identityTokenRetriever{
+		ctx:        ctx,
+		httpClient: httpClient,
+		config:     c,
+	}
+}
+
+type identityTokenRetriever struct {
+	ctx        context.Context
+	httpClient aws.HTTPClient
+	config     AssumeRoleWithWebIdentity
+}
+
+func (r identityTokenRetriever) GetIdentityToken() ([]byte, error) {
+	return r.config.getIdentityToken(r.ctx, r.httpClient)
+}
+
+func (c AssumeRoleWithWebIdentity) getIdentityToken(ctx context.Context, httpClient aws.HTTPClient) ([]byte, error) {
 	if c.WebIdentityToken != "" {
 		return []byte(c.WebIdentityToken), nil
 	}
-	webIdentityTokenFile, err := c.resolveWebIdentityTokenFile()
-	if err != nil {
-		return nil, err
+
+	if c.WebIdentityTokenFile != "" {
+		webIdentityTokenFile, err := c.resolveWebIdentityTokenFile()
+		if err != nil {
+			return nil, err
+		}
+
+		b, err := os.ReadFile(webIdentityTokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read file at %s: %w", webIdentityTokenFile, err)
+		}
+
+		return b, nil
 	}
 
-	b, err := os.ReadFile(webIdentityTokenFile)
-	if err != nil {
-		return nil, fmt.Errorf("unable to read file at %s: %w", webIdentityTokenFile, err)
+	if c.WebIdentityTokenCommand != nil {
+		return c.WebIdentityTokenCommand.token(ctx)
+	}
+
+	if c.WebIdentityTokenCI != nil {
+		return c.WebIdentityTokenCI.token(ctx, httpClient)
+	}
+
+	if c.WebIdentityTokenFunc != nil {
+		token, err := c.WebIdentityTokenFunc(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("getting web identity token: %w", err)
+		}
+		if token == "" {
+			return nil, errors.New("getting web identity token: empty token")
+		}
+		return []byte(token), nil
 	}
 
-	return b, nil
+	return nil, errors.New("no web identity token source")
 }
@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/hashicorp/aws-sdk-go-base/v2/diag"
+)
+
+const conflictWarningSummary = "Configuration conflict detected"
+
+// ValidateConflicts reports ambiguous combinations of settings as warnings.
+// Each warning names both of the conflicting sources and which one takes effect.
+func (c Config) ValidateConflicts(ctx context.Context, diags *diag.Diagnostics) {
+	profile, profileSource := c.profile()
+
+	if c.Profile != "" && os.Getenv("AWS_ACCESS_KEY_ID") != "" && os.Getenv("AWS_SECRET_ACCESS_KEY") != "" {
+		*diags = diags.AddWarning(conflictWarningSummary,
+			`A Profile was specified along with the environment variables "AWS_ACCESS_KEY_ID" and "AWS_SECRET_ACCESS_KEY". `+
+				`The Profile is now used instead of the environment variable credentials. This may lead to unexpected behavior.`)
+	}
+
+	if profile != "" && (c.AccessKey != "" || c.SecretKey != "" || c.AssumeRoleWithWebIdentity != nil) {
+		if sc, ok := c.sharedConfigProfile(ctx, profile); ok {
+			if (c.AccessKey != "" || c.SecretKey != "") && sharedConfigHasCredentials(sc) {
+				*diags = diags.AddWarning(conflictWarningSummary,
+					fmt.Sprintf("Static credentials were set in the provider configuration along with the profile %q from %s, which also sets credentials. "+
+						"The static credentials are used instead of the profile's credentials.", profile, profileSource))
+			}
+			if c.AssumeRoleWithWebIdentity != nil && sc.RoleARN != "" {
+				*diags = diags.AddWarning(conflictWarningSummary,
+					fmt.Sprintf("Assume role with web identity was set in the provider configuration along with the profile %q from %s, "+
+						"which assumes the role %q. The web identity role is assumed and the profile's role is ignored.", profile, profileSource, sc.RoleARN))
+			}
+		}
+	}
+
+	if c.IAMRolesAnywhere != nil && (c.AccessKey != "" || c.SecretKey != "" || c.Token != "") {
+		*diags = diags.AddWarning(conflictWarningSummary,
+			"Static credentials were set in the provider configuration along with IAM Roles Anywhere. "+
+				"The IAM Roles Anywhere credentials are used instead of the static credentials.")
+	}
+
+	if c.UseFIPSEndpoint {
+		for _, source := range c.customEndpointSources() {
+			*diags = diags.AddWarning(conflictWarningSummary,
+				fmt.Sprintf("FIPS endpoints were enabled in the provider configuration along with a custom endpoint in %s. "+
+					"The custom endpoint is used, so requests may not use a FIPS endpoint.", source))
+		}
+	}
+
+	if c.StsRegion != "" && c.StsEndpoint == "" && c.Region != "" && c.StsRegion != c.Region {
+		*diags = diags.AddWarning(conflictWarningSummary,
+			fmt.Sprintf("The STS Region %q was set in the provider configuration without an STS endpoint, and differs from the Region %q in the provider configuration. "+
+				"STS requests use the default endpoint for the STS Region, and all other requests use the Region.", c.StsRegion, c.Region))
+	}
+
+	if c.Region != "" {
+		for _, name := range []string{"AWS_REGION", "AWS_DEFAULT_REGION"} {
+			if v := os.Getenv(name); v != "" {
+				if v != c.Region {
+					*diags = diags.AddWarning(conflictWarningSummary,
+						fmt.Sprintf("The Region %q was set in the provider configuration and the Region %q was set in the environment variable %q. "+
+							"The Region from the provider configuration is used.", c.Region, v, name))
+				}
+				break
+			}
+		}
+	}
+}
+
+// profile returns the shared config profile and a description of where it was set.
+func (c Config) profile() (string, string) {
+	if c.Profile != "" {
+		return c.Profile, "the provider configuration"
+	}
+	if v := os.Getenv("AWS_PROFILE"); v != "" {
+		return v, `the environment variable "AWS_PROFILE"`
+	}
+	return "", ""
+}
+
+// sharedConfigProfile loads the shared config profile.
+// Errors loading the profile are ignored, as they are reported when credentials are resolved.
+func (c Config) sharedConfigProfile(ctx context.Context, profile string) (config.SharedConfig, bool) {
+	configFiles, err := c.ResolveSharedConfigFiles()
+	if err != nil {
+		return config.SharedConfig{}, false
+	}
+	credentialsFiles, err := c.ResolveSharedCredentialsFiles()
+	if err != nil {
+		return config.SharedConfig{}, false
+	}
+
+	sc, err := config.LoadSharedConfigProfile(ctx, profile, func(opts *config.LoadSharedConfigOptions) {
+		if len(configFiles) > 0 {
+			opts.ConfigFiles = configFiles
+		}
+		if len(credentialsFiles) > 0 {
+			opts.CredentialsFiles = credentialsFiles
+		}
+	})
+	if err != nil {
+		return config.SharedConfig{}, false
+	}
+
+	return sc, true
+}
+
+// sharedConfigHasCredentials returns whether the shared config profile is a source of credentials.
+func sharedConfigHasCredentials(sc config.SharedConfig) bool {
+	return sc.Credentials.HasKeys() ||
+		sc.RoleARN != "" ||
+		sc.SSOSessionName != "" ||
+		sc.SSOAccountID != "" ||
+		sc.CredentialProcess != "" ||
+		sc.WebIdentityTokenFile != ""
+}
+
+// customEndpointSources returns descriptions of where custom service endpoints are set.
+func (c Config) customEndpointSources() []string {
+	var sources []string
+
+	for _, v := range []struct {
+		name, value string
+	}{
+		{"the IAM endpoint", c.IamEndpoint},
+		{"the IAM Roles Anywhere endpoint", c.RolesAnywhereEndpoint},
+		{"the SSO endpoint", c.SsoEndpoint},
+		{"the STS endpoint", c.StsEndpoint},
+	} {
+		if v.value != "" {
+			sources = append(sources, fmt.Sprintf("%s in the provider configuration", v.name))
+		}
+	}
+
+	for _, k := range slices.Sorted(maps.Keys(c.Endpoints)) {
+		sources = append(sources, fmt.Sprintf("the endpoint for service %q in the provider configuration", k))
+	}
+
+	var names []string
+	for _, env := range os.Environ() {
+		name, value, _ := strings.Cut(env, "=")
+		if value != "" && (name == endpointURLEnvVar || strings.HasPrefix(name, serviceEndpointURLEnvVarPrefix)) {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		sources = append(sources, fmt.Sprintf("the environment variable %q", name))
+	}
+
+	return sources
+}
@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/diag"
+	"github.com/hashicorp/aws-sdk-go-base/v2/logging"
+)
+
+type dialContextFunc func(ctx context.Context, network, address string) (net.Conn, error)
+
+// resolveHostOverrides returns the host overrides indexed by lower-cased host name.
+func (c Config) resolveHostOverrides() (map[string]netip.Addr, error) {
+	if len(c.HostOverrides) == 0 {
+		return nil, nil
+	}
+
+	var errs []error
+	overrides := make(map[string]netip.Addr, len(c.HostOverrides))
+	for _, host := range slices.Sorted(maps.Keys(c.HostOverrides)) {
+		ip := c.HostOverrides[host]
+		if err := validateOverrideHost(host); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		addr, err := netip.ParseAddr(ip)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("host %q: invalid IP address %q", host, ip))
+			continue
+		}
+		overrides[strings.ToLower(host)] = addr
+	}
+
+	return overrides, errors.Join(errs...)
+}
+
+func validateOverrideHost(host string) error {
+	if host == "" {
+		return errors.New("host name cannot be empty")
+	}
+	if strings.ContainsAny(host, ":/") {
+		return fmt.Errorf("host %q: must be a host name without scheme or port", host)
+	}
+	return nil
+}
+
+// ValidateHostOverrides validates the host and DNS override settings.
+func (c Config) ValidateHostOverrides(diags *diag.Diagnostics) {
+	if _, err := c.resolveHostOverrides(); err != nil {
+		*diags = diags.AddError(
+			"Invalid Host Overrides",
+			err.Error(),
+		)
+	}
+
+	if c.DialContext != nil && c.DNSResolver != nil {
+		*diags = diags.AddWarning(
+			"Conflicting DNS Settings",
+			"Both a custom dialer and a custom DNS resolver were set. "+
+				"Host names are resolved using the custom DNS resolver before being passed to the custom dialer.",
+		)
+	}
+}
+
+// dialContext wraps the base dial function, applying any host overrides and custom DNS resolution.
+// Only the network connection is redirected; TLS server name indication and certificate
+// verification continue to use the original host name.
+func (c Config) dialContext(base dialContextFunc, overrides map[string]netip.Addr) dialContextFunc {
+	if c.DialContext != nil {
+		base = c.DialContext
+	}
+	if base == nil {
+		var dialer net.Dialer
+		base = dialer.DialContext
+	}
+
+	if len(overrides) == 0 && c.DNSResolver == nil {
+		return base
+	}
+
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(address)
+		if err != nil {
+			return base(ctx, network, address)
+		}
+
+		logger := logging.RetrieveLogger(ctx)
+
+		if addr, ok := overrides[strings.ToLower(host)]; ok {
+			overridden := net.JoinHostPort(addr.String(), port)
+			logger.Debug(ctx, "Overriding host address", map[string]any{
+				"tf_aws.host_override.host":    host,
+				"tf_aws.host_override.address": overridden,
+				"tf_aws.host_override.source":  "HostOverrides",
+			})
+			return base(ctx, network, overridden)
+		}
+
+		if c.DNSResolver == nil {
+			return base(ctx, network, address)
+		}
+		if _, err := netip.ParseAddr(host); err == nil {
+			return base(ctx, network, address)
+		}
+
+		addrs, err := c.DNSResolver.LookupNetIP(ctx, "ip", host)
+		if err != nil {
+			return nil, fmt.Errorf("resolving host %q with custom DNS resolver: %w", host, err)
+		}
+		for _, addr := range addrs {
+			resolved := net.JoinHostPort(addr.Unmap().String(), port)
+			logger.Debug(ctx, "Overriding host address", map[string]any{
+				"tf_aws.host_override.host":    host,
+				"tf_aws.host_override.address": resolved,
+				"tf_aws.host_override.source":  "DNSResolver",
+			})
+			var conn net.Conn
+			conn, err = base(ctx, network, resolved)
+			if err == nil {
+				return conn, nil
+			}
+		}
+		if err == nil {
+			err = fmt.Errorf("no addresses found for host %q", host)
+		}
+		return nil, err
+	}
+}
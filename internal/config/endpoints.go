@@ -0,0 +1,196 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+	"maps"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/diag"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+)
+
+const (
+	endpointURLEnvVar              = "AWS_ENDPOINT_URL"
+	serviceEndpointURLEnvVarPrefix = endpointURLEnvVar + "_"
+)
+
+// ServiceEndpoint returns the endpoint override from `Endpoints` for the service with the given SDK service ID.
+// Keys are matched ignoring case and treating spaces and underscores as equivalent,
+// so "SSO OIDC", "sso_oidc", and "SSO_OIDC" all match the "SSO OIDC" service.
+func (c Config) ServiceEndpoint(serviceID string) (string, bool) {
+	if len(c.Endpoints) == 0 {
+		return "", false
+	}
+
+	if v, ok := c.Endpoints[serviceID]; ok {
+		return v, true
+	}
+
+	normalized := NormalizeServiceID(serviceID)
+	for k, v := range c.Endpoints {
+		if NormalizeServiceID(k) == normalized {
+			return v, true
+		}
+	}
+
+	return "", false
+}
+
+// Partitions returns the default partitions merged with any partitions from
+// the file named in `PartitionsFile` or the `TF_AWS_PARTITIONS_FILE` environment variable.
+func (c Config) Partitions() ([]endpoints.Partition, error) {
+	ps, err := endpoints.Partitions()
+	if err != nil {
+		return nil, err
+	}
+
+	if c.PartitionsFile != "" {
+		additional, err := endpoints.ReadPartitionsFile(c.PartitionsFile)
+		if err != nil {
+			return nil, err
+		}
+		ps = endpoints.MergePartitions(ps, additional...)
+	}
+
+	return ps, nil
+}
+
+// NormalizeServiceID returns the form of an SDK service ID used in shared config `services` sections.
+func NormalizeServiceID(serviceID string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(serviceID)), " ", "_")
+}
+
+// IsKnownServiceID returns whether the SDK service ID, in any normalized form, is a known service.
+func IsKnownServiceID(serviceID string) bool {
+	_, ok := knownServiceIDsNormalized()[NormalizeServiceID(serviceID)]
+	return ok
+}
+
+var knownServiceIDsNormalized = sync.OnceValue(func() map[string]struct{} {
+	ids := endpoints.SDKServiceIDs()
+	m := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		m[NormalizeServiceID(id)] = struct{}{}
+	}
+	return m
+})
+
+// ValidateEndpoints validates the service endpoint overrides set in `Endpoints`
+// and in the `AWS_ENDPOINT_URL` and `AWS_ENDPOINT_URL_<SERVICE>` environment variables.
+func (c Config) ValidateEndpoints(diags *diag.Diagnostics) {
+	seen := make(map[string]string, len(c.Endpoints))
+	for _, k := range slices.Sorted(maps.Keys(c.Endpoints)) {
+		normalized := NormalizeServiceID(k)
+		if other, ok := seen[normalized]; ok {
+			*diags = diags.AddError(
+				"Conflicting Service Endpoints",
+				fmt.Sprintf("Endpoints for services %q and %q refer to the same service.", other, k),
+			)
+			continue
+		}
+		seen[normalized] = k
+
+		if !IsKnownServiceID(k) {
+			*diags = diags.Append(unknownServiceIDWarningDiag(k, fmt.Sprintf("endpoint for service %q", k)))
+		}
+		if err := validateEndpointURL(c.Endpoints[k]); err != nil {
+			*diags = diags.AddError(
+				"Invalid Service Endpoint",
+				fmt.Sprintf("Endpoint for service %q: %s", k, err),
+			)
+		}
+	}
+
+	if v := os.Getenv(endpointURLEnvVar); v != "" {
+		if err := validateEndpointURL(v); err != nil {
+			*diags = diags.AddError(
+				"Invalid Service Endpoint",
+				fmt.Sprintf("Environment variable %q: %s", endpointURLEnvVar, err),
+			)
+		}
+
+		// The AWS SDK for Go v2 ignores all other service endpoint sources for a service when `AWS_ENDPOINT_URL` is set
+		// and the corresponding `AWS_ENDPOINT_URL_<SERVICE>` is not.
+		var ignored []string
+		for _, k := range slices.Sorted(maps.Keys(c.Endpoints)) {
+			if _, ok := os.LookupEnv(serviceEndpointURLEnvVar(k)); !ok {
+				ignored = append(ignored, k)
+			}
+		}
+		if len(ignored) > 0 {
+			*diags = diags.AddWarning(
+				"Service Endpoints Overridden",
+				fmt.Sprintf("The environment variable %q is set, so the endpoints for the following services are ignored: %s.\n\n"+
+					"To use these endpoints, set the corresponding %q environment variables.",
+					endpointURLEnvVar, strings.Join(ignored, ", "), serviceEndpointURLEnvVarPrefix+"<SERVICE>"),
+			)
+		}
+	}
+
+	for _, env := range os.Environ() {
+		name, value, _ := strings.Cut(env, "=")
+		service, ok := strings.CutPrefix(name, serviceEndpointURLEnvVarPrefix)
+		if !ok || service == "" || value == "" {
+			continue
+		}
+		if !IsKnownServiceID(service) {
+			*diags = diags.Append(unknownServiceIDWarningDiag(service, fmt.Sprintf("environment variable %q", name)))
+		}
+		if err := validateEndpointURL(value); err != nil {
+			*diags = diags.AddError(
+				"Invalid Service Endpoint",
+				fmt.Sprintf("Environment variable %q: %s", name, err),
+			)
+		}
+	}
+}
+
+// ValidateServiceEndpointURL validates a service endpoint from a shared config file `services` section.
+func ValidateServiceEndpointURL(diags *diag.Diagnostics, source, serviceID, endpoint string) {
+	if !IsKnownServiceID(serviceID) {
+		*diags = diags.Append(unknownServiceIDWarningDiag(serviceID, source))
+	}
+	if err := validateEndpointURL(endpoint); err != nil {
+		*diags = diags.AddError(
+			"Invalid Service Endpoint",
+			fmt.Sprintf("%s: %s", source, err),
+		)
+	}
+}
+
+func unknownServiceIDWarningDiag(serviceID, source string) diag.Diagnostic {
+	return diag.NewWarningDiagnostic(
+		"Unknown Service ID",
+		fmt.Sprintf("The service ID %q in %s is not a known AWS SDK service ID. The endpoint may not be used.", serviceID, source),
+	)
+}
+
+func serviceEndpointURLEnvVar(serviceID string) string {
+	return serviceEndpointURLEnvVarPrefix + strings.ToUpper(NormalizeServiceID(serviceID))
+}
+
+func validateEndpointURL(s string) error {
+	if s == "" {
+		return fmt.Errorf("URL cannot be empty")
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return fmt.Errorf("unable to parse URL: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("URL %q must use the %q or %q scheme", s, "http", "https")
+	}
+	if u.Host == "" {
+		return fmt.Errorf("URL %q must include a host", s)
+	}
+
+	return nil
+}
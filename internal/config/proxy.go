@@ -0,0 +1,161 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+)
+
+const (
+	proxySchemeHTTP    = "http"
+	proxySchemeHTTPS   = "https"
+	proxySchemeSOCKS5  = "socks5"
+	proxySchemeSOCKS5H = "socks5h"
+)
+
+// ProxyCredentials are used to authenticate to a proxy server.
+// They are supplied separately from the proxy URL so that they are not included in logs or diagnostics.
+// `Config.ProxyCredentials` are only sent to the proxies set by `HTTPProxy` and `HTTPSProxy`.
+type ProxyCredentials struct {
+	Username string
+	Password string
+}
+
+func (c *ProxyCredentials) userinfo() *url.Userinfo {
+	if c.Password == "" {
+		return url.User(c.Username)
+	}
+	return url.UserPassword(c.Username, c.Password)
+}
+
+// withProxyCredentials returns a copy of the proxy URL with the credentials added.
+// The URL is returned unchanged if it already contains credentials.
+func withProxyCredentials(u *url.URL, creds *ProxyCredentials) *url.URL {
+	if u == nil || creds == nil || u.User != nil {
+		return u
+	}
+
+	// Copy the URL so that the credentials are never added to the configured value.
+	authUrl := *u
+	authUrl.User = creds.userinfo()
+	return &authUrl
+}
+
+// proxyStringWithCredentials adds the credentials to a configured proxy URL string.
+func proxyStringWithCredentials(s string, creds *ProxyCredentials) string {
+	if s == "" || creds == nil {
+		return s
+	}
+
+	u, err := parseProxyURL(s)
+	if err != nil {
+		return s
+	}
+	return withProxyCredentials(u, creds).String()
+}
+
+// ProxyRule routes requests for matching hosts through a specific proxy.
+//
+// Hosts is a list of host name patterns, such as "sts.amazonaws.com" or "*.s3.amazonaws.com".
+// Patterns use the syntax of path.Match and are matched case-insensitively.
+// Proxy is the URL of the proxy to use. An empty Proxy connects directly to matching hosts.
+// Credentials, if set, are used to authenticate to Proxy.
+type ProxyRule struct {
+	Hosts       []string
+	Proxy       string
+	Credentials *ProxyCredentials
+}
+
+func (r ProxyRule) matches(host string) bool {
+	host = strings.ToLower(host)
+	return slices.ContainsFunc(r.Hosts, func(pattern string) bool {
+		ok, _ := path.Match(strings.ToLower(pattern), host)
+		return ok
+	})
+}
+
+// parseProxyURL parses a proxy URL using the same rules as `httpproxy.Config`:
+// values without a supported scheme are treated as HTTP proxies.
+func parseProxyURL(s string) (*url.URL, error) {
+	if u, err := url.Parse(s); err == nil && isSupportedProxyScheme(u.Scheme) {
+		return u, nil
+	}
+
+	return url.Parse("http://" + s)
+}
+
+// checkProxyScheme returns an error if the proxy URL explicitly specifies an unsupported scheme.
+func checkProxyScheme(s string) error {
+	if !strings.Contains(s, "://") {
+		return nil
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return err
+	}
+	if !isSupportedProxyScheme(u.Scheme) {
+		return fmt.Errorf("unsupported proxy scheme %q, supported schemes are %q, %q, %q, and %q",
+			u.Scheme, proxySchemeHTTP, proxySchemeHTTPS, proxySchemeSOCKS5, proxySchemeSOCKS5H)
+	}
+
+	return nil
+}
+
+func isSupportedProxyScheme(scheme string) bool {
+	switch scheme {
+	case proxySchemeHTTP, proxySchemeHTTPS, proxySchemeSOCKS5, proxySchemeSOCKS5H:
+		return true
+	}
+	return false
+}
+
+func validateProxyRule(rule ProxyRule) error {
+	var errs []error
+
+	if len(rule.Hosts) == 0 {
+		errs = append(errs, errors.New("at least one host pattern is required"))
+	}
+	for _, pattern := range rule.Hosts {
+		if pattern == "" {
+			errs = append(errs, errors.New("host pattern cannot be empty"))
+			continue
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			errs = append(errs, fmt.Errorf("invalid host pattern %q: %w", pattern, err))
+		}
+	}
+
+	if rule.Proxy != "" {
+		if _, err := parseProxyURL(rule.Proxy); err != nil {
+			errs = append(errs, fmt.Errorf("parsing proxy URL: %w", err))
+		} else if err := checkProxyScheme(rule.Proxy); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if rule.Credentials != nil {
+		if rule.Proxy == "" {
+			errs = append(errs, errors.New("credentials cannot be set without a proxy"))
+		}
+		if rule.Credentials.Username == "" {
+			errs = append(errs, errors.New("a username is required when proxy credentials are set"))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func proxyURLHasCredentials(s string) bool {
+	u, err := parseProxyURL(s)
+	if err != nil {
+		return false
+	}
+	return u.User != nil
+}
@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/diag"
+	"github.com/hashicorp/aws-sdk-go-base/v2/validation"
+)
+
+// rolesAnywhereMaxDuration is the maximum duration of an IAM Roles Anywhere session.
+//
+// See https://docs.aws.amazon.com/rolesanywhere/latest/userguide/authentication-create-session.html.
+const rolesAnywhereMaxDuration = 12 * time.Hour
+
+// IAMRolesAnywhere configures credentials from IAM Roles Anywhere, which exchanges an X.509 certificate
+// issued by a trust anchor's certificate authority for temporary credentials.
+// The credentials can be used as the source credentials for AssumeRole.
+type IAMRolesAnywhere struct {
+	// CertificateFile is a PEM file containing the end-entity certificate.
+	CertificateFile string
+	// CertificateChainFile is an optional PEM file containing the intermediate certificates.
+	CertificateChainFile string
+	// PrivateKeyFile is a PEM file containing the certificate's unencrypted RSA or EC private key.
+	PrivateKeyFile string
+
+	TrustAnchorARN string
+	ProfileARN     string
+	RoleARN        string
+	SessionName    string
+	Duration       time.Duration
+}
+
+// ValidateIAMRolesAnywhere validates the `IAMRolesAnywhere` configuration,
+// so that problems are reported before any calls to IAM Roles Anywhere.
+func (c Config) ValidateIAMRolesAnywhere(diags *diag.Diagnostics) {
+	ra := c.IAMRolesAnywhere
+	if ra == nil {
+		return
+	}
+
+	const summary = "Invalid IAM Roles Anywhere Configuration"
+
+	if c.AssumeRoleWithWebIdentity != nil {
+		*diags = diags.AddError(summary, "IAMRolesAnywhere and AssumeRoleWithWebIdentity cannot both be set")
+	}
+
+	for _, v := range []struct {
+		name, value string
+	}{
+		{"CertificateFile", ra.CertificateFile},
+		{"PrivateKeyFile", ra.PrivateKeyFile},
+		{"TrustAnchorARN", ra.TrustAnchorARN},
+		{"ProfileARN", ra.ProfileARN},
+		{"RoleARN", ra.RoleARN},
+	} {
+		if v.value == "" {
+			*diags = diags.AddError(summary, fmt.Sprintf("%s was not set", v.name))
+		}
+	}
+
+	if ra.CertificateFile != "" {
+		validateFile(diags, summary, "IAM Roles Anywhere certificate file", ra.CertificateFile, false)
+	}
+	if ra.CertificateChainFile != "" {
+		validateFile(diags, summary, "IAM Roles Anywhere certificate chain file", ra.CertificateChainFile, false)
+	}
+	if ra.PrivateKeyFile != "" {
+		validateFile(diags, summary, "IAM Roles Anywhere private key file", ra.PrivateKeyFile, false)
+	}
+
+	ps, err := c.Partitions()
+	if err != nil {
+		*diags = diags.AddSimpleError(err)
+		return
+	}
+	arnOpts := func(opts *validation.ARNOptions) {
+		opts.Partitions = ps
+	}
+
+	for _, v := range []struct {
+		name, value string
+	}{
+		{"Trust anchor ARN", ra.TrustAnchorARN},
+		{"Profile ARN", ra.ProfileARN},
+	} {
+		if v.value == "" {
+			continue
+		}
+		if err := validation.ARN(v.value, arnOpts); err != nil {
+			*diags = diags.AddError(summary, fmt.Sprintf("%s: %s", v.name, err))
+		}
+	}
+
+	validateRoleParameters(diags, "IAM Roles Anywhere", ra.RoleARN, ra.SessionName, ra.Duration, rolesAnywhereMaxDuration, "", nil, arnOpts)
+}
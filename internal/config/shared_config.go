@@ -0,0 +1,363 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/hashicorp/aws-sdk-go-base/v2/diag"
+	"github.com/hashicorp/aws-sdk-go-base/v2/internal/sharedconfig"
+)
+
+// SharedConfigInspection describes the profiles and SSO sessions in the shared config and credentials files.
+type SharedConfigInspection struct {
+	ConfigFiles      []string
+	CredentialsFiles []string
+	Profiles         []SharedConfigProfile
+	SSOSessions      []SharedConfigSSOSession
+}
+
+// SharedConfigProfile is a profile in the shared config and credentials files.
+type SharedConfigProfile struct {
+	Name string
+	// Locations are the sections defining the profile, in the order read.
+	Locations []sharedconfig.Location
+	// Keys are the names of the keys set in the profile. Values are omitted, as they may be secret.
+	Keys []string
+	// SourceProfileChain is the profile followed by each profile in its `source_profile` chain.
+	// It is empty if the profile does not have a `source_profile` or the chain is invalid.
+	SourceProfileChain []string
+	// SSOSession is the name of the profile's `sso_session`, if any.
+	SSOSession string
+}
+
+// SharedConfigSSOSession is an `sso-session` section in the shared config files.
+type SharedConfigSSOSession struct {
+	Name      string
+	Locations []sharedconfig.Location
+	Keys      []string
+}
+
+// knownProfileKeys are the profile keys used by the AWS SDKs and the AWS CLI.
+var knownProfileKeys = []string{
+	"account_id_endpoint_mode",
+	"api_versions",
+	"auth_scheme_preference",
+	"aws_access_key_id",
+	"aws_account_id",
+	"aws_secret_access_key",
+	"aws_security_token",
+	"aws_session_token",
+	"ca_bundle",
+	"cli_auto_prompt",
+	"cli_binary_format",
+	"cli_follow_urlparam",
+	"cli_history",
+	"cli_pager",
+	"cli_timestamp_format",
+	"credential_process",
+	"credential_source",
+	"defaults_mode",
+	"disable_request_compression",
+	"duration_seconds",
+	"ec2_metadata_service_endpoint",
+	"ec2_metadata_service_endpoint_mode",
+	"ec2_metadata_v1_disabled",
+	"endpoint_discovery_enabled",
+	"endpoint_url",
+	"external_id",
+	"ignore_configured_endpoint_urls",
+	"max_attempts",
+	"metadata_service_num_attempts",
+	"metadata_service_timeout",
+	"mfa_serial",
+	"output",
+	"parameter_validation",
+	"region",
+	"request_checksum_calculation",
+	"request_min_compression_size_bytes",
+	"response_checksum_validation",
+	"retry_mode",
+	"role_arn",
+	"role_session_name",
+	"s3",
+	"s3_disable_multiregion_access_points",
+	"s3_use_arn_region",
+	"sdk_ua_app_id",
+	"services",
+	"sigv4a_signing_region_set",
+	"source_profile",
+	"sso_account_id",
+	"sso_region",
+	"sso_registration_scopes",
+	"sso_role_name",
+	"sso_session",
+	"sso_start_url",
+	"sts_regional_endpoints",
+	"tcp_keepalive",
+	"use_dualstack_endpoint",
+	"use_fips_endpoint",
+	"web_identity_token_file",
+}
+
+// knownSSOSessionKeys are the `sso-session` keys used by the AWS SDKs and the AWS CLI.
+var knownSSOSessionKeys = []string{
+	"sso_region",
+	"sso_registration_scopes",
+	"sso_start_url",
+}
+
+// sharedConfigSection is a profile or SSO session merged from all of the files.
+type sharedConfigSection struct {
+	name      string
+	locations []sharedconfig.Location
+	keys      map[string]sharedconfig.Key
+}
+
+func (s *sharedConfigSection) value(key string) string {
+	return s.keys[key].Value
+}
+
+func (s *sharedConfigSection) has(key string) bool {
+	return s.value(key) != ""
+}
+
+func (s *sharedConfigSection) keyLocation(key string) sharedconfig.Location {
+	if k, ok := s.keys[key]; ok {
+		return k.Location
+	}
+	return s.locations[0]
+}
+
+// InspectSharedConfig lists the profiles and SSO sessions in the shared config and credentials files
+// and reports problems with them, such as `source_profile` cycles, missing source profiles, conflicting
+// credential sources, unknown keys, and duplicate sections. Each problem includes its file and line.
+// Files that do not exist are ignored.
+func (c Config) InspectSharedConfig() (SharedConfigInspection, diag.Diagnostics) {
+	var (
+		diags      diag.Diagnostics
+		inspection SharedConfigInspection
+	)
+
+	configFiles, err := c.ResolveSharedConfigFiles()
+	if err != nil {
+		return inspection, diags.AddSimpleError(err)
+	}
+	if len(configFiles) == 0 {
+		configFiles = []string{defaultSharedFile("AWS_CONFIG_FILE", config.DefaultSharedConfigFilename())}
+	}
+	credentialsFiles, err := c.ResolveSharedCredentialsFiles()
+	if err != nil {
+		return inspection, diags.AddSimpleError(err)
+	}
+	if len(credentialsFiles) == 0 {
+		credentialsFiles = []string{defaultSharedFile("AWS_SHARED_CREDENTIALS_FILE", config.DefaultSharedCredentialsFilename())}
+	}
+	inspection.ConfigFiles, inspection.CredentialsFiles = configFiles, credentialsFiles
+
+	profiles := make(map[string]*sharedConfigSection)
+	ssoSessions := make(map[string]*sharedConfigSection)
+
+	// Credentials files are read first, as keys in shared config files take precedence.
+	for _, f := range credentialsFiles {
+		for _, s := range parseSharedConfigFile(&diags, f) {
+			addSharedConfigSection(profiles, s.Name, s)
+		}
+	}
+	for _, f := range configFiles {
+		for _, s := range parseSharedConfigFile(&diags, f) {
+			kind, name, _ := strings.Cut(s.Name, " ")
+			switch {
+			case s.Name == "default":
+				addSharedConfigSection(profiles, s.Name, s)
+			case kind == "profile" && name != "":
+				addSharedConfigSection(profiles, name, s)
+			case kind == "sso-session" && name != "":
+				addSharedConfigSection(ssoSessions, name, s)
+			case kind == "services" && name != "":
+			default:
+				diags = diags.AddWarning(
+					"Ignored Shared Config Section",
+					fmt.Sprintf("%s: The section [%s] is ignored. Profiles other than %q must be named [profile %s] in shared config files.", s.Location, s.Name, "default", s.Name),
+				)
+			}
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(ssoSessions)) {
+		s := ssoSessions[name]
+		validateSharedConfigKeys(&diags, "sso-session", s, knownSSOSessionKeys)
+		inspection.SSOSessions = append(inspection.SSOSessions, SharedConfigSSOSession{
+			Name:      name,
+			Locations: s.locations,
+			Keys:      slices.Sorted(maps.Keys(s.keys)),
+		})
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(profiles)) {
+		p := profiles[name]
+		validateSharedConfigKeys(&diags, "profile", p, knownProfileKeys)
+		validateCredentialSources(&diags, p)
+
+		profile := SharedConfigProfile{
+			Name:       name,
+			Locations:  p.locations,
+			Keys:       slices.Sorted(maps.Keys(p.keys)),
+			SSOSession: p.value("sso_session"),
+		}
+
+		if session := profile.SSOSession; session != "" {
+			if _, ok := ssoSessions[session]; !ok {
+				diags = diags.AddError(
+					"Missing SSO Session",
+					fmt.Sprintf("%s: The profile %q refers to the sso-session %q, which does not exist.", p.keyLocation("sso_session"), name, session),
+				)
+			}
+		}
+
+		if p.has("source_profile") {
+			profile.SourceProfileChain = sourceProfileChain(&diags, profiles, p)
+		}
+
+		inspection.Profiles = append(inspection.Profiles, profile)
+	}
+
+	return inspection, diags
+}
+
+func defaultSharedFile(envVar, defaultFile string) string {
+	if v := os.Getenv(envVar); v != "" {
+		return v
+	}
+	return defaultFile
+}
+
+// parseSharedConfigFile parses the file, reporting duplicate sections.
+func parseSharedConfigFile(diags *diag.Diagnostics, filename string) []sharedconfig.Section {
+	sections, err := sharedconfig.ParseFile(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		*diags = diags.AddError("Invalid Shared Config File", err.Error())
+		return nil
+	}
+
+	seen := make(map[string]sharedconfig.Location)
+	for _, s := range sections {
+		if first, ok := seen[s.Name]; ok {
+			*diags = diags.AddWarning(
+				"Duplicate Shared Config Section",
+				fmt.Sprintf("%s: The section [%s] is also defined at line %d. The AWS SDKs merge the sections, so later keys override earlier ones.", s.Location, s.Name, first.Line),
+			)
+			continue
+		}
+		seen[s.Name] = s.Location
+	}
+
+	return sections
+}
+
+func addSharedConfigSection(sections map[string]*sharedConfigSection, name string, s sharedconfig.Section) {
+	section, ok := sections[name]
+	if !ok {
+		section = &sharedConfigSection{
+			name: name,
+			keys: make(map[string]sharedconfig.Key),
+		}
+		sections[name] = section
+	}
+
+	section.locations = append(section.locations, s.Location)
+	for _, k := range s.Keys {
+		section.keys[k.Name] = k
+	}
+}
+
+func validateSharedConfigKeys(diags *diag.Diagnostics, kind string, s *sharedConfigSection, known []string) {
+	for _, name := range slices.Sorted(maps.Keys(s.keys)) {
+		if !slices.Contains(known, name) {
+			*diags = diags.AddWarning(
+				"Unknown Shared Config Key",
+				fmt.Sprintf("%s: The key %q in %s %q is not a known shared config key.", s.keys[name].Location, name, kind, s.name),
+			)
+		}
+	}
+}
+
+// validateCredentialSources reports profiles that set more than one source of credentials.
+func validateCredentialSources(diags *diag.Diagnostics, p *sharedConfigSection) {
+	var sources []string
+
+	// A profile can use its own static credentials as the source profile for assuming a role.
+	if p.has("aws_access_key_id") && p.value("source_profile") != p.name {
+		sources = append(sources, "aws_access_key_id")
+	}
+	for _, key := range []string{"source_profile", "credential_source", "web_identity_token_file", "credential_process"} {
+		if p.has(key) {
+			sources = append(sources, key)
+		}
+	}
+	if p.has("sso_session") || p.has("sso_account_id") {
+		sources = append(sources, "sso")
+	}
+
+	if len(sources) > 1 {
+		*diags = diags.AddWarning(
+			"Conflicting Credential Sources",
+			fmt.Sprintf("%s: The profile %q sets more than one source of credentials: %s. Only one of them is used.", p.locations[0], p.name, strings.Join(sources, ", ")),
+		)
+	}
+}
+
+// sourceProfileChain follows the `source_profile` chain starting at the profile,
+// returning nil and reporting an error if a source profile is missing or the chain contains a cycle.
+func sourceProfileChain(diags *diag.Diagnostics, profiles map[string]*sharedConfigSection, p *sharedConfigSection) []string {
+	chain := []string{p.name}
+
+	for current := p; current.has("source_profile"); {
+		next := current.value("source_profile")
+
+		// A profile with static credentials can be its own source profile.
+		if next == current.name && current.has("aws_access_key_id") {
+			break
+		}
+
+		if i := slices.Index(chain, next); i >= 0 {
+			// Report each cycle once, from its first profile by name.
+			cycle := chain[i:]
+			if i == 0 && slices.Min(cycle) == p.name {
+				*diags = diags.AddError(
+					"Source Profile Cycle",
+					fmt.Sprintf("%s: The source_profile chain of profile %q contains a cycle: %s.", p.keyLocation("source_profile"), p.name, strings.Join(append(cycle, next), " -> ")),
+				)
+			}
+			return nil
+		}
+
+		source, ok := profiles[next]
+		if !ok {
+			// Report each missing source profile once, from the profile that names it.
+			if current == p {
+				*diags = diags.AddError(
+					"Missing Source Profile",
+					fmt.Sprintf("%s: The profile %q has the source_profile %q, which does not exist.", current.keyLocation("source_profile"), current.name, next),
+				)
+			}
+			return nil
+		}
+
+		chain = append(chain, next)
+		current = source
+	}
+
+	return chain
+}
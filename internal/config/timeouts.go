@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/diag"
+)
+
+// Timeouts limits the time spent resolving configuration and credentials.
+// A zero value means no limit.
+type Timeouts struct {
+	// Total limits the time spent resolving configuration and credentials in GetAwsConfig.
+	Total time.Duration
+
+	// SharedConfigLoad limits the time spent loading the shared configuration,
+	// including any Region lookup from EC2 instance metadata.
+	SharedConfigLoad time.Duration
+
+	// CredentialsRetrieval limits the time spent retrieving the initial credentials,
+	// for example from EC2 instance metadata, an SSO portal, a credential process, or IAM Roles Anywhere.
+	CredentialsRetrieval time.Duration
+
+	// AssumeRole limits the time spent on each assume role, including assume role with web identity.
+	AssumeRole time.Duration
+
+	// IdentityValidation limits the time spent validating credentials with STS GetCallerIdentity.
+	IdentityValidation time.Duration
+}
+
+// ValidateTimeouts validates that none of the timeouts are negative.
+func (c Config) ValidateTimeouts(diags *diag.Diagnostics) {
+	for _, v := range []struct {
+		name  string
+		value time.Duration
+	}{
+		{"total", c.Timeouts.Total},
+		{"shared config load", c.Timeouts.SharedConfigLoad},
+		{"credentials retrieval", c.Timeouts.CredentialsRetrieval},
+		{"assume role", c.Timeouts.AssumeRole},
+		{"identity validation", c.Timeouts.IdentityValidation},
+	} {
+		if v.value < 0 {
+			*diags = diags.AddError(
+				"Invalid Timeout",
+				fmt.Sprintf("The %s timeout must not be negative, got %s.", v.name, v.value),
+			)
+		}
+	}
+}
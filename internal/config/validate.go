@@ -0,0 +1,203 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/hashicorp/aws-sdk-go-base/v2/diag"
+	"github.com/hashicorp/aws-sdk-go-base/v2/internal/expand"
+	"github.com/hashicorp/aws-sdk-go-base/v2/validation"
+)
+
+// Validate validates the configuration without making any network calls,
+// returning all of the problems found so that they can be fixed at once.
+// `GetAwsConfig` only performs a subset of these checks, so callers opt in to the full set by calling Validate.
+// ctx is used when loading shared config profiles.
+func (c Config) Validate(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c.ValidateProxySettings(&diags)
+	c.ValidateHostOverrides(&diags)
+	c.ValidateEndpoints(&diags)
+	c.validateServiceEndpoints(&diags)
+	c.validateFiles(&diags)
+	c.validateEnums(&diags)
+	c.validateStaticCredentials(&diags)
+	c.validateAccountIDs(&diags)
+	c.validateRetries(&diags)
+	c.ValidateTimeouts(&diags)
+	c.ValidateAssumeRoles(&diags)
+	c.validateSessionPolicies(&diags)
+	c.ValidateIAMRolesAnywhere(&diags)
+	c.ValidateConflicts(ctx, &diags)
+
+	return diags
+}
+
+// validateServiceEndpoints validates the endpoints set for individual services.
+func (c Config) validateServiceEndpoints(diags *diag.Diagnostics) {
+	for _, v := range []struct {
+		name, value string
+	}{
+		{"EC2 metadata service endpoint", c.EC2MetadataServiceEndpoint},
+		{"IAM endpoint", c.IamEndpoint},
+		{"IAM Roles Anywhere endpoint", c.RolesAnywhereEndpoint},
+		{"SSO endpoint", c.SsoEndpoint},
+		{"STS endpoint", c.StsEndpoint},
+	} {
+		if v.value == "" {
+			continue
+		}
+		if err := validateEndpointURL(v.value); err != nil {
+			*diags = diags.AddError(
+				"Invalid Endpoint",
+				fmt.Sprintf("%s: %s", v.name, err),
+			)
+		}
+	}
+}
+
+// validateFiles validates that the files in the configuration exist and are readable.
+// Missing shared config and credentials files are ignored by the AWS SDK, so only produce warnings.
+func (c Config) validateFiles(diags *diag.Diagnostics) {
+	if c.CustomCABundle != "" {
+		validateFile(diags, "Invalid Custom CA Bundle", "custom CA bundle", c.CustomCABundle, false)
+	}
+	if c.PartitionsFile != "" {
+		validateFile(diags, "Invalid Partitions File", "partitions file", c.PartitionsFile, false)
+	}
+	if ar := c.AssumeRoleWithWebIdentity; ar != nil && ar.WebIdentityToken == "" && ar.WebIdentityTokenFile != "" {
+		validateFile(diags, "Invalid Web Identity Token File", "web identity token file", ar.WebIdentityTokenFile, false)
+	}
+	for _, f := range c.SharedConfigFiles {
+		validateFile(diags, "Invalid Shared Config File", "shared config file", f, true)
+	}
+	for _, f := range c.SharedCredentialsFiles {
+		validateFile(diags, "Invalid Shared Credentials File", "shared credentials file", f, true)
+	}
+}
+
+func validateFile(diags *diag.Diagnostics, summary, name, path string, optional bool) {
+	expanded, err := expand.FilePath(path)
+	if err != nil {
+		*diags = diags.AddError(summary, fmt.Sprintf("Expanding %s path %q: %s", name, path, err))
+		return
+	}
+
+	f, err := os.Open(expanded)
+	if errors.Is(err, fs.ErrNotExist) && optional {
+		*diags = diags.AddWarning(summary, fmt.Sprintf("The %s %q does not exist and will be ignored.", name, path))
+		return
+	}
+	if err != nil {
+		*diags = diags.AddError(summary, fmt.Sprintf("Unable to read %s: %s", name, err))
+		return
+	}
+	defer f.Close()
+
+	if fi, err := f.Stat(); err != nil {
+		*diags = diags.AddError(summary, fmt.Sprintf("Unable to read %s: %s", name, err))
+	} else if fi.IsDir() {
+		*diags = diags.AddError(summary, fmt.Sprintf("The %s %q is a directory.", name, path))
+	}
+}
+
+// validateEnums validates settings that have a fixed set of values.
+func (c Config) validateEnums(diags *diag.Diagnostics) {
+	if c.EC2MetadataServiceEndpointMode != "" {
+		var endpointMode imds.EndpointModeState
+		if err := endpointMode.SetFromString(c.EC2MetadataServiceEndpointMode); err != nil {
+			*diags = diags.AddError(
+				"Invalid EC2 Metadata Service Endpoint Mode",
+				fmt.Sprintf("%s. Valid values are %q and %q.", err, "IPv4", "IPv6"),
+			)
+		}
+	}
+
+	if !slices.Contains([]imds.ClientEnableState{imds.ClientDefaultEnableState, imds.ClientEnabled, imds.ClientDisabled}, c.EC2MetadataServiceEnableState) {
+		*diags = diags.AddError(
+			"Invalid EC2 Metadata Service Enable State",
+			fmt.Sprintf("Unknown EC2 metadata service enable state: %d", c.EC2MetadataServiceEnableState),
+		)
+	}
+
+	if c.RetryMode != "" {
+		if _, err := aws.ParseRetryMode(string(c.RetryMode)); err != nil {
+			*diags = diags.AddError(
+				"Invalid Retry Mode",
+				fmt.Sprintf("%s. Valid values are %q and %q.", err, aws.RetryModeStandard, aws.RetryModeAdaptive),
+			)
+		}
+	}
+
+	if c.HTTPProxyMode != HTTPProxyModeLegacy && c.HTTPProxyMode != HTTPProxyModeSeparate {
+		*diags = diags.AddError(
+			"Invalid HTTP Proxy Mode",
+			fmt.Sprintf("Unknown HTTP proxy mode: %d", c.HTTPProxyMode),
+		)
+	}
+}
+
+// validateStaticCredentials validates that static credentials include both an access key and a secret key.
+func (c Config) validateStaticCredentials(diags *diag.Diagnostics) {
+	if c.AccessKey == "" && c.SecretKey == "" && c.Token == "" {
+		return
+	}
+
+	if c.AccessKey == "" || c.SecretKey == "" {
+		*diags = diags.AddError(
+			"Incomplete Static Credentials",
+			"Both an access key and a secret key are required when an access key, secret key, or token is set.",
+		)
+	}
+}
+
+// validateAccountIDs validates the allowed and forbidden account IDs.
+func (c Config) validateAccountIDs(diags *diag.Diagnostics) {
+	if len(c.AllowedAccountIds) > 0 && len(c.ForbiddenAccountIds) > 0 {
+		*diags = diags.AddError(
+			"Conflicting Account ID Settings",
+			"Only one of allowed account IDs or forbidden account IDs can be set.",
+		)
+	}
+
+	for _, id := range slices.Concat(c.AllowedAccountIds, c.ForbiddenAccountIds) {
+		if err := validation.AccountID(id); err != nil {
+			*diags = diags.AddError(
+				"Invalid Account ID",
+				err.Error(),
+			)
+		}
+	}
+}
+
+// validateRetries validates the retry and rate limiting settings.
+func (c Config) validateRetries(diags *diag.Diagnostics) {
+	if c.MaxRetries < 0 {
+		*diags = diags.AddError(
+			"Invalid Max Retries",
+			fmt.Sprintf("Max retries must not be negative, got %d.", c.MaxRetries),
+		)
+	}
+	if c.MaxBackoff < 0 {
+		*diags = diags.AddError(
+			"Invalid Max Backoff",
+			fmt.Sprintf("Max backoff must not be negative, got %s.", c.MaxBackoff),
+		)
+	}
+	if c.TokenBucketRateLimiterCapacity < 0 {
+		*diags = diags.AddError(
+			"Invalid Token Bucket Rate Limiter Capacity",
+			fmt.Sprintf("Token bucket rate limiter capacity must not be negative, got %d.", c.TokenBucketRateLimiterCapacity),
+		)
+	}
+}
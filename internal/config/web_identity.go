@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// DefaultWebIdentityTokenCommandTimeout is the time a web identity token command can run if no timeout is set.
+const DefaultWebIdentityTokenCommandTimeout = 1 * time.Minute
+
+// webIdentityTokenCommandWaitDelay is the time to wait for a timed out command's output to be closed,
+// for example by child processes that are not killed with the command.
+const webIdentityTokenCommandWaitDelay = 1 * time.Second
+
+// WebIdentityTokenCommand is a command that writes a web identity token to standard output.
+type WebIdentityTokenCommand struct {
+	// Command is the program to run, followed by its arguments.
+	Command []string
+	// Env contains additional environment variables, in "key=value" form.
+	// The command also inherits the environment of the current process.
+	Env []string
+	// Timeout limits the time the command can run. Defaults to DefaultWebIdentityTokenCommandTimeout.
+	Timeout time.Duration
+}
+
+// token runs the command and returns its standard output, with leading and trailing whitespace removed.
+// The command is killed if it times out or ctx is done.
+func (c WebIdentityTokenCommand) token(ctx context.Context) ([]byte, error) {
+	if len(c.Command) == 0 {
+		return nil, errors.New("running web identity token command: no command")
+	}
+
+	timeout := c.Timeout
+	if timeout == 0 {
+		timeout = DefaultWebIdentityTokenCommandTimeout
+	}
+	cmdCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(cmdCtx, c.Command[0], c.Command[1:]...)
+	cmd.Env = append(os.Environ(), c.Env...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	cmd.WaitDelay = webIdentityTokenCommandWaitDelay
+
+	if err := cmd.Run(); err != nil {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("running web identity token command %q: %w", c.Command[0], err)
+		}
+		if cmdCtx.Err() != nil {
+			err = fmt.Errorf("timed out after %s", timeout)
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			err = fmt.Errorf("%w: %s", err, msg)
+		}
+		return nil, fmt.Errorf("running web identity token command %q: %w", c.Command[0], err)
+	}
+
+	token := bytes.TrimSpace(stdout.Bytes())
+	if len(token) == 0 {
+		return nil, fmt.Errorf("running web identity token command %q: empty token", c.Command[0])
+	}
+
+	return token, nil
+}
@@ -0,0 +1,229 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// CIProvider is a CI system that issues OpenID Connect (OIDC) tokens to its jobs.
+type CIProvider string
+
+const (
+	CIProviderGitHubActions  CIProvider = "github_actions"
+	CIProviderGitLab         CIProvider = "gitlab"
+	CIProviderTerraformCloud CIProvider = "terraform_cloud"
+)
+
+const (
+	// DefaultGitHubActionsAudience is the audience requested for GitHub Actions tokens if no audience is set.
+	DefaultGitHubActionsAudience = "sts.amazonaws.com"
+
+	// DefaultGitLabIDTokenEnvVar is the environment variable containing GitLab ID tokens if no environment variable is set.
+	DefaultGitLabIDTokenEnvVar = "CI_JOB_JWT_V2"
+
+	// DefaultTerraformCloudWorkloadIdentityTokenEnvVar is the environment variable containing
+	// Terraform Cloud workload identity tokens if no environment variable is set.
+	DefaultTerraformCloudWorkloadIdentityTokenEnvVar = "TFC_WORKLOAD_IDENTITY_TOKEN"
+
+	// gitHubActionsTokenRequestTimeout is the maximum time allowed for requesting a GitHub Actions token.
+	gitHubActionsTokenRequestTimeout = 30 * time.Second
+)
+
+// WebIdentityTokenCI gets a web identity token from the CI system running the current process.
+type WebIdentityTokenCI struct {
+	// Provider is the CI system. If empty, the CI system is detected from the environment.
+	Provider CIProvider
+
+	// Audience is the audience of the token.
+	// GitHub Actions tokens are requested with the audience, which defaults to DefaultGitHubActionsAudience.
+	// GitLab and Terraform Cloud tokens are issued with the audience configured in the pipeline or workspace;
+	// if set, the audience of the token must match.
+	Audience string
+
+	// EnvVar is the environment variable containing the token for GitLab and Terraform Cloud.
+	// Defaults to DefaultGitLabIDTokenEnvVar and DefaultTerraformCloudWorkloadIdentityTokenEnvVar.
+	EnvVar string
+}
+
+// DetectCIProvider returns the CI system running the current process, if any, based on its environment variables.
+func DetectCIProvider() (CIProvider, bool) {
+	switch {
+	case os.Getenv("GITHUB_ACTIONS") == "true":
+		return CIProviderGitHubActions, true
+	case os.Getenv("GITLAB_CI") == "true":
+		return CIProviderGitLab, true
+	case os.Getenv("TFC_RUN_ID") != "" || os.Getenv(DefaultTerraformCloudWorkloadIdentityTokenEnvVar) != "":
+		return CIProviderTerraformCloud, true
+	}
+	return "", false
+}
+
+func (c WebIdentityTokenCI) provider() (CIProvider, error) {
+	if c.Provider != "" {
+		return c.Provider, nil
+	}
+	if p, ok := DetectCIProvider(); ok {
+		return p, nil
+	}
+	return "", errors.New("no supported CI system detected")
+}
+
+// token gets a token from the CI system. Tokens are requested or read each time, so that refreshed credentials use a fresh token.
+// Requests are made using httpClient, or a default client if nil.
+func (c WebIdentityTokenCI) token(ctx context.Context, httpClient aws.HTTPClient) ([]byte, error) {
+	provider, err := c.provider()
+	if err != nil {
+		return nil, fmt.Errorf("getting CI web identity token: %w", err)
+	}
+
+	var token string
+	switch provider {
+	case CIProviderGitHubActions:
+		token, err = c.gitHubActionsToken(ctx, httpClient)
+	case CIProviderGitLab:
+		token, err = c.envVarToken(DefaultGitLabIDTokenEnvVar)
+	case CIProviderTerraformCloud:
+		token, err = c.envVarToken(DefaultTerraformCloudWorkloadIdentityTokenEnvVar)
+	default:
+		err = fmt.Errorf("unsupported CI system %q", provider)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting %s web identity token: %w", provider, err)
+	}
+
+	return []byte(token), nil
+}
+
+// gitHubActionsToken requests a token from the GitHub Actions OIDC provider.
+// The workflow must have the `id-token: write` permission.
+//
+// See https://docs.github.com/en/actions/security-for-github-actions/security-hardening-your-deployments/about-security-hardening-with-openid-connect.
+func (c WebIdentityTokenCI) gitHubActionsToken(ctx context.Context, httpClient aws.HTTPClient) (string, error) {
+	requestURL, requestToken := os.Getenv("ACTIONS_ID_TOKEN_REQUEST_URL"), os.Getenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN")
+	if requestURL == "" || requestToken == "" {
+		return "", errors.New("ACTIONS_ID_TOKEN_REQUEST_URL and ACTIONS_ID_TOKEN_REQUEST_TOKEN are not set; the workflow must have the `id-token: write` permission")
+	}
+
+	u, err := url.Parse(requestURL)
+	if err != nil {
+		return "", fmt.Errorf("parsing ACTIONS_ID_TOKEN_REQUEST_URL: %w", err)
+	}
+	audience := c.Audience
+	if audience == "" {
+		audience = DefaultGitHubActionsAudience
+	}
+	query := u.Query()
+	query.Set("audience", audience)
+	u.RawQuery = query.Encode()
+
+	ctx, cancel := context.WithTimeout(ctx, gitHubActionsTokenRequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "bearer "+requestToken)
+	req.Header.Set("Accept", "application/json")
+
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("reading response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	var output struct {
+		Value string `json:"value"`
+	}
+	if err := json.Unmarshal(body, &output); err != nil {
+		return "", fmt.Errorf("decoding response: %w", err)
+	}
+	if output.Value == "" {
+		return "", errors.New("empty token")
+	}
+
+	return output.Value, nil
+}
+
+// envVarToken reads a token from the configured environment variable and checks its audience.
+func (c WebIdentityTokenCI) envVarToken(defaultEnvVar string) (string, error) {
+	envVar := c.EnvVar
+	if envVar == "" {
+		envVar = defaultEnvVar
+	}
+
+	token := strings.TrimSpace(os.Getenv(envVar))
+	if token == "" {
+		return "", fmt.Errorf("%s is not set", envVar)
+	}
+
+	if c.Audience != "" {
+		audiences, err := jwtAudiences(token)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", envVar, err)
+		}
+		if !slices.Contains(audiences, c.Audience) {
+			return "", fmt.Errorf("%s: token audience %q does not include %q", envVar, strings.Join(audiences, ", "), c.Audience)
+		}
+	}
+
+	return token, nil
+}
+
+// jwtAudiences returns the `aud` claim of a JSON Web Token. The token's signature is not verified.
+func jwtAudiences(token string) ([]string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 { //nolint:mnd
+		return nil, errors.New("token is not a JSON Web Token")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("decoding token payload: %w", err)
+	}
+
+	var claims struct {
+		Audience json.RawMessage `json:"aud"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("decoding token claims: %w", err)
+	}
+
+	// The `aud` claim is either a single string or an array of strings.
+	var audience string
+	if err := json.Unmarshal(claims.Audience, &audience); err == nil {
+		return []string{audience}, nil
+	}
+	var audiences []string
+	if err := json.Unmarshal(claims.Audience, &audiences); err != nil {
+		return nil, errors.New("token has no audience")
+	}
+
+	return audiences, nil
+}
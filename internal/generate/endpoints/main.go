@@ -15,20 +15,30 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/internal/generate/common"
+	"github.com/hashicorp/aws-sdk-go-base/v2/internal/generate/endpoints/report"
 	"github.com/hashicorp/aws-sdk-go-base/v2/internal/slices"
 )
 
 type PartitionDatum struct {
-	ID          string
-	Name        string
-	DNSSuffix   string
-	RegionRegex string
-	Regions     []RegionDatum
-	Services    []ServiceDatum
+	ID                     string
+	Name                   string
+	DNSSuffix              string
+	DualStackDNSSuffix     string
+	FIPSDNSSuffix          string
+	FIPSDualStackDNSSuffix string
+	ImplicitGlobalRegion   string
+	SupportsFIPS           bool
+	SupportsDualStack      bool
+	RegionRegex            string
+	Defaults               EndpointDatum
+	Regions                []RegionDatum
+	Services               []ServiceDatum
 }
 
 type RegionDatum struct {
@@ -37,51 +47,165 @@ type RegionDatum struct {
 }
 
 type ServiceDatum struct {
-	ID string
+	ID                string
+	Defaults          EndpointDatum
+	PartitionEndpoint string
+	IsGlobal          bool
+	Regions           []string
+	Endpoints         []EndpointDatum
+}
+
+// EndpointDatum holds the attributes of a service endpoint, or the defaults for a service or partition.
+// Only attributes which differ from the inherited defaults are set.
+type EndpointDatum struct {
+	Region                 string
+	Hostname               string
+	DNSSuffix              string
+	CredentialScopeRegion  string
+	CredentialScopeService string
+	Variants               []VariantDatum
+	Deprecated             bool
+}
+
+func (e EndpointDatum) IsEmpty() bool {
+	return e.Hostname == "" && e.DNSSuffix == "" && e.CredentialScopeRegion == "" && e.CredentialScopeService == "" && len(e.Variants) == 0 && !e.Deprecated
+}
+
+func (e EndpointDatum) variant(tags string) (VariantDatum, bool) {
+	for _, v := range e.Variants {
+		if v.Tags == tags {
+			return v, true
+		}
+	}
+	return VariantDatum{}, false
+}
+
+type VariantDatum struct {
+	// Tags is the sorted, "|"-separated list of variant tags, e.g. "dualstack|fips".
+	Tags      string
+	Hostname  string
+	DNSSuffix string
+}
+
+// partitionOutputs holds the partition-level attributes from partitions.json.
+type partitionOutputs struct {
+	DualStackDNSSuffix   string
+	ImplicitGlobalRegion string
+	SupportsFIPS         bool
+	SupportsDualStack    bool
 }
 
 type TemplateData struct {
-	Partitions []PartitionDatum
+	Partitions    []PartitionDatum
+	ServiceIDs    []string
+	SDKServiceIDs []string
 }
 
 func usage() {
 	fmt.Fprintf(os.Stderr, "Usage:\n")
-	fmt.Fprintf(os.Stderr, "\tmain.go <aws-sdk-go-v2-endpoints-json-url>\n\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] <aws-sdk-go-v2-endpoints-json> <aws-sdk-go-v2-partitions-json> <aws-sdk-go-v2-endpoint-prefix-json>\n\n")
+	fmt.Fprintf(os.Stderr, "Each input is either an HTTP(S) URL or a local file path.\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
 }
 
+var reportFile = flag.String("report", "", "write the change report to this file instead of standard output")
+
 func main() {
 	flag.Usage = usage
 	flag.Parse()
 
 	args := flag.Args()
 
-	if len(args) < 1 {
+	if len(args) < 3 {
 		flag.Usage()
 		os.Exit(2)
 	}
 
-	inputURL := args[0]
+	input := args[0]
+	partitionsInput := args[1]
+	endpointPrefixInput := args[2]
 	filename := `endpoints_gen.go`
 	target := map[string]any{}
+	partitionsMetadata := map[string]any{}
+	endpointPrefixes := map[string]any{}
 
 	g := common.NewGenerator()
 	g.Infof("Generating endpoints/%s", filename)
 
-	if err := readHTTPJSON(inputURL, &target); err != nil {
-		g.Fatalf("error reading JSON from %s: %s", inputURL, err)
+	if err := readJSON(input, &target); err != nil {
+		g.Fatalf("error reading JSON from %s: %s", input, err)
+	}
+	if err := readJSON(partitionsInput, &partitionsMetadata); err != nil {
+		g.Fatalf("error reading JSON from %s: %s", partitionsInput, err)
+	}
+	if err := readJSON(endpointPrefixInput, &endpointPrefixes); err != nil {
+		g.Fatalf("error reading JSON from %s: %s", endpointPrefixInput, err)
+	}
+
+	/*
+		See https://github.com/aws/aws-sdk-go-v2/blob/main/internal/endpoints/awsrulesfn/partitions.json.
+		e.g.
+		{
+		  "partitions": [{
+		    "id": "aws",
+		    "outputs": {
+		      "dnsSuffix": "amazonaws.com",
+		      "dualStackDnsSuffix": "api.aws",
+		      "implicitGlobalRegion": "us-east-1",
+		      "name": "aws",
+		      "supportsDualStack": true,
+		      "supportsFIPS": true
+		    },
+		    ...
+		  }, ...]
+		}
+	*/
+	partitionsOutputs := map[string]partitionOutputs{}
+	if partitions, ok := partitionsMetadata["partitions"].([]any); ok {
+		for _, partition := range partitions {
+			if partition, ok := partition.(map[string]any); ok {
+				id, _ := partition["id"].(string)
+				if outputs, ok := partition["outputs"].(map[string]any); ok {
+					partitionsOutputs[id] = parsePartitionOutputs(outputs)
+				}
+			}
+		}
 	}
 
 	td := TemplateData{}
+	regionIDs := map[string]bool{}
 	templateFuncMap := template.FuncMap{
 		// IDToTitle splits a '-' or '.' separated string and returns a string with each part title cased.
 		"IDToTitle": func(s string) (string, error) {
-			parts := strings.Split(s, "-")
-			if len(parts) == 1 {
-				parts = strings.Split(s, ".")
+			return idToTitle(s), nil
+		},
+		// RegionIDExpr returns the Region ID constant for known Regions and a quoted string otherwise.
+		"RegionIDExpr": func(s string) string {
+			if regionIDs[s] {
+				return idToTitle(s) + "RegionID"
+			}
+			if s == "aws-global" {
+				return "AwsGlobalRegionID"
 			}
-			return strings.Join(slices.ApplyToAll(parts, func(s string) string {
-				return common.FirstUpper(s)
-			}), ""), nil
+			return strconv.Quote(s)
+		},
+		// ServiceIDConst returns the name of the constant for a service ID.
+		"ServiceIDConst": serviceIDConst,
+		// VariantExpr returns the endpoint variant expression for a "|"-separated list of variant tags.
+		"VariantExpr": func(s string) (string, error) {
+			var flags []string
+			for _, tag := range strings.Split(s, "|") {
+				switch tag {
+				case "dualstack":
+					flags = append(flags, "DualStackVariant")
+				case "fips":
+					flags = append(flags, "FIPSVariant")
+				default:
+					return "", fmt.Errorf("unsupported endpoint variant tag: %q", tag)
+				}
+			}
+			return strings.Join(flags, " | "), nil
 		},
 	}
 
@@ -136,6 +260,28 @@ func main() {
 				if regionRegex, ok := partition["regionRegex"].(string); ok {
 					partitionDatum.RegionRegex = regionRegex
 				}
+				if defaults, ok := partition["defaults"].(map[string]any); ok {
+					partitionDatum.Defaults = parseEndpoint(defaults)
+				}
+				// Partition-level variant support comes from partitions.json.
+				// The FIPS DNS suffixes, which partitions.json doesn't include, come from the endpoints.json defaults.
+				outputs := partitionsOutputs[partitionDatum.ID]
+				partitionDatum.SupportsFIPS = outputs.SupportsFIPS
+				partitionDatum.SupportsDualStack = outputs.SupportsDualStack
+				partitionDatum.DualStackDNSSuffix = outputs.DualStackDNSSuffix
+				partitionDatum.ImplicitGlobalRegion = outputs.ImplicitGlobalRegion
+				if partitionDatum.SupportsFIPS {
+					partitionDatum.FIPSDNSSuffix = partitionDatum.DNSSuffix
+					if v, ok := partitionDatum.Defaults.variant("fips"); ok {
+						partitionDatum.FIPSDNSSuffix = firstNonEmpty(v.DNSSuffix, partitionDatum.DNSSuffix)
+					}
+				}
+				if partitionDatum.SupportsFIPS && partitionDatum.SupportsDualStack {
+					partitionDatum.FIPSDualStackDNSSuffix = partitionDatum.DualStackDNSSuffix
+					if v, ok := partitionDatum.Defaults.variant("dualstack|fips"); ok {
+						partitionDatum.FIPSDualStackDNSSuffix = firstNonEmpty(v.DNSSuffix, partitionDatum.DualStackDNSSuffix)
+					}
+				}
 				if regions, ok := partition["regions"].(map[string]any); ok {
 					for id, region := range regions {
 						regionDatum := RegionDatum{
@@ -152,11 +298,15 @@ func main() {
 					}
 				}
 				if services, ok := partition["services"].(map[string]any); ok {
-					for id := range services {
+					for id, service := range services {
 						serviceDatum := ServiceDatum{
 							ID: id,
 						}
 
+						if service, ok := service.(map[string]any); ok {
+							serviceDatum = parseService(partitionDatum, id, service)
+						}
+
 						partitionDatum.Services = append(partitionDatum.Services, serviceDatum)
 					}
 				}
@@ -182,6 +332,49 @@ func main() {
 		})
 	}
 
+	for _, partition := range td.Partitions {
+		for _, region := range partition.Regions {
+			regionIDs[region.ID] = true
+		}
+	}
+
+	serviceIDConsts := map[string]string{}
+	for _, partition := range td.Partitions {
+		for _, service := range partition.Services {
+			name := serviceIDConst(service.ID)
+			if id, ok := serviceIDConsts[name]; ok {
+				if id != service.ID {
+					g.Fatalf("service IDs %q and %q have the same constant name: %s", id, service.ID, name)
+				}
+				continue
+			}
+			serviceIDConsts[name] = service.ID
+			td.ServiceIDs = append(td.ServiceIDs, service.ID)
+		}
+	}
+	sort.Strings(td.ServiceIDs)
+
+	/*
+		See https://github.com/aws/aws-sdk-go-v2/blob/main/codegen/smithy-aws-go-codegen/src/main/resources/software/amazon/smithy/aws/go/codegen/endpoint-prefix.json.
+		e.g.
+		{
+		  "ACM PCA": "acm-pca",
+		  "API Gateway": "apigateway",
+		  ...
+		}
+	*/
+	for sdkID := range endpointPrefixes {
+		td.SDKServiceIDs = append(td.SDKServiceIDs, sdkID)
+	}
+	sort.Slice(td.SDKServiceIDs, func(i, j int) bool {
+		return strings.ToLower(td.SDKServiceIDs[i]) < strings.ToLower(td.SDKServiceIDs[j])
+	})
+
+	previous, err := report.FromFile(filename)
+	if err != nil {
+		g.Fatalf("error reading current endpoints (%s): %s", filename, err)
+	}
+
 	d := g.NewGoFileDestination(filename)
 
 	if err := d.WriteTemplate("endpoints", tmpl, td, templateFuncMap); err != nil {
@@ -191,6 +384,260 @@ func main() {
 	if err := d.Write(); err != nil {
 		g.Fatalf("generating file (%s): %s", filename, err)
 	}
+
+	changes := report.Changes(previous, snapshotFromTemplateData(td))
+	if *reportFile != "" {
+		if err := os.WriteFile(*reportFile, []byte(changes), 0644); err != nil { //nolint:mnd // good protection for new files
+			g.Fatalf("writing change report (%s): %s", *reportFile, err)
+		}
+	} else {
+		fmt.Fprint(os.Stdout, changes)
+	}
+}
+
+func snapshotFromTemplateData(td TemplateData) report.Snapshot {
+	s := make(report.Snapshot, len(td.Partitions))
+
+	for _, partition := range td.Partitions {
+		p := report.Partition{
+			Name:     partition.Name,
+			Regions:  make(map[string]string, len(partition.Regions)),
+			Services: make(map[string]struct{}, len(partition.Services)),
+		}
+		for _, region := range partition.Regions {
+			p.Regions[region.ID] = region.Description
+		}
+		for _, service := range partition.Services {
+			p.Services[service.ID] = struct{}{}
+		}
+		s[partition.ID] = p
+	}
+
+	return s
+}
+
+func parseService(partition PartitionDatum, id string, service map[string]any) ServiceDatum {
+	serviceDatum := ServiceDatum{
+		ID: id,
+	}
+
+	if defaults, ok := service["defaults"].(map[string]any); ok {
+		serviceDatum.Defaults = parseEndpoint(defaults)
+	}
+	if partitionEndpoint, ok := service["partitionEndpoint"].(string); ok {
+		serviceDatum.PartitionEndpoint = partitionEndpoint
+	}
+	if isRegionalized, ok := service["isRegionalized"].(bool); ok && !isRegionalized && serviceDatum.PartitionEndpoint != "" {
+		serviceDatum.IsGlobal = true
+	}
+
+	regionRegex := regexp.MustCompile(partition.RegionRegex)
+	isRegion := func(id string) bool {
+		return regionRegex.MatchString(id) || len(slices.Filter(partition.Regions, func(r RegionDatum) bool {
+			return r.ID == id
+		})) > 0
+	}
+
+	if endpoints, ok := service["endpoints"].(map[string]any); ok {
+		for region, endpoint := range endpoints {
+			endpointDatum := EndpointDatum{}
+			if endpoint, ok := endpoint.(map[string]any); ok {
+				endpointDatum = parseEndpoint(endpoint)
+			}
+			endpointDatum.Region = region
+			endpointDatum = compactEndpoint(partition, serviceDatum, endpointDatum)
+
+			// Pseudo-Regions, such as "aws-global", are always kept as endpoints so that they resolve
+			// but are not included in the service's Regions.
+			pseudoRegion := !isRegion(region)
+			if !endpointDatum.Deprecated && !pseudoRegion {
+				serviceDatum.Regions = append(serviceDatum.Regions, region)
+			}
+			if !endpointDatum.IsEmpty() || pseudoRegion {
+				serviceDatum.Endpoints = append(serviceDatum.Endpoints, endpointDatum)
+			}
+		}
+	}
+
+	sort.Strings(serviceDatum.Regions)
+	sort.SliceStable(serviceDatum.Endpoints, func(i, j int) bool {
+		return serviceDatum.Endpoints[i].Region < serviceDatum.Endpoints[j].Region
+	})
+
+	return serviceDatum
+}
+
+// parsePartitionOutputs parses the "outputs" of a partitions.json partition.
+func parsePartitionOutputs(outputs map[string]any) partitionOutputs {
+	partitionOutputs := partitionOutputs{}
+
+	if dualStackDNSSuffix, ok := outputs["dualStackDnsSuffix"].(string); ok {
+		partitionOutputs.DualStackDNSSuffix = dualStackDNSSuffix
+	}
+	if implicitGlobalRegion, ok := outputs["implicitGlobalRegion"].(string); ok {
+		partitionOutputs.ImplicitGlobalRegion = implicitGlobalRegion
+	}
+	if supportsFIPS, ok := outputs["supportsFIPS"].(bool); ok {
+		partitionOutputs.SupportsFIPS = supportsFIPS
+	}
+	if supportsDualStack, ok := outputs["supportsDualStack"].(bool); ok {
+		partitionOutputs.SupportsDualStack = supportsDualStack
+	}
+
+	return partitionOutputs
+}
+
+/*
+parseEndpoint parses endpoint attributes.
+e.g.
+
+	{
+	  "credentialScope" : {
+	    "region" : "us-east-1"
+	  },
+	  "hostname" : "iam.amazonaws.com",
+	  "variants" : [ {
+	    "hostname" : "iam-fips.amazonaws.com",
+	    "tags" : [ "fips" ]
+	  } ]
+	}
+*/
+func parseEndpoint(endpoint map[string]any) EndpointDatum {
+	endpointDatum := EndpointDatum{}
+
+	if hostname, ok := endpoint["hostname"].(string); ok {
+		endpointDatum.Hostname = hostname
+	}
+	if dnsSuffix, ok := endpoint["dnsSuffix"].(string); ok {
+		endpointDatum.DNSSuffix = dnsSuffix
+	}
+	if credentialScope, ok := endpoint["credentialScope"].(map[string]any); ok {
+		if region, ok := credentialScope["region"].(string); ok {
+			endpointDatum.CredentialScopeRegion = region
+		}
+		if service, ok := credentialScope["service"].(string); ok {
+			endpointDatum.CredentialScopeService = service
+		}
+	}
+	if deprecated, ok := endpoint["deprecated"].(bool); ok {
+		endpointDatum.Deprecated = deprecated
+	}
+	if variants, ok := endpoint["variants"].([]any); ok {
+		for _, variant := range variants {
+			if variant, ok := variant.(map[string]any); ok {
+				variantDatum := VariantDatum{}
+
+				if hostname, ok := variant["hostname"].(string); ok {
+					variantDatum.Hostname = hostname
+				}
+				if dnsSuffix, ok := variant["dnsSuffix"].(string); ok {
+					variantDatum.DNSSuffix = dnsSuffix
+				}
+				if tags, ok := variant["tags"].([]any); ok {
+					ts := slices.ApplyToAll(tags, func(v any) string {
+						s, _ := v.(string)
+						return s
+					})
+					sort.Strings(ts)
+					variantDatum.Tags = strings.Join(ts, "|")
+				}
+
+				endpointDatum.Variants = append(endpointDatum.Variants, variantDatum)
+			}
+		}
+		sort.SliceStable(endpointDatum.Variants, func(i, j int) bool {
+			return endpointDatum.Variants[i].Tags < endpointDatum.Variants[j].Tags
+		})
+	}
+
+	return endpointDatum
+}
+
+// compactEndpoint removes endpoint attributes which resolve to the same values as the service and partition defaults.
+func compactEndpoint(partition PartitionDatum, service ServiceDatum, endpoint EndpointDatum) EndpointDatum {
+	dnsSuffix := firstNonEmpty(endpoint.DNSSuffix, service.Defaults.DNSSuffix, partition.DNSSuffix)
+	defaultHostname := expandHostname(firstNonEmpty(service.Defaults.Hostname, partition.Defaults.Hostname), service.ID, endpoint.Region, dnsSuffix)
+	if expandHostname(endpoint.Hostname, service.ID, endpoint.Region, dnsSuffix) == defaultHostname {
+		endpoint.Hostname = ""
+	}
+	if endpoint.DNSSuffix == firstNonEmpty(service.Defaults.DNSSuffix, partition.DNSSuffix) {
+		endpoint.DNSSuffix = ""
+	}
+	if endpoint.CredentialScopeRegion == endpoint.Region && service.Defaults.CredentialScopeRegion == "" {
+		endpoint.CredentialScopeRegion = ""
+	}
+
+	variants := endpoint.Variants
+	endpoint.Variants = nil
+	for _, v := range variants {
+		sv, sok := service.Defaults.variant(v.Tags)
+		pv, pok := partition.Defaults.variant(v.Tags)
+		if sok || pok {
+			variantDNSSuffix := firstNonEmpty(v.DNSSuffix, sv.DNSSuffix, pv.DNSSuffix, dnsSuffix)
+			defaultDNSSuffix := firstNonEmpty(sv.DNSSuffix, pv.DNSSuffix, dnsSuffix)
+			if expandHostname(firstNonEmpty(v.Hostname, sv.Hostname, pv.Hostname), service.ID, endpoint.Region, variantDNSSuffix) ==
+				expandHostname(firstNonEmpty(sv.Hostname, pv.Hostname), service.ID, endpoint.Region, defaultDNSSuffix) {
+				continue
+			}
+		}
+		endpoint.Variants = append(endpoint.Variants, v)
+	}
+
+	return endpoint
+}
+
+func expandHostname(template, service, region, dnsSuffix string) string {
+	return strings.NewReplacer(
+		"{service}", service,
+		"{region}", region,
+		"{dnsSuffix}", dnsSuffix,
+	).Replace(template)
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// serviceIDConst returns the name of the constant for a service ID.
+// The ID is split on any non-alphanumeric character, e.g. "api.ecr-public" is "ApiEcrPublicServiceID".
+func serviceIDConst(s string) string {
+	parts := nonAlphanumericRegex.Split(s, -1)
+	return strings.Join(slices.ApplyToAll(parts, func(s string) string {
+		return common.FirstUpper(s)
+	}), "") + "ServiceID"
+}
+
+var nonAlphanumericRegex = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+// idToTitle splits a '-' or '.' separated string and returns a string with each part title cased.
+func idToTitle(s string) string {
+	parts := strings.Split(s, "-")
+	if len(parts) == 1 {
+		parts = strings.Split(s, ".")
+	}
+	return strings.Join(slices.ApplyToAll(parts, func(s string) string {
+		return common.FirstUpper(s)
+	}), "")
+}
+
+// readJSON decodes JSON from an HTTP(S) URL or a local file.
+func readJSON(source string, to any) error {
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		return readHTTPJSON(source, to)
+	}
+
+	f, err := os.Open(strings.TrimPrefix(source, "file://"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return decodeFromReader(f, to)
 }
 
 func readHTTPJSON(url string, to any) error {
@@ -0,0 +1,239 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package report describes the changes between generated endpoints.
+package report
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Snapshot is the set of partitions, Regions, and services in generated endpoints, indexed by partition ID.
+type Snapshot map[string]Partition
+
+// Partition is the name, Regions, and services of a partition.
+type Partition struct {
+	Name     string
+	Regions  map[string]string // Region ID => description.
+	Services map[string]struct{}
+}
+
+// FromFile parses a previously generated endpoints file.
+// A missing file returns an empty snapshot.
+func FromFile(filename string) (Snapshot, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), filename, nil, 0)
+	if errors.Is(err, fs.ErrNotExist) {
+		return Snapshot{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// String constants, such as partition and Region IDs.
+	consts := make(map[string]string)
+	var partitions *ast.CompositeLit
+	for _, decl := range f.Decls {
+		decl, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range decl.Specs {
+			spec, ok := spec.(*ast.ValueSpec)
+			if !ok || len(spec.Names) != 1 || len(spec.Values) != 1 {
+				continue
+			}
+			switch decl.Tok {
+			case token.CONST:
+				if v, ok := stringLiteral(spec.Values[0]); ok {
+					consts[spec.Names[0].Name] = v
+				}
+			case token.VAR:
+				if spec.Names[0].Name == "partitions" {
+					partitions, _ = spec.Values[0].(*ast.CompositeLit)
+				}
+			}
+		}
+	}
+
+	if partitions == nil {
+		return nil, fmt.Errorf("partitions not found")
+	}
+
+	// exprValue returns the value of a string literal or string constant.
+	exprValue := func(expr ast.Expr) string {
+		if v, ok := stringLiteral(expr); ok {
+			return v
+		}
+		if ident, ok := expr.(*ast.Ident); ok {
+			if v, ok := consts[ident.Name]; ok {
+				return v
+			}
+			return ident.Name
+		}
+		return ""
+	}
+
+	s := make(Snapshot)
+	for _, elt := range partitions.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+
+		ps := Partition{
+			Regions:  make(map[string]string),
+			Services: make(map[string]struct{}),
+		}
+		for field, value := range compositeLitFields(kv.Value) {
+			switch field {
+			case "name":
+				ps.Name = exprValue(value)
+			case "regions":
+				for _, elt := range compositeLitElts(value) {
+					if kv, ok := elt.(*ast.KeyValueExpr); ok {
+						ps.Regions[exprValue(kv.Key)] = exprValue(compositeLitFields(kv.Value)["description"])
+					}
+				}
+			case "services":
+				for _, elt := range compositeLitElts(value) {
+					if kv, ok := elt.(*ast.KeyValueExpr); ok {
+						ps.Services[exprValue(kv.Key)] = struct{}{}
+					}
+				}
+			}
+		}
+		s[exprValue(kv.Key)] = ps
+	}
+
+	return s, nil
+}
+
+func stringLiteral(expr ast.Expr) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	v, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", false
+	}
+	return v, true
+}
+
+func compositeLitElts(expr ast.Expr) []ast.Expr {
+	if lit, ok := expr.(*ast.CompositeLit); ok {
+		return lit.Elts
+	}
+	return nil
+}
+
+// compositeLitFields returns the field values of a struct literal, indexed by field name.
+func compositeLitFields(expr ast.Expr) map[string]ast.Expr {
+	fields := make(map[string]ast.Expr)
+	for _, elt := range compositeLitElts(expr) {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			if ident, ok := kv.Key.(*ast.Ident); ok {
+				fields[ident.Name] = kv.Value
+			}
+		}
+	}
+	return fields
+}
+
+// Changes returns a Markdown report of the partitions, Regions, and services
+// added, removed, or renamed between the previous and current endpoints.
+func Changes(previous, current Snapshot) string {
+	var sb strings.Builder
+
+	sb.WriteString("# Endpoints Changes\n")
+
+	var partitionChanges []string
+	for _, id := range slices.Sorted(maps.Keys(current)) {
+		p, ok := previous[id]
+		switch {
+		case !ok:
+			partitionChanges = append(partitionChanges, fmt.Sprintf("* Added `%s` (%s)", id, current[id].Name))
+		case p.Name != current[id].Name:
+			partitionChanges = append(partitionChanges, fmt.Sprintf("* Renamed `%s` from %q to %q", id, p.Name, current[id].Name))
+		}
+	}
+	for _, id := range slices.Sorted(maps.Keys(previous)) {
+		if _, ok := current[id]; !ok {
+			partitionChanges = append(partitionChanges, fmt.Sprintf("* Removed `%s` (%s)", id, previous[id].Name))
+		}
+	}
+	writeReportSection(&sb, "Partitions", partitionChanges)
+
+	ids := slices.Sorted(maps.Keys(current))
+	for _, id := range slices.Sorted(maps.Keys(previous)) {
+		if _, ok := current[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+
+	var regionChanges, serviceChanges []string
+	for _, id := range ids {
+		p, c := previous[id], current[id]
+
+		var changes []string
+		for _, region := range slices.Sorted(maps.Keys(c.Regions)) {
+			description, ok := p.Regions[region]
+			switch {
+			case !ok:
+				changes = append(changes, fmt.Sprintf("  * Added `%s` (%s)", region, c.Regions[region]))
+			case description != c.Regions[region]:
+				changes = append(changes, fmt.Sprintf("  * Renamed `%s` from %q to %q", region, description, c.Regions[region]))
+			}
+		}
+		for _, region := range slices.Sorted(maps.Keys(p.Regions)) {
+			if _, ok := c.Regions[region]; !ok {
+				changes = append(changes, fmt.Sprintf("  * Removed `%s` (%s)", region, p.Regions[region]))
+			}
+		}
+		if len(changes) > 0 {
+			regionChanges = append(regionChanges, fmt.Sprintf("* `%s`", id))
+			regionChanges = append(regionChanges, changes...)
+		}
+
+		changes = nil
+		for _, service := range slices.Sorted(maps.Keys(c.Services)) {
+			if _, ok := p.Services[service]; !ok {
+				changes = append(changes, fmt.Sprintf("  * Added `%s`", service))
+			}
+		}
+		for _, service := range slices.Sorted(maps.Keys(p.Services)) {
+			if _, ok := c.Services[service]; !ok {
+				changes = append(changes, fmt.Sprintf("  * Removed `%s`", service))
+			}
+		}
+		if len(changes) > 0 {
+			serviceChanges = append(serviceChanges, fmt.Sprintf("* `%s`", id))
+			serviceChanges = append(serviceChanges, changes...)
+		}
+	}
+	writeReportSection(&sb, "Regions", regionChanges)
+	writeReportSection(&sb, "Services", serviceChanges)
+
+	return sb.String()
+}
+
+func writeReportSection(sb *strings.Builder, title string, lines []string) {
+	fmt.Fprintf(sb, "\n## %s\n\n", title)
+	if len(lines) == 0 {
+		sb.WriteString("No changes.\n")
+		return
+	}
+	for _, line := range lines {
+		sb.WriteString(line)
+		sb.WriteString("\n")
+	}
+}
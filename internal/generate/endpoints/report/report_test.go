@@ -0,0 +1,326 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSnapshotFromFile(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		contents      string // If empty, the file is not written.
+		expected      Snapshot
+		expectedError string
+	}{
+		"file missing": {
+			expected: Snapshot{},
+		},
+		"generated file": {
+			contents: `package endpoints
+
+const (
+	AwsPartitionID   = "aws"    // AWS Standard
+	AwsCnPartitionID = "aws-cn" // AWS China
+)
+
+const (
+	CnNorth1RegionID = "cn-north-1" // China (Beijing)
+	UsEast1RegionID  = "us-east-1"  // US East (N. Virginia)
+)
+
+const (
+	Ec2ServiceID = "ec2"
+	IamServiceID = "iam"
+)
+
+var (
+	partitions = map[string]Partition{
+		AwsPartitionID: {
+			id:   AwsPartitionID,
+			name: "AWS Standard",
+			regions: map[string]Region{
+				UsEast1RegionID: {
+					id:          UsEast1RegionID,
+					description: "US East (N. Virginia)",
+				},
+				"us-west-2": {
+					id:          "us-west-2",
+					description: "US West (Oregon)",
+				},
+			},
+			services: map[string]Service{
+				Ec2ServiceID: {
+					id: Ec2ServiceID,
+				},
+				IamServiceID: {
+					id: IamServiceID,
+				},
+			},
+		},
+		AwsCnPartitionID: {
+			id:   AwsCnPartitionID,
+			name: "AWS China",
+			regions: map[string]Region{
+				CnNorth1RegionID: {
+					id:          CnNorth1RegionID,
+					description: "China (Beijing)",
+				},
+			},
+		},
+	}
+)
+`,
+			expected: Snapshot{
+				"aws": {
+					Name: "AWS Standard",
+					Regions: map[string]string{
+						"us-east-1": "US East (N. Virginia)",
+						"us-west-2": "US West (Oregon)",
+					},
+					Services: map[string]struct{}{
+						"ec2": {},
+						"iam": {},
+					},
+				},
+				"aws-cn": {
+					Name: "AWS China",
+					Regions: map[string]string{
+						"cn-north-1": "China (Beijing)",
+					},
+					Services: map[string]struct{}{},
+				},
+			},
+		},
+		"partitions missing": {
+			contents: `package endpoints
+
+const (
+	AwsPartitionID = "aws" // AWS Standard
+)
+`,
+			expectedError: "partitions not found",
+		},
+		"invalid file": {
+			contents:      `package endpoints {`,
+			expectedError: "expected ';'",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			filename := filepath.Join(t.TempDir(), "endpoints_gen.go")
+			if testCase.contents != "" {
+				if err := os.WriteFile(filename, []byte(testCase.contents), 0600); err != nil {
+					t.Fatalf("writing file: %s", err)
+				}
+			}
+
+			got, err := FromFile(filename)
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error containing %q, got none", testCase.expectedError)
+				}
+				if !strings.Contains(err.Error(), testCase.expectedError) {
+					t.Fatalf("expected error containing %q, got %q", testCase.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestChangeReport(t *testing.T) {
+	t.Parallel()
+
+	aws := Partition{
+		Name: "AWS Standard",
+		Regions: map[string]string{
+			"us-east-1": "US East (N. Virginia)",
+			"us-west-2": "US West (Oregon)",
+		},
+		Services: map[string]struct{}{
+			"ec2": {},
+			"iam": {},
+		},
+	}
+	awsCn := Partition{
+		Name: "AWS China",
+		Regions: map[string]string{
+			"cn-north-1": "China (Beijing)",
+		},
+		Services: map[string]struct{}{
+			"ec2": {},
+		},
+	}
+
+	testCases := map[string]struct {
+		previous, current Snapshot
+		expected          string
+	}{
+		"no changes": {
+			previous: Snapshot{"aws": aws},
+			current:  Snapshot{"aws": aws},
+			expected: `# Endpoints Changes
+
+## Partitions
+
+No changes.
+
+## Regions
+
+No changes.
+
+## Services
+
+No changes.
+`,
+		},
+		"file missing": {
+			previous: Snapshot{},
+			current:  Snapshot{"aws": aws},
+			expected: "# Endpoints Changes\n\n## Partitions\n\n* Added `aws` (AWS Standard)\n\n" +
+				"## Regions\n\n* `aws`\n  * Added `us-east-1` (US East (N. Virginia))\n  * Added `us-west-2` (US West (Oregon))\n\n" +
+				"## Services\n\n* `aws`\n  * Added `ec2`\n  * Added `iam`\n",
+		},
+		"partition added": {
+			previous: Snapshot{"aws": aws},
+			current:  Snapshot{"aws": aws, "aws-cn": awsCn},
+			expected: "# Endpoints Changes\n\n## Partitions\n\n* Added `aws-cn` (AWS China)\n\n" +
+				"## Regions\n\n* `aws-cn`\n  * Added `cn-north-1` (China (Beijing))\n\n" +
+				"## Services\n\n* `aws-cn`\n  * Added `ec2`\n",
+		},
+		"partition removed": {
+			previous: Snapshot{"aws": aws, "aws-cn": awsCn},
+			current:  Snapshot{"aws": aws},
+			expected: "# Endpoints Changes\n\n## Partitions\n\n* Removed `aws-cn` (AWS China)\n\n" +
+				"## Regions\n\n* `aws-cn`\n  * Removed `cn-north-1` (China (Beijing))\n\n" +
+				"## Services\n\n* `aws-cn`\n  * Removed `ec2`\n",
+		},
+		"partition renamed": {
+			previous: Snapshot{"aws": aws},
+			current: Snapshot{"aws": {
+				Name:     "AWS Commercial",
+				Regions:  aws.Regions,
+				Services: aws.Services,
+			}},
+			expected: "# Endpoints Changes\n\n## Partitions\n\n* Renamed `aws` from \"AWS Standard\" to \"AWS Commercial\"\n\n" +
+				"## Regions\n\nNo changes.\n\n" +
+				"## Services\n\nNo changes.\n",
+		},
+		"Region added": {
+			previous: Snapshot{"aws": aws},
+			current: Snapshot{"aws": {
+				Name: aws.Name,
+				Regions: map[string]string{
+					"us-east-1": "US East (N. Virginia)",
+					"us-east-2": "US East (Ohio)",
+					"us-west-2": "US West (Oregon)",
+				},
+				Services: aws.Services,
+			}},
+			expected: "# Endpoints Changes\n\n## Partitions\n\nNo changes.\n\n" +
+				"## Regions\n\n* `aws`\n  * Added `us-east-2` (US East (Ohio))\n\n" +
+				"## Services\n\nNo changes.\n",
+		},
+		"Region removed": {
+			previous: Snapshot{"aws": aws},
+			current: Snapshot{"aws": {
+				Name: aws.Name,
+				Regions: map[string]string{
+					"us-east-1": "US East (N. Virginia)",
+				},
+				Services: aws.Services,
+			}},
+			expected: "# Endpoints Changes\n\n## Partitions\n\nNo changes.\n\n" +
+				"## Regions\n\n* `aws`\n  * Removed `us-west-2` (US West (Oregon))\n\n" +
+				"## Services\n\nNo changes.\n",
+		},
+		"Region renamed": {
+			previous: Snapshot{"aws": aws},
+			current: Snapshot{"aws": {
+				Name: aws.Name,
+				Regions: map[string]string{
+					"us-east-1": "US East (Virginia)",
+					"us-west-2": "US West (Oregon)",
+				},
+				Services: aws.Services,
+			}},
+			expected: "# Endpoints Changes\n\n## Partitions\n\nNo changes.\n\n" +
+				"## Regions\n\n* `aws`\n  * Renamed `us-east-1` from \"US East (N. Virginia)\" to \"US East (Virginia)\"\n\n" +
+				"## Services\n\nNo changes.\n",
+		},
+		"service added": {
+			previous: Snapshot{"aws": aws},
+			current: Snapshot{"aws": {
+				Name:    aws.Name,
+				Regions: aws.Regions,
+				Services: map[string]struct{}{
+					"ec2": {},
+					"iam": {},
+					"s3":  {},
+				},
+			}},
+			expected: "# Endpoints Changes\n\n## Partitions\n\nNo changes.\n\n" +
+				"## Regions\n\nNo changes.\n\n" +
+				"## Services\n\n* `aws`\n  * Added `s3`\n",
+		},
+		"service removed": {
+			previous: Snapshot{"aws": aws},
+			current: Snapshot{"aws": {
+				Name:    aws.Name,
+				Regions: aws.Regions,
+				Services: map[string]struct{}{
+					"ec2": {},
+				},
+			}},
+			expected: "# Endpoints Changes\n\n## Partitions\n\nNo changes.\n\n" +
+				"## Regions\n\nNo changes.\n\n" +
+				"## Services\n\n* `aws`\n  * Removed `iam`\n",
+		},
+		// Services are identified only by ID, so a renamed service is reported as removed and added.
+		"service renamed": {
+			previous: Snapshot{"aws": aws},
+			current: Snapshot{"aws": {
+				Name:    aws.Name,
+				Regions: aws.Regions,
+				Services: map[string]struct{}{
+					"ec2":    {},
+					"iam-v2": {},
+				},
+			}},
+			expected: "# Endpoints Changes\n\n## Partitions\n\nNo changes.\n\n" +
+				"## Regions\n\nNo changes.\n\n" +
+				"## Services\n\n* `aws`\n  * Added `iam-v2`\n  * Removed `iam`\n",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Changes(testCase.previous, testCase.current)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
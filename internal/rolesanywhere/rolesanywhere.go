@@ -0,0 +1,380 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package rolesanywhere retrieves credentials from IAM Roles Anywhere by signing CreateSession requests
+// with an X.509 certificate's private key.
+//
+// See https://docs.aws.amazon.com/rolesanywhere/latest/userguide/authentication-sign-process.html.
+package rolesanywhere
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+const (
+	// ProviderName is the source of credentials retrieved from IAM Roles Anywhere.
+	ProviderName = "IAMRolesAnywhereProvider"
+
+	// SigningName is the service name used when signing requests to IAM Roles Anywhere.
+	SigningName = "rolesanywhere"
+
+	// DefaultDuration is the duration of sessions if no duration is set.
+	DefaultDuration = 1 * time.Hour
+
+	// Header names used when signing requests.
+	HeaderX509      = "X-Amz-X509"
+	HeaderX509Chain = "X-Amz-X509-Chain"
+	HeaderDate      = "X-Amz-Date"
+
+	algorithmRSA   = "AWS4-X509-RSA-SHA256"
+	algorithmECDSA = "AWS4-X509-ECDSA-SHA256"
+
+	timeFormat      = "20060102T150405Z"
+	shortTimeFormat = "20060102"
+)
+
+// Options configures a Provider.
+type Options struct {
+	Certificate      *x509.Certificate
+	CertificateChain []*x509.Certificate
+	PrivateKey       crypto.Signer
+
+	TrustAnchorARN string
+	ProfileARN     string
+	RoleARN        string
+	SessionName    string
+	Duration       time.Duration
+
+	// Region is the Region of the trust anchor and profile.
+	Region string
+	// Endpoint is the URL of the IAM Roles Anywhere endpoint.
+	Endpoint string
+
+	HTTPClient aws.HTTPClient
+}
+
+// Provider retrieves credentials from IAM Roles Anywhere. It implements aws.CredentialsProvider.
+// Credentials are not cached; wrap the Provider in an aws.CredentialsCache.
+type Provider struct {
+	options Options
+}
+
+var _ aws.CredentialsProvider = (*Provider)(nil)
+
+// New returns a Provider.
+func New(options Options) *Provider {
+	if options.HTTPClient == nil {
+		options.HTTPClient = http.DefaultClient
+	}
+	if options.Duration == 0 {
+		options.Duration = DefaultDuration
+	}
+
+	return &Provider{
+		options: options,
+	}
+}
+
+type createSessionInput struct {
+	DurationSeconds int    `json:"durationSeconds"`
+	ProfileARN      string `json:"profileArn"`
+	RoleARN         string `json:"roleArn"`
+	RoleSessionName string `json:"roleSessionName,omitempty"`
+	TrustAnchorARN  string `json:"trustAnchorArn"`
+}
+
+type createSessionOutput struct {
+	CredentialSet []struct {
+		Credentials struct {
+			AccessKeyID     string `json:"accessKeyId"`
+			SecretAccessKey string `json:"secretAccessKey"`
+			SessionToken    string `json:"sessionToken"`
+			Expiration      string `json:"expiration"`
+		} `json:"credentials"`
+	} `json:"credentialSet"`
+}
+
+// Retrieve calls CreateSession and returns the session's credentials.
+func (p *Provider) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	creds, err := p.createSession(ctx)
+	if err != nil {
+		return aws.Credentials{}, fmt.Errorf("IAM Roles Anywhere CreateSession: %w", err)
+	}
+
+	return creds, nil
+}
+
+func (p *Provider) createSession(ctx context.Context) (aws.Credentials, error) {
+	body, err := json.Marshal(createSessionInput{
+		DurationSeconds: int(p.options.Duration.Seconds()),
+		ProfileARN:      p.options.ProfileARN,
+		RoleARN:         p.options.RoleARN,
+		RoleSessionName: p.options.SessionName,
+		TrustAnchorARN:  p.options.TrustAnchorARN,
+	})
+	if err != nil {
+		return aws.Credentials{}, err
+	}
+
+	u, err := url.JoinPath(p.options.Endpoint, "sessions")
+	if err != nil {
+		return aws.Credentials{}, fmt.Errorf("invalid endpoint: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return aws.Credentials{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if err := Sign(req, body, p.options.Certificate, p.options.CertificateChain, p.options.PrivateKey, p.options.Region, time.Now()); err != nil {
+		return aws.Credentials{}, fmt.Errorf("signing request: %w", err)
+	}
+
+	resp, err := p.options.HTTPClient.Do(req)
+	if err != nil {
+		return aws.Credentials{}, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return aws.Credentials{}, fmt.Errorf("reading response: %w", err)
+	}
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return aws.Credentials{}, newResponseError(resp, respBody)
+	}
+
+	var output createSessionOutput
+	if err := json.Unmarshal(respBody, &output); err != nil {
+		return aws.Credentials{}, fmt.Errorf("decoding response: %w", err)
+	}
+	if len(output.CredentialSet) == 0 {
+		return aws.Credentials{}, errors.New("response contains no credentials")
+	}
+
+	c := output.CredentialSet[0].Credentials
+	expires, err := time.Parse(time.RFC3339, c.Expiration)
+	if err != nil {
+		return aws.Credentials{}, fmt.Errorf("parsing credentials expiration: %w", err)
+	}
+
+	return aws.Credentials{
+		AccessKeyID:     c.AccessKeyID,
+		SecretAccessKey: c.SecretAccessKey,
+		SessionToken:    c.SessionToken,
+		Source:          ProviderName,
+		CanExpire:       true,
+		Expires:         expires,
+	}, nil
+}
+
+// ResponseError is an error response from IAM Roles Anywhere.
+type ResponseError struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("https response error StatusCode: %d, %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+func newResponseError(resp *http.Response, body []byte) error {
+	var output struct {
+		Message string `json:"message"`
+	}
+	_ = json.Unmarshal(body, &output)
+
+	code, _, _ := strings.Cut(resp.Header.Get("X-Amzn-Errortype"), ":")
+
+	return &ResponseError{
+		StatusCode: resp.StatusCode,
+		Code:       code,
+		Message:    output.Message,
+	}
+}
+
+// Sign signs the request with the certificate's private key, setting the X.509 and Authorization headers.
+// The Content-Type header must already be set.
+func Sign(req *http.Request, body []byte, cert *x509.Certificate, chain []*x509.Certificate, key crypto.Signer, region string, now time.Time) error {
+	var algorithm string
+	switch key.Public().(type) {
+	case *rsa.PublicKey:
+		algorithm = algorithmRSA
+	case *ecdsa.PublicKey:
+		algorithm = algorithmECDSA
+	default:
+		return fmt.Errorf("unsupported private key type %T", key)
+	}
+
+	now = now.UTC()
+	req.Header.Set(HeaderDate, now.Format(timeFormat))
+	req.Header.Set(HeaderX509, base64.StdEncoding.EncodeToString(cert.Raw))
+	if len(chain) > 0 {
+		encoded := make([]string, 0, len(chain))
+		for _, c := range chain {
+			encoded = append(encoded, base64.StdEncoding.EncodeToString(c.Raw))
+		}
+		req.Header.Set(HeaderX509Chain, strings.Join(encoded, ","))
+	}
+
+	scope := strings.Join([]string{now.Format(shortTimeFormat), region, SigningName, "aws4_request"}, "/")
+	signedHeaders, stringToSign := StringToSign(req, body, algorithm, scope)
+
+	digest := sha256.Sum256([]byte(stringToSign))
+	signature, err := key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		algorithm, cert.SerialNumber, scope, signedHeaders, hex.EncodeToString(signature)))
+
+	return nil
+}
+
+// StringToSign returns the signed headers and the string to sign for the request,
+// as for Signature Version 4 with the host, Content-Type, date, and X.509 headers signed.
+func StringToSign(req *http.Request, body []byte, algorithm, scope string) (string, string) {
+	headers := map[string]string{
+		"host": req.Host,
+	}
+	if headers["host"] == "" {
+		headers["host"] = req.URL.Host
+	}
+	for _, name := range []string{"Content-Type", HeaderDate, HeaderX509, HeaderX509Chain} {
+		if v := req.Header.Get(name); v != "" {
+			headers[strings.ToLower(name)] = strings.TrimSpace(v)
+		}
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&canonicalHeaders, "%s:%s\n", name, headers[name])
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	payloadHash := sha256.Sum256(body)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		req.URL.Query().Encode(),
+		canonicalHeaders.String(),
+		signedHeaders,
+		hex.EncodeToString(payloadHash[:]),
+	}, "\n")
+
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		algorithm,
+		req.Header.Get(HeaderDate),
+		scope,
+		hex.EncodeToString(requestHash[:]),
+	}, "\n")
+
+	return signedHeaders, stringToSign
+}
+
+// LoadCertificates reads the PEM-encoded certificates in the file.
+func LoadCertificates(filename string) ([]*x509.Certificate, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, b = pem.Decode(b)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parsing certificate in %s: %w", filename, err)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no certificates found in %s", filename)
+	}
+
+	return certs, nil
+}
+
+// LoadPrivateKey reads a PEM-encoded, unencrypted RSA or EC private key in PKCS #8, PKCS #1, or SEC 1 form.
+func LoadPrivateKey(filename string) (crypto.Signer, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		var block *pem.Block
+		block, b = pem.Decode(b)
+		if block == nil {
+			return nil, fmt.Errorf("no private key found in %s", filename)
+		}
+
+		var key any
+		switch block.Type {
+		case "PRIVATE KEY":
+			key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+		case "RSA PRIVATE KEY":
+			key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+		case "EC PRIVATE KEY":
+			key, err = x509.ParseECPrivateKey(block.Bytes)
+		case "ENCRYPTED PRIVATE KEY":
+			return nil, fmt.Errorf("encrypted private key in %s is not supported", filename)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parsing private key in %s: %w", filename, err)
+		}
+
+		switch key := key.(type) {
+		case *rsa.PrivateKey:
+			return key, nil
+		case *ecdsa.PrivateKey:
+			return key, nil
+		default:
+			return nil, fmt.Errorf("unsupported private key type %T in %s", key, filename)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rolesanywhere
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadPrivateKey(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("unexpected error generating RSA key: %s", err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unexpected error generating EC key: %s", err)
+	}
+	pkcs8RSA, err := x509.MarshalPKCS8PrivateKey(rsaKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	pkcs8EC, err := x509.MarshalPKCS8PrivateKey(ecKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	sec1, err := x509.MarshalECPrivateKey(ecKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testcases := map[string]struct {
+		blocks      []*pem.Block
+		expectedErr string
+	}{
+		"PKCS #8 RSA": {
+			blocks: []*pem.Block{{Type: "PRIVATE KEY", Bytes: pkcs8RSA}},
+		},
+		"PKCS #8 EC": {
+			blocks: []*pem.Block{{Type: "PRIVATE KEY", Bytes: pkcs8EC}},
+		},
+		"PKCS #1": {
+			blocks: []*pem.Block{{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}},
+		},
+		"SEC 1 with parameters": {
+			blocks: []*pem.Block{{Type: "EC PARAMETERS", Bytes: []byte{0x06, 0x08}}, {Type: "EC PRIVATE KEY", Bytes: sec1}},
+		},
+		"encrypted": {
+			blocks:      []*pem.Block{{Type: "ENCRYPTED PRIVATE KEY", Bytes: []byte("encrypted")}},
+			expectedErr: "encrypted private key",
+		},
+		"no key": {
+			expectedErr: "no private key found",
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			var b []byte
+			for _, block := range testcase.blocks {
+				b = append(b, pem.EncodeToMemory(block)...)
+			}
+			filename := filepath.Join(t.TempDir(), "key.pem")
+			if err := os.WriteFile(filename, b, 0600); err != nil {
+				t.Fatalf("unexpected error writing key: %s", err)
+			}
+
+			key, err := LoadPrivateKey(filename)
+
+			if testcase.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), testcase.expectedErr) {
+					t.Fatalf("expected error containing %q, got: %v", testcase.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if key == nil {
+				t.Fatal("expected key, got nil")
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package sharedconfig parses shared config and credentials files, keeping the location of each section and key.
+package sharedconfig
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Location is a line in a file.
+type Location struct {
+	File string
+	Line int
+}
+
+func (l Location) String() string {
+	return fmt.Sprintf("%s:%d", l.File, l.Line)
+}
+
+// Section is a section of a file, such as "[profile test]".
+type Section struct {
+	// Name is the section header with whitespace normalized, such as "profile test".
+	Name     string
+	Location Location
+	Keys     []Key
+}
+
+// Key is a key in a section.
+type Key struct {
+	Name     string
+	Value    string
+	Location Location
+	// SubKeys are the indented keys following a key with an empty value, such as the keys of "s3" or "services".
+	SubKeys []Key
+}
+
+// ParseFile parses the file.
+func ParseFile(filename string) ([]Section, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Parse(filename, f)
+}
+
+// Parse parses shared config or credentials file content read from r.
+// As with the AWS SDK for Go v2, leading whitespace is ignored and values are not stripped of trailing comments.
+func Parse(filename string, r io.Reader) ([]Section, error) {
+	var (
+		sections []Section
+		parent   *Key
+		indent   int
+	)
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		trimmed := strings.TrimSpace(text)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, ";") {
+			continue
+		}
+
+		loc := Location{File: filename, Line: line}
+
+		if strings.HasPrefix(trimmed, "[") {
+			if !strings.HasSuffix(trimmed, "]") {
+				return nil, fmt.Errorf("%s: invalid section header %q", loc, trimmed)
+			}
+			sections = append(sections, Section{
+				Name:     strings.Join(strings.Fields(trimmed[1:len(trimmed)-1]), " "),
+				Location: loc,
+			})
+			parent = nil
+			continue
+		}
+
+		name, value, ok := strings.Cut(trimmed, "=")
+		if !ok {
+			return nil, fmt.Errorf("%s: expected key = value, got %q", loc, trimmed)
+		}
+		if len(sections) == 0 {
+			return nil, fmt.Errorf("%s: key %q is not in a section", loc, strings.TrimSpace(name))
+		}
+		key := Key{
+			Name:     strings.TrimSpace(name),
+			Value:    strings.TrimSpace(value),
+			Location: loc,
+		}
+
+		lineIndent := len(text) - len(strings.TrimLeft(text, " \t"))
+		if parent != nil && lineIndent > indent {
+			parent.SubKeys = append(parent.SubKeys, key)
+			continue
+		}
+
+		section := &sections[len(sections)-1]
+		section.Keys = append(section.Keys, key)
+		parent = nil
+		if key.Value == "" {
+			parent = &section.Keys[len(section.Keys)-1]
+			indent = lineIndent
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", filename, err)
+	}
+
+	return sections, nil
+}
@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sharedconfig
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParse(t *testing.T) {
+	testCases := map[string]struct {
+		content          string
+		expectedSections []Section
+		expectedError    string
+	}{
+		"empty": {},
+
+		"sections": {
+			content: `
+# comment
+[default]
+region = us-west-2
+
+; comment
+[profile  test]
+role_arn = arn:aws:iam::123456789012:role/test
+sso_start_url = https://d-123456789a.awsapps.com/start#
+`,
+			expectedSections: []Section{
+				{
+					Name:     "default",
+					Location: Location{File: "config", Line: 3},
+					Keys: []Key{
+						{Name: "region", Value: "us-west-2", Location: Location{File: "config", Line: 4}},
+					},
+				},
+				{
+					Name:     "profile test",
+					Location: Location{File: "config", Line: 7},
+					Keys: []Key{
+						{Name: "role_arn", Value: "arn:aws:iam::123456789012:role/test", Location: Location{File: "config", Line: 8}},
+						{Name: "sso_start_url", Value: "https://d-123456789a.awsapps.com/start#", Location: Location{File: "config", Line: 9}},
+					},
+				},
+			},
+		},
+
+		"leading whitespace": {
+			// Do not "fix" indentation!
+			content: `	[default]
+	region = us-west-2
+	`,
+			expectedSections: []Section{
+				{
+					Name:     "default",
+					Location: Location{File: "config", Line: 1},
+					Keys: []Key{
+						{Name: "region", Value: "us-west-2", Location: Location{File: "config", Line: 2}},
+					},
+				},
+			},
+		},
+
+		"sub-keys": {
+			content: `[default]
+s3 =
+  max_concurrent_requests = 20
+region = us-west-2
+`,
+			expectedSections: []Section{
+				{
+					Name:     "default",
+					Location: Location{File: "config", Line: 1},
+					Keys: []Key{
+						{
+							Name:     "s3",
+							Location: Location{File: "config", Line: 2},
+							SubKeys: []Key{
+								{Name: "max_concurrent_requests", Value: "20", Location: Location{File: "config", Line: 3}},
+							},
+						},
+						{Name: "region", Value: "us-west-2", Location: Location{File: "config", Line: 4}},
+					},
+				},
+			},
+		},
+
+		"key outside section": {
+			content:       `region = us-west-2`,
+			expectedError: `config:1: key "region" is not in a section`,
+		},
+
+		"invalid section header": {
+			content:       `[default`,
+			expectedError: `config:1: invalid section header "[default"`,
+		},
+
+		"invalid line": {
+			content: `[default]
+region
+`,
+			expectedError: `config:2: expected key = value, got "region"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sections, err := Parse("config", strings.NewReader(testCase.content))
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got none", testCase.expectedError)
+				}
+				if a, e := err.Error(), testCase.expectedError; a != e {
+					t.Errorf("expected error %q, got %q", e, a)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(sections, testCase.expectedSections); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
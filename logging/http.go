@@ -230,20 +230,31 @@ func normalizeHeaderName(k string) string {
 }
 
 func authorizationHeaderAttribute(v string) (attribute.KeyValue, bool) {
+	masked, ok := MaskAuthorizationHeader(v)
+	if !ok {
+		return attribute.KeyValue{}, false
+	}
+
+	return RequestHeaderAttributeKey("Authorization").String(masked), true
+}
+
+// MaskAuthorizationHeader masks the sensitive parts of an HTTP Authorization header value.
+// For AWS Signature Version 4 values, the signature is masked and the access key ID is partially masked.
+// For all other schemes, the entire parameter value is masked.
+func MaskAuthorizationHeader(v string) (string, bool) {
 	parts := regexp.MustCompile(`\s+`).Split(v, 2) //nolint:mnd
 	if len(parts) != 2 {                           //nolint:mnd
-		return attribute.KeyValue{}, false
+		return "", false
 	}
 	scheme := parts[0]
 	if scheme == "" {
-		return attribute.KeyValue{}, false
+		return "", false
 	}
 	params := parts[1]
 	if params == "" {
-		return attribute.KeyValue{}, false
+		return "", false
 	}
 
-	key := RequestHeaderAttributeKey("Authorization")
 	if strings.HasPrefix(scheme, "AWS4-") {
 		components := regexp.MustCompile(`,\s+`).Split(params, -1)
 		var builder strings.Builder
@@ -262,9 +273,9 @@ func authorizationHeaderAttribute(v string) (attribute.KeyValue, bool) {
 				builder.WriteString(", ")
 			}
 		}
-		return key.String(fmt.Sprintf("%s %s", scheme, MaskAWSSensitiveValues(builder.String()))), true
+		return fmt.Sprintf("%s %s", scheme, MaskAWSSensitiveValues(builder.String())), true
 	} else {
-		return key.String(fmt.Sprintf("%s %s", scheme, strings.Repeat("*", len(params)))), true
+		return fmt.Sprintf("%s %s", scheme, strings.Repeat("*", len(params))), true
 	}
 }
 
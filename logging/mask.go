@@ -3,6 +3,10 @@
 
 package logging
 
+import (
+	"regexp"
+)
+
 func partialMaskString(s []byte, first, last int) []byte {
 	l := len(s)
 	result := make([]byte, 0, l)
@@ -13,3 +17,10 @@ func partialMaskString(s []byte, first, last int) []byte {
 	result = append(result, s[l-last:]...)
 	return result
 }
+
+var urlCredentialsRegex = regexp.MustCompile(`(://)[^/?#@\s]+@`)
+
+// MaskURLCredentials masks the user information, such as proxy credentials, in any URLs in the input.
+func MaskURLCredentials(s string) string {
+	return urlCredentialsRegex.ReplaceAllString(s, "${1}*****@")
+}
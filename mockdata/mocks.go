@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/credentials/endpointcreds"
 	"github.com/aws/aws-sdk-go-v2/credentials/ssocreds"
 	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/hashicorp/aws-sdk-go-base/v2/internal/rolesanywhere"
 	"github.com/hashicorp/aws-sdk-go-base/v2/servicemocks"
 )
 
@@ -88,6 +89,14 @@ var (
 		CanExpire:       true,
 	}
 
+	MockRolesAnywhereCredentials = aws.Credentials{
+		AccessKeyID:     servicemocks.MockRolesAnywhereAccessKey,
+		SecretAccessKey: servicemocks.MockRolesAnywhereSecretKey,
+		SessionToken:    servicemocks.MockRolesAnywhereSessionToken,
+		Source:          rolesanywhere.ProviderName,
+		CanExpire:       true,
+	}
+
 	MockSsoCredentials = aws.Credentials{
 		AccessKeyID:     servicemocks.MockSsoAccessKeyID,
 		AccountID:       "123456789012",
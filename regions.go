@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package awsbase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/hashicorp/aws-sdk-go-base/v2/diag"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/aws-sdk-go-base/v2/logging"
+)
+
+// MultiRegionAwsConfig is the result of GetAwsConfigForRegions.
+type MultiRegionAwsConfig struct {
+	// AccountID and Partition identify the credentials shared by all of the Regions.
+	AccountID string
+	Partition string
+
+	// Regions contains an entry for each of the requested Regions, in the order requested.
+	Regions []RegionalAwsConfig
+}
+
+// RegionalAwsConfig is the aws.Config for one Region.
+type RegionalAwsConfig struct {
+	Region    string
+	AwsConfig aws.Config
+
+	// Diags contains the diagnostics specific to the Region.
+	// If it contains errors, AwsConfig should not be used.
+	Diags diag.Diagnostics
+}
+
+// GetAwsConfigForRegions resolves the configuration and credentials once and returns an aws.Config for each of the Regions.
+// The returned aws.Configs share one credentials cache, and the credentials are validated at most once.
+// If c.Region is not set, the first of the Regions is used when resolving credentials.
+//
+// The returned diagnostics apply to all of the Regions. If they contain errors, no aws.Configs are returned.
+func GetAwsConfigForRegions(ctx context.Context, c *Config, regions []string) (context.Context, MultiRegionAwsConfig, diag.Diagnostics) {
+	var result MultiRegionAwsConfig
+
+	base := *c
+	if base.Region == "" && len(regions) > 0 {
+		base.Region = regions[0]
+	}
+
+	// Credentials are validated by GetAwsAccountIDAndPartition so that the identity is only retrieved once.
+	skipCredsValidation := base.SkipCredsValidation
+	base.SkipCredsValidation = true
+	ctx, awsConfig, diags := GetAwsConfig(ctx, &base)
+	if diags.HasError() {
+		return ctx, result, diags
+	}
+	base.SkipCredsValidation = skipCredsValidation
+
+	accountID, partition, d := GetAwsAccountIDAndPartition(ctx, awsConfig, &base)
+	diags = diags.Append(d...)
+	if diags.HasError() {
+		return ctx, result, diags
+	}
+	result.AccountID, result.Partition = accountID, partition
+
+	ps, err := base.Partitions()
+	if err != nil {
+		return ctx, result, diags.AddSimpleError(err)
+	}
+
+	logger := logging.RetrieveLogger(ctx)
+	for _, region := range regions {
+		logger.Debug(ctx, "Configuring Region", map[string]any{
+			"tf_aws.region": region,
+		})
+		result.Regions = append(result.Regions, regionalAwsConfig(&base, awsConfig, ps, partition, region))
+	}
+
+	return ctx, result, diags
+}
+
+func regionalAwsConfig(c *Config, awsConfig aws.Config, ps []endpoints.Partition, partitionID, region string) RegionalAwsConfig {
+	result := RegionalAwsConfig{
+		Region: region,
+	}
+
+	partition, ok := endpoints.PartitionForRegion(ps, region)
+	if !ok {
+		result.Diags = result.Diags.AddError(
+			"Invalid Region",
+			fmt.Sprintf("The Region %q is not part of any partition.", region),
+		)
+		return result
+	}
+	if partitionID != "" && partition.ID() != partitionID {
+		result.Diags = result.Diags.AddError(
+			"Region in Different Partition",
+			fmt.Sprintf("The Region %q is in the partition %q, but the credentials are for the partition %q.", region, partition.ID(), partitionID),
+		)
+		return result
+	}
+
+	result.Diags = result.Diags.Append(validateEndpointVariants(c, region)...)
+	if result.Diags.HasError() {
+		return result
+	}
+
+	// The copy shares the credentials cache with the original.
+	result.AwsConfig = awsConfig.Copy()
+	result.AwsConfig.Region = region
+
+	// Keep the configuration sources consistent with the Region, so that EffectiveSettings reports it.
+	result.AwsConfig.ConfigSources = make([]any, len(awsConfig.ConfigSources))
+	for i, source := range awsConfig.ConfigSources {
+		if o, ok := source.(config.LoadOptions); ok {
+			o.Region = region
+			source = o
+		}
+		result.AwsConfig.ConfigSources[i] = source
+	}
+
+	return result
+}
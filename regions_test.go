@@ -0,0 +1,144 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package awsbase
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/aws-sdk-go-base/v2/diag"
+	"github.com/hashicorp/aws-sdk-go-base/v2/servicemocks"
+)
+
+func TestGetAwsConfigForRegions(t *testing.T) {
+	testCases := map[string]struct {
+		Config              *Config
+		Regions             []string
+		ExpectedAccountID   string
+		ExpectedPartition   string
+		ExpectedRegionDiags map[string]diag.Diagnostics
+		ExpectedDiags       diag.Diagnostics
+	}{
+		"regions": {
+			Config: &Config{
+				Region: "us-east-1",
+			},
+			Regions:           []string{"us-west-2", "eu-west-1", "ap-southeast-2"},
+			ExpectedAccountID: "222222222222",
+			ExpectedPartition: "aws",
+		},
+
+		"no config region": {
+			Config:            &Config{},
+			Regions:           []string{"us-west-2", "eu-west-1"},
+			ExpectedAccountID: "222222222222",
+			ExpectedPartition: "aws",
+		},
+
+		"region diagnostics": {
+			Config: &Config{
+				Region: "us-east-1",
+			},
+			Regions:           []string{"us-west-2", "us-gov-west-1", "not-a-region", "cn-north-1"},
+			ExpectedAccountID: "222222222222",
+			ExpectedPartition: "aws",
+			ExpectedRegionDiags: map[string]diag.Diagnostics{
+				"us-gov-west-1": {
+					diag.NewErrorDiagnostic(
+						"Region in Different Partition",
+						`The Region "us-gov-west-1" is in the partition "aws-us-gov", but the credentials are for the partition "aws".`,
+					),
+				},
+				"not-a-region": {
+					diag.NewErrorDiagnostic(
+						"Invalid Region",
+						`The Region "not-a-region" is not part of any partition.`,
+					),
+				},
+				"cn-north-1": {
+					diag.NewErrorDiagnostic(
+						"Region in Different Partition",
+						`The Region "cn-north-1" is in the partition "aws-cn", but the credentials are for the partition "aws".`,
+					),
+				},
+			},
+		},
+
+		"invalid configuration": {
+			Config: &Config{
+				Region:     "us-east-1",
+				HTTPSProxy: aws.String("ftp://invalid.test"),
+			},
+			Regions: []string{"us-west-2"},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid HTTPS Proxy",
+					`unsupported proxy scheme "ftp", supported schemes are "http", "https", "socks5", and "socks5h"`,
+				),
+			},
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			servicemocks.InitSessionTestEnv(t)
+
+			ts := servicemocks.MockAwsApiServer("STS", []*servicemocks.MockEndpoint{
+				servicemocks.MockStsGetCallerIdentityValidEndpoint,
+			})
+			defer ts.Close()
+
+			if testCase.Config.AccessKey == "" {
+				testCase.Config.AccessKey = servicemocks.MockStaticAccessKey
+				testCase.Config.SecretKey = servicemocks.MockStaticSecretKey
+			}
+			testCase.Config.StsEndpoint = ts.URL
+
+			_, result, diags := GetAwsConfigForRegions(t.Context(), testCase.Config, testCase.Regions)
+
+			if diff := cmp.Diff(diags, testCase.ExpectedDiags); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+			if diags.HasError() {
+				return
+			}
+
+			if a, e := result.AccountID, testCase.ExpectedAccountID; a != e {
+				t.Errorf("expected account ID %q, got: %q", e, a)
+			}
+			if a, e := result.Partition, testCase.ExpectedPartition; a != e {
+				t.Errorf("expected partition %q, got: %q", e, a)
+			}
+
+			if a, e := len(result.Regions), len(testCase.Regions); a != e {
+				t.Fatalf("expected %d Regions, got: %d", e, a)
+			}
+
+			var credentials any
+			for i, regional := range result.Regions {
+				region := testCase.Regions[i]
+				if a, e := regional.Region, region; a != e {
+					t.Errorf("expected Region %q, got: %q", e, a)
+				}
+
+				if diff := cmp.Diff(regional.Diags, testCase.ExpectedRegionDiags[region]); diff != "" {
+					t.Errorf("Region %q: Unexpected response (+wanted, -got): %s", region, diff)
+				}
+				if regional.Diags.HasError() {
+					continue
+				}
+
+				if a, e := regional.AwsConfig.Region, region; a != e {
+					t.Errorf("expected aws.Config Region %q, got: %q", e, a)
+				}
+				if credentials == nil {
+					credentials = regional.AwsConfig.Credentials
+				} else if regional.AwsConfig.Credentials != credentials {
+					t.Errorf("Region %q: expected shared credentials cache", region)
+				}
+			}
+		})
+	}
+}
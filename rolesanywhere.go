@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package awsbase
+
+import (
+	"cmp"
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/aws-sdk-go-base/v2/diag"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/aws-sdk-go-base/v2/internal/expand"
+	"github.com/hashicorp/aws-sdk-go-base/v2/internal/rolesanywhere"
+	"github.com/hashicorp/aws-sdk-go-base/v2/logging"
+)
+
+// rolesAnywhereCredentialsProvider returns a cached credentials provider for IAM Roles Anywhere,
+// retrieving the initial credentials to check that a session can be created.
+func rolesAnywhereCredentialsProvider(ctx context.Context, awsConfig aws.Config, c *Config) (aws.CredentialsProvider, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	logger := logging.RetrieveLogger(ctx)
+
+	ra := c.IAMRolesAnywhere
+
+	logger.Info(ctx, "Creating IAM Roles Anywhere session", map[string]any{
+		"tf_aws.iam_roles_anywhere.role_arn":         ra.RoleARN,
+		"tf_aws.iam_roles_anywhere.profile_arn":      ra.ProfileARN,
+		"tf_aws.iam_roles_anywhere.trust_anchor_arn": ra.TrustAnchorARN,
+	})
+
+	options, err := rolesAnywhereOptions(ctx, awsConfig, c)
+	if err != nil {
+		return nil, diags.AddError("Invalid IAM Roles Anywhere Configuration", err.Error())
+	}
+
+	provider := aws.NewCredentialsCache(rolesanywhere.New(options))
+
+	err = runPhase(ctx, phaseRolesAnywhereCreateSession, c.Timeouts.CredentialsRetrieval, func(ctx context.Context) error {
+		_, err := provider.Retrieve(ctx)
+		return err
+	})
+	if e, ok := asPhaseTimeoutError(err); ok {
+		return nil, diags.Append(e)
+	}
+	if err != nil {
+		return nil, diags.Append(newCannotCreateRolesAnywhereSessionError(*ra, err))
+	}
+
+	return provider, diags
+}
+
+func rolesAnywhereOptions(ctx context.Context, awsConfig aws.Config, c *Config) (rolesanywhere.Options, error) {
+	ra := c.IAMRolesAnywhere
+
+	certs, err := loadRolesAnywhereFile(ra.CertificateFile, rolesanywhere.LoadCertificates)
+	if err != nil {
+		return rolesanywhere.Options{}, fmt.Errorf("reading certificate: %w", err)
+	}
+	key, err := loadRolesAnywhereFile(ra.PrivateKeyFile, rolesanywhere.LoadPrivateKey)
+	if err != nil {
+		return rolesanywhere.Options{}, fmt.Errorf("reading private key: %w", err)
+	}
+	if pub, ok := key.Public().(interface{ Equal(crypto.PublicKey) bool }); !ok || !pub.Equal(certs[0].PublicKey) {
+		return rolesanywhere.Options{}, errors.New("the private key does not match the certificate")
+	}
+
+	// Intermediate certificates can follow the end-entity certificate in the certificate file.
+	chain := certs[1:]
+	if ra.CertificateChainFile != "" {
+		intermediates, err := loadRolesAnywhereFile(ra.CertificateChainFile, rolesanywhere.LoadCertificates)
+		if err != nil {
+			return rolesanywhere.Options{}, fmt.Errorf("reading certificate chain: %w", err)
+		}
+		chain = append(chain, intermediates...)
+	}
+
+	region := awsConfig.Region
+	if region == "" {
+		return rolesanywhere.Options{}, errors.New("no Region was set")
+	}
+	endpoint, err := rolesAnywhereEndpoint(ctx, c, region)
+	if err != nil {
+		return rolesanywhere.Options{}, err
+	}
+
+	return rolesanywhere.Options{
+		Certificate:      certs[0],
+		CertificateChain: chain,
+		PrivateKey:       key,
+		TrustAnchorARN:   ra.TrustAnchorARN,
+		ProfileARN:       ra.ProfileARN,
+		RoleARN:          ra.RoleARN,
+		SessionName:      ra.SessionName,
+		Duration:         ra.Duration,
+		Region:           region,
+		Endpoint:         endpoint,
+		HTTPClient:       awsConfig.HTTPClient,
+	}, nil
+}
+
+func loadRolesAnywhereFile[T any](filename string, load func(string) (T, error)) (T, error) {
+	expanded, err := expand.FilePath(filename)
+	if err != nil {
+		var zero T
+		return zero, fmt.Errorf("expanding %s: %w", filename, err)
+	}
+	return load(expanded)
+}
+
+// rolesAnywhereEndpoint returns the IAM Roles Anywhere endpoint for the Region.
+func rolesAnywhereEndpoint(ctx context.Context, c *Config, region string) (string, error) {
+	logger := logging.RetrieveLogger(ctx)
+
+	serviceEndpoint, _ := c.ServiceEndpoint(endpoints.RolesanywhereServiceID)
+	if endpoint := cmp.Or(c.RolesAnywhereEndpoint, serviceEndpoint); endpoint != "" {
+		logger.Info(ctx, "IAM Roles Anywhere client: setting custom endpoint", map[string]any{
+			"tf_aws.iam_roles_anywhere_client.endpoint": endpoint,
+		})
+		return endpoint, nil
+	}
+
+	ps, err := c.Partitions()
+	if err != nil {
+		return "", err
+	}
+	partition, ok := endpoints.PartitionForRegion(ps, region)
+	if !ok {
+		return "", fmt.Errorf("no partition found for Region %q", region)
+	}
+
+	var variant endpoints.EndpointVariant
+	if c.UseFIPSEndpoint {
+		variant |= endpoints.FIPSVariant
+	}
+	if c.UseDualStackEndpoint {
+		variant |= endpoints.DualStackVariant
+	}
+	endpoint, err := partition.ResolveEndpoint(endpoints.RolesanywhereServiceID, region, variant)
+	if err != nil {
+		return "", err
+	}
+
+	return endpoint.URL, nil
+}
@@ -0,0 +1,153 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package awsbase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/diag"
+	"github.com/hashicorp/aws-sdk-go-base/v2/internal/rolesanywhere"
+	"github.com/hashicorp/aws-sdk-go-base/v2/mockdata"
+	"github.com/hashicorp/aws-sdk-go-base/v2/servicemocks"
+)
+
+func TestIAMRolesAnywhere(t *testing.T) {
+	testCases := map[string]struct {
+		EC                        bool
+		StaticCredentials         bool
+		RoleARN                   string
+		AssumeRole                []AssumeRole
+		ExpectedCredentialsValue  aws.Credentials
+		ExpectedCredentialsSource string
+		ExpectedDiags             diag.Diagnostics
+		ExpectedErr               func(*testing.T, error)
+	}{
+		"RSA key": {
+			ExpectedCredentialsValue:  mockdata.MockRolesAnywhereCredentials,
+			ExpectedCredentialsSource: rolesanywhere.ProviderName,
+		},
+
+		"EC key": {
+			EC:                        true,
+			ExpectedCredentialsValue:  mockdata.MockRolesAnywhereCredentials,
+			ExpectedCredentialsSource: rolesanywhere.ProviderName,
+		},
+
+		"assume role": {
+			AssumeRole: []AssumeRole{{
+				RoleARN:     servicemocks.MockStsAssumeRoleArn,
+				SessionName: servicemocks.MockStsAssumeRoleSessionName,
+			}},
+			ExpectedCredentialsValue:  mockdata.MockStsAssumeRoleCredentials,
+			ExpectedCredentialsSource: rolesanywhere.ProviderName,
+		},
+
+		"static credentials": {
+			StaticCredentials:         true,
+			ExpectedCredentialsValue:  mockdata.MockRolesAnywhereCredentials,
+			ExpectedCredentialsSource: rolesanywhere.ProviderName,
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Configuration conflict detected",
+					"Static credentials were set in the provider configuration along with IAM Roles Anywhere. "+
+						"The IAM Roles Anywhere credentials are used instead of the static credentials.",
+				),
+			},
+		},
+
+		"invalid role": {
+			RoleARN: "arn:aws:iam::777777777777:role/Invalid",
+			ExpectedErr: func(t *testing.T, err error) {
+				var re *rolesanywhere.ResponseError
+				if !errors.As(err, &re) {
+					t.Fatalf("expected ResponseError, got: %s", err)
+				}
+				if a, e := re.Code, "ValidationException"; a != e {
+					t.Errorf("expected error code %q, got: %q", e, a)
+				}
+			},
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			ctx := t.Context()
+
+			servicemocks.InitSessionTestEnv(t)
+
+			certFile, keyFile, err := servicemocks.RolesAnywhereCertificateFiles(t.TempDir(), testCase.EC)
+			if err != nil {
+				t.Fatalf("unexpected error creating certificate: %s", err)
+			}
+
+			closeRolesAnywhere, rolesAnywhereEndpoint := servicemocks.RolesAnywhereApiMock()
+			defer closeRolesAnywhere()
+
+			closeSts, _, stsEndpoint := mockdata.GetMockedAwsApiSession("STS", []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleValidEndpoint,
+			})
+			defer closeSts()
+
+			roleARN := servicemocks.MockRolesAnywhereRoleArn
+			if testCase.RoleARN != "" {
+				roleARN = testCase.RoleARN
+			}
+
+			config := &Config{
+				AssumeRole: testCase.AssumeRole,
+				IAMRolesAnywhere: &IAMRolesAnywhere{
+					CertificateFile: certFile,
+					PrivateKeyFile:  keyFile,
+					TrustAnchorARN:  servicemocks.MockRolesAnywhereTrustAnchorArn,
+					ProfileARN:      servicemocks.MockRolesAnywhereProfileArn,
+					RoleARN:         roleARN,
+				},
+				Region:                "us-east-1",
+				RolesAnywhereEndpoint: rolesAnywhereEndpoint,
+				SkipCredsValidation:   true,
+				StsEndpoint:           stsEndpoint,
+			}
+
+			if testCase.StaticCredentials {
+				config.AccessKey = servicemocks.MockStaticAccessKey
+				config.SecretKey = servicemocks.MockStaticSecretKey
+			}
+
+			ctx, awsConfig, diags := GetAwsConfig(ctx, config)
+
+			if testCase.ExpectedErr != nil {
+				if !diags.HasError() {
+					t.Fatal("expected error, got none")
+				}
+				d := diags.Errors()[0]
+				if !IsCannotCreateRolesAnywhereSessionError(d) {
+					t.Fatalf("expected CannotCreateRolesAnywhereSessionError, got: %#v", d)
+				}
+				testCase.ExpectedErr(t, d.(cannotCreateRolesAnywhereSessionError).Err())
+				return
+			}
+			if diff := cmp.Diff(diags, testCase.ExpectedDiags); diff != "" {
+				t.Fatalf("Unexpected response (+wanted, -got): %s", diff)
+			}
+
+			credentialsValue, err := awsConfig.Credentials.Retrieve(ctx)
+			if err != nil {
+				t.Fatalf("unexpected credentials Retrieve() error: %s", err)
+			}
+
+			if diff := cmp.Diff(credentialsValue, testCase.ExpectedCredentialsValue, cmpopts.IgnoreFields(aws.Credentials{}, "Expires")); diff != "" {
+				t.Fatalf("unexpected credentials: (- got, + expected)\n%s", diff)
+			}
+
+			_, source, _ := getCredentialsProvider(ctx, config)
+			if a, e := source, testCase.ExpectedCredentialsSource; a != e {
+				t.Errorf("expected credentials source %q, got: %q", e, a)
+			}
+		})
+	}
+}
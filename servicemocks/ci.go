@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package servicemocks
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+)
+
+const (
+	MockGitHubActionsIDTokenRequestToken = `GitHubActionsIDTokenRequestToken`
+)
+
+// MockCIIDToken returns an unsigned JSON Web Token for the audience, as issued by a CI system.
+func MockCIIDToken(audience string) string {
+	header, _ := json.Marshal(map[string]string{
+		"alg": "none",
+		"typ": "JWT",
+	})
+	payload, _ := json.Marshal(map[string]any{
+		"aud": audience,
+		"iss": "https://ci.example.com",
+		"sub": "repo:example/example:ref:refs/heads/main",
+	})
+
+	return fmt.Sprintf("%s.%s.", base64.RawURLEncoding.EncodeToString(header), base64.RawURLEncoding.EncodeToString(payload))
+}
+
+// GitHubActionsIDTokenApiMock establishes a httptest server to mock out the GitHub Actions OIDC token API
+// and sets the GitHub Actions environment variables. Tokens are issued for the requested audience.
+func GitHubActionsIDTokenApiMock() func() {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		log.Printf("[DEBUG] Mock GitHub Actions ID token server received request: %s", r.RequestURI)
+		if r.Method != http.MethodGet || r.URL.Path != "/token" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("Authorization") != "bearer "+MockGitHubActionsIDTokenRequestToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		audience := r.URL.Query().Get("audience")
+		if audience == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"value": MockCIIDToken(audience),
+		})
+	}))
+
+	os.Setenv("GITHUB_ACTIONS", "true")
+	os.Setenv("ACTIONS_ID_TOKEN_REQUEST_URL", ts.URL+"/token?api-version=2.0")
+	os.Setenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN", MockGitHubActionsIDTokenRequestToken)
+	return func() {
+		ts.Close()
+		os.Unsetenv("GITHUB_ACTIONS")
+		os.Unsetenv("ACTIONS_ID_TOKEN_REQUEST_URL")
+		os.Unsetenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN")
+	}
+}
+
+// GitLabIDTokenMock sets the GitLab CI environment variables, with an ID token for the audience in envVar.
+func GitLabIDTokenMock(envVar, audience string) func() {
+	os.Setenv("GITLAB_CI", "true")
+	os.Setenv(envVar, MockCIIDToken(audience))
+	return func() {
+		os.Unsetenv("GITLAB_CI")
+		os.Unsetenv(envVar)
+	}
+}
+
+// TerraformCloudWorkloadIdentityTokenMock sets the Terraform Cloud run environment variables,
+// with a workload identity token for the audience in envVar.
+func TerraformCloudWorkloadIdentityTokenMock(envVar, audience string) func() {
+	os.Setenv("TFC_RUN_ID", "run-MockTerraformCloudRun")
+	os.Setenv(envVar, MockCIIDToken(audience))
+	return func() {
+		os.Unsetenv("TFC_RUN_ID")
+		os.Unsetenv(envVar)
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package servicemocks
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/internal/rolesanywhere"
+)
+
+const (
+	MockRolesAnywhereAccessKey      = `RolesAnywhereAccessKey`
+	MockRolesAnywhereProfileArn     = `arn:aws:rolesanywhere:us-east-1:777777777777:profile/00000000-0000-0000-0000-000000000000`
+	MockRolesAnywhereRoleArn        = `arn:aws:iam::777777777777:role/RolesAnywhere`
+	MockRolesAnywhereSecretKey      = `RolesAnywhereSecretKey`
+	MockRolesAnywhereSessionToken   = `RolesAnywhereSessionToken`
+	MockRolesAnywhereTrustAnchorArn = `arn:aws:rolesanywhere:us-east-1:777777777777:trust-anchor/11111111-1111-1111-1111-111111111111`
+)
+
+// RolesAnywhereCertificateFiles writes a self-signed certificate and its private key to PEM files in dir.
+// If ec is true, the key is an ECDSA P-256 key; otherwise it is a 2048-bit RSA key.
+func RolesAnywhereCertificateFiles(dir string, ec bool) (string, string, error) {
+	var key crypto.Signer
+	var err error
+	if ec {
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	} else {
+		key, err = rsa.GenerateKey(rand.Reader, 2048) //nolint:mnd
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "aws-sdk-go-base"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		return "", "", err
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", "", err
+	}
+
+	certFile, keyFile := filepath.Join(dir, "certificate.pem"), filepath.Join(dir, "private-key.pem")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil { //nolint:mnd
+		return "", "", err
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}), 0600); err != nil { //nolint:mnd
+		return "", "", err
+	}
+
+	return certFile, keyFile, nil
+}
+
+// RolesAnywhereApiMock establishes a httptest server to mock out the IAM Roles Anywhere CreateSession API.
+// Requests must be for the mock trust anchor, profile, and role, and be signed with the private key
+// of the certificate in the X-Amz-X509 header.
+func RolesAnywhereApiMock() (func(), string) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		log.Printf("[DEBUG] Mock IAM Roles Anywhere server received request: %s %s", r.Method, r.RequestURI)
+
+		buf := new(bytes.Buffer)
+		if _, err := buf.ReadFrom(r.Body); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		body := buf.Bytes()
+
+		if r.Method != http.MethodPost || r.URL.Path != "/sessions" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if err := verifyRolesAnywhereSignature(r, body); err != nil {
+			log.Printf("[DEBUG] Mock IAM Roles Anywhere server rejected request: %s", err)
+			w.Header().Set("X-Amzn-Errortype", "AccessDeniedException")
+			w.WriteHeader(http.StatusForbidden)
+			_ = json.NewEncoder(w).Encode(map[string]string{"message": err.Error()})
+			return
+		}
+
+		var input struct {
+			DurationSeconds int    `json:"durationSeconds"`
+			ProfileARN      string `json:"profileArn"`
+			RoleARN         string `json:"roleArn"`
+			TrustAnchorARN  string `json:"trustAnchorArn"`
+		}
+		if err := json.Unmarshal(body, &input); err != nil ||
+			input.ProfileARN != MockRolesAnywhereProfileArn ||
+			input.RoleARN != MockRolesAnywhereRoleArn ||
+			input.TrustAnchorARN != MockRolesAnywhereTrustAnchorArn {
+			w.Header().Set("X-Amzn-Errortype", "ValidationException")
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"message": "invalid request"})
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"credentialSet": []any{
+				map[string]any{
+					"credentials": map[string]string{
+						"accessKeyId":     MockRolesAnywhereAccessKey,
+						"secretAccessKey": MockRolesAnywhereSecretKey,
+						"sessionToken":    MockRolesAnywhereSessionToken,
+						"expiration":      time.Now().UTC().Add(time.Duration(input.DurationSeconds) * time.Second).Format(time.RFC3339),
+					},
+					"roleArn": MockRolesAnywhereRoleArn,
+				},
+			},
+		})
+	}))
+
+	return ts.Close, ts.URL
+}
+
+func verifyRolesAnywhereSignature(r *http.Request, body []byte) error {
+	der, err := base64.StdEncoding.DecodeString(r.Header.Get(rolesanywhere.HeaderX509))
+	if err != nil {
+		return fmt.Errorf("decoding certificate: %w", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return fmt.Errorf("parsing certificate: %w", err)
+	}
+
+	algorithm, params, _ := strings.Cut(r.Header.Get("Authorization"), " ")
+	fields := make(map[string]string)
+	for _, param := range strings.Split(params, ", ") {
+		k, v, _ := strings.Cut(param, "=")
+		fields[k] = v
+	}
+
+	serial, scope, _ := strings.Cut(fields["Credential"], "/")
+	if serial != cert.SerialNumber.String() {
+		return fmt.Errorf("credential serial number %q does not match certificate", serial)
+	}
+	signedHeaders, stringToSign := rolesanywhere.StringToSign(r, body, algorithm, scope)
+	if signedHeaders != fields["SignedHeaders"] {
+		return fmt.Errorf("signed headers %q, expected %q", fields["SignedHeaders"], signedHeaders)
+	}
+	signature, err := hex.DecodeString(fields["Signature"])
+	if err != nil {
+		return fmt.Errorf("decoding signature: %w", err)
+	}
+
+	digest := sha256.Sum256([]byte(stringToSign))
+	switch pub := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		if algorithm != "AWS4-X509-RSA-SHA256" {
+			return fmt.Errorf("algorithm %q does not match RSA key", algorithm)
+		}
+		if err := rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], signature); err != nil {
+			return fmt.Errorf("verifying signature: %w", err)
+		}
+	case *ecdsa.PublicKey:
+		if algorithm != "AWS4-X509-ECDSA-SHA256" {
+			return fmt.Errorf("algorithm %q does not match ECDSA key", algorithm)
+		}
+		if !ecdsa.VerifyASN1(pub, digest[:], signature) {
+			return errors.New("verifying signature: invalid signature")
+		}
+	default:
+		return fmt.Errorf("unsupported public key type %T", pub)
+	}
+
+	return nil
+}
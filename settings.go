@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package awsbase
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/aws-sdk-go-base/v2/internal/awsconfig"
+	"github.com/hashicorp/aws-sdk-go-base/v2/logging"
+)
+
+// EffectiveSetting is a setting in a resolved aws.Config and the source it was resolved from.
+type EffectiveSetting = awsconfig.Setting
+
+// SettingSource is where an effective setting was resolved from.
+type SettingSource = awsconfig.Source
+
+// Kinds of setting source.
+const (
+	SettingSourceProviderConfig      = awsconfig.SourceKindProviderConfig
+	SettingSourceEnvironmentVariable = awsconfig.SourceKindEnvironmentVariable
+	SettingSourceSharedConfig        = awsconfig.SourceKindSharedConfig
+	SettingSourceEC2InstanceMetadata = awsconfig.SourceKindEC2InstanceMetadata
+	SettingSourceCredentialsProvider = awsconfig.SourceKindCredentialsProvider
+	SettingSourceDefault             = awsconfig.SourceKindDefault
+)
+
+// resolvedSettings records values resolved by GetAwsConfig that cannot be determined from the aws.Config.
+type resolvedSettings struct {
+	credentials aws.Credentials
+	imdsRegion  bool
+}
+
+type resolvedSettingsKeyT string
+
+const resolvedSettingsKey resolvedSettingsKeyT = "resolved-settings-key"
+
+func withResolvedSettings(ctx context.Context, rs resolvedSettings) context.Context {
+	return context.WithValue(ctx, resolvedSettingsKey, rs)
+}
+
+// EffectiveSettings reports the effective Region, retry, FIPS and dual-stack endpoint, CA bundle, and
+// EC2 Instance Metadata Service settings in an aws.Config returned by GetAwsConfig, together with
+// the source of each: the provider configuration, an environment variable, or a shared config file and profile.
+// The access key ID and provider of the credentials retrieved by GetAwsConfig are also reported,
+// so ctx must be the context returned by GetAwsConfig. Credentials are not retrieved again.
+//
+// Credentials in values are masked.
+func EffectiveSettings(ctx context.Context, awsConfig aws.Config, c *Config) []EffectiveSetting {
+	settings := awsconfig.ResolveSettings(awsConfig.ConfigSources)
+
+	rs, ok := ctx.Value(resolvedSettingsKey).(resolvedSettings)
+
+	for i, s := range settings {
+		switch s.Name {
+		case "region":
+			if s.Source.Kind == SettingSourceDefault && awsConfig.Region != "" {
+				s.Value = awsConfig.Region
+				// The Region is retrieved from EC2 instance metadata when using EC2 instance role credentials
+				// and no other Region is set.
+				if rs.imdsRegion {
+					s.Source = SettingSource{Kind: SettingSourceEC2InstanceMetadata}
+				}
+			}
+		case "ca_bundle":
+			if s.Source.Kind == SettingSourceProviderConfig {
+				s.Value = c.CustomCABundle
+			}
+		}
+		s.Value = redactSettingValue(s.Value)
+		settings[i] = s
+	}
+
+	if ok && rs.credentials.HasKeys() {
+		settings = append(settings, EffectiveSetting{
+			Name:   "credentials",
+			Value:  string(logging.MaskAWSAccessKey([]byte(rs.credentials.AccessKeyID))),
+			Source: SettingSource{Kind: SettingSourceCredentialsProvider, Provider: rs.credentials.Source},
+		})
+	}
+
+	return settings
+}
+
+func redactSettingValue(s string) string {
+	return logging.MaskAWSSensitiveValues(logging.MaskURLCredentials(s))
+}
@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package awsbase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http/httptrace"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/diag"
+)
+
+// Phases of configuration and credential resolution.
+const (
+	phaseSharedConfigLoad           = "shared config load"
+	phaseCredentialsRetrieval       = "credentials retrieval"
+	phaseAssumeRoleWithWebIdentity  = "assume role with web identity"
+	phaseRolesAnywhereCreateSession = "IAM Roles Anywhere create session"
+	phaseIdentityValidation         = "identity validation"
+)
+
+func phaseAssumeRole(i, total int) string {
+	return fmt.Sprintf("assume role %d of %d", i+1, total)
+}
+
+// phaseTimeoutError occurs when a phase of configuration and credential resolution does not complete before its deadline.
+type phaseTimeoutError struct {
+	phase    string
+	timeout  time.Duration
+	endpoint string
+	err      error
+}
+
+func (e phaseTimeoutError) Error() string {
+	return fmt.Sprintf("%s timed out: %s", e.phase, e.err)
+}
+
+func (e phaseTimeoutError) Severity() diag.Severity {
+	return diag.SeverityError
+}
+
+func (e phaseTimeoutError) Summary() string {
+	return "Timeout resolving AWS configuration"
+}
+
+func (e phaseTimeoutError) Detail() string {
+	var waiting string
+	if e.endpoint != "" {
+		waiting = fmt.Sprintf(" while waiting on the endpoint %q", e.endpoint)
+	}
+
+	var deadline string
+	if e.timeout > 0 {
+		deadline = fmt.Sprintf("The %s phase did not complete within %s", e.phase, e.timeout)
+	} else {
+		deadline = fmt.Sprintf("The deadline for resolving AWS configuration was reached during the %s phase", e.phase)
+	}
+
+	return fmt.Sprintf("%s%s.\n\nError: %s", deadline, waiting, e.err)
+}
+
+func (e phaseTimeoutError) Equal(other diag.Diagnostic) bool {
+	ed, ok := other.(phaseTimeoutError)
+	if !ok {
+		return false
+	}
+
+	return ed.Summary() == e.Summary() && ed.Detail() == e.Detail()
+}
+
+func (e phaseTimeoutError) Err() error {
+	return e.err
+}
+
+var _ diag.DiagnosticWithErr = phaseTimeoutError{}
+
+// IsPhaseTimeoutError returns true if the diagnostic is a timeout in a phase of configuration and credential resolution.
+func IsPhaseTimeoutError(diag diag.Diagnostic) bool {
+	_, ok := diag.(phaseTimeoutError)
+	return ok
+}
+
+// runPhase runs f, limited to the phase's timeout, if any.
+// If the phase's deadline or the deadline of ctx is exceeded, a phaseTimeoutError naming the phase
+// and the last endpoint connected to is returned.
+func runPhase(ctx context.Context, phase string, timeout time.Duration, f func(context.Context) error) error {
+	var tracker endpointTracker
+	ctx = httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		GetConn: tracker.set,
+	})
+
+	parent := ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	err := f(ctx)
+	if err == nil || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return err
+	}
+
+	e := phaseTimeoutError{
+		phase:    phase,
+		endpoint: tracker.get(),
+		err:      err,
+	}
+	// Only report the phase's timeout if it was the deadline that was reached.
+	if parent.Err() == nil {
+		e.timeout = timeout
+	}
+	return e
+}
+
+// asPhaseTimeoutError returns the phaseTimeoutError in err's tree, if any.
+func asPhaseTimeoutError(err error) (phaseTimeoutError, bool) {
+	var e phaseTimeoutError
+	ok := errors.As(err, &e)
+	return e, ok
+}
+
+// endpointTracker records the host and port of the last connection requested.
+type endpointTracker struct {
+	mu       sync.Mutex
+	hostPort string
+}
+
+func (t *endpointTracker) set(hostPort string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.hostPort = hostPort
+}
+
+func (t *endpointTracker) get() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.hostPort
+}
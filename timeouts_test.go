@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package awsbase
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/servicemocks"
+)
+
+func TestPhaseTimeouts(t *testing.T) {
+	testCases := map[string]struct {
+		Config         *Config
+		ExpectedDetail string
+	}{
+		"identity validation": {
+			Config: &Config{
+				Timeouts: Timeouts{
+					IdentityValidation: 100 * time.Millisecond,
+				},
+			},
+			ExpectedDetail: `The identity validation phase did not complete within 100ms while waiting on the endpoint "%s".`,
+		},
+
+		"assume role": {
+			Config: &Config{
+				AssumeRole: []AssumeRole{
+					{
+						RoleARN:     servicemocks.MockStsAssumeRoleArn,
+						SessionName: servicemocks.MockStsAssumeRoleSessionName,
+					},
+				},
+				SkipCredsValidation: true,
+				Timeouts: Timeouts{
+					AssumeRole: 100 * time.Millisecond,
+				},
+			},
+			ExpectedDetail: `The assume role 1 of 1 phase did not complete within 100ms while waiting on the endpoint "%s".`,
+		},
+
+		"total": {
+			Config: &Config{
+				Timeouts: Timeouts{
+					Total:              100 * time.Millisecond,
+					IdentityValidation: time.Minute,
+				},
+			},
+			ExpectedDetail: `The deadline for resolving AWS configuration was reached during the identity validation phase while waiting on the endpoint "%s".`,
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			servicemocks.InitSessionTestEnv(t)
+
+			// The STS server does not respond until the test completes.
+			done := make(chan struct{})
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				<-done
+			}))
+			defer ts.Close()
+			defer close(done)
+
+			testCase.Config.AccessKey = servicemocks.MockStaticAccessKey
+			testCase.Config.SecretKey = servicemocks.MockStaticSecretKey
+			testCase.Config.Region = "us-east-1"
+			testCase.Config.StsEndpoint = ts.URL
+
+			_, _, diags := GetAwsConfig(t.Context(), testCase.Config)
+
+			if l := len(diags); l != 1 {
+				t.Fatalf("expected 1 diagnostic, got %d: %v", l, diags)
+			}
+			d := diags[0]
+			if !IsPhaseTimeoutError(d) {
+				t.Fatalf("expected phase timeout error, got %T: %v", d, d)
+			}
+			expected := fmt.Sprintf(testCase.ExpectedDetail, strings.TrimPrefix(ts.URL, "http://"))
+			if detail := d.Detail(); !strings.HasPrefix(detail, expected) {
+				t.Errorf("expected detail to start with %q, got %q", expected, detail)
+			}
+		})
+	}
+}
+
+func TestPhaseTimeouts_webIdentityTokenCI(t *testing.T) {
+	servicemocks.InitSessionTestEnv(t)
+
+	// The GitHub Actions token server does not respond until the test completes.
+	done := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer ts.Close()
+	defer close(done)
+
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_URL", ts.URL+"/token")
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN", servicemocks.MockGitHubActionsIDTokenRequestToken)
+
+	config := &Config{
+		AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+			RoleARN:     servicemocks.MockStsAssumeRoleWithWebIdentityArn,
+			SessionName: servicemocks.MockStsAssumeRoleWithWebIdentitySessionName,
+			WebIdentityTokenCI: &WebIdentityTokenCI{
+				Provider: CIProviderGitHubActions,
+			},
+		},
+		Region:              "us-east-1",
+		SkipCredsValidation: true,
+		Timeouts: Timeouts{
+			AssumeRole: 100 * time.Millisecond,
+		},
+	}
+
+	start := time.Now()
+	_, _, diags := GetAwsConfig(t.Context(), config)
+
+	if l := len(diags); l != 1 {
+		t.Fatalf("expected 1 diagnostic, got %d: %v", l, diags)
+	}
+	if d := diags[0]; !IsPhaseTimeoutError(d) {
+		t.Fatalf("expected phase timeout error, got %T: %v", d, d)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("expected GetAwsConfig to return after the assume role timeout, took %s", elapsed)
+	}
+}
@@ -2256,6 +2256,10 @@ web_identity_token_file = no-such-file
 					"Assume Role With Web Identity",
 					"Role ARN was not set",
 				),
+				diag.NewErrorDiagnostic(
+					"Assume Role With Web Identity",
+					"One of WebIdentityToken, WebIdentityTokenFile, WebIdentityTokenCommand, WebIdentityTokenCI, WebIdentityTokenFunc must be set",
+				),
 			},
 		},
 
@@ -2269,7 +2273,7 @@ web_identity_token_file = no-such-file
 			ExpectedDiags: diag.Diagnostics{
 				diag.NewErrorDiagnostic(
 					"Assume Role With Web Identity",
-					"One of WebIdentityToken, WebIdentityTokenFile must be set",
+					"One of WebIdentityToken, WebIdentityTokenFile, WebIdentityTokenCommand, WebIdentityTokenCI, WebIdentityTokenFunc must be set",
 				),
 			},
 		},
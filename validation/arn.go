@@ -0,0 +1,152 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+)
+
+// InvalidARNError is returned when an ARN is not valid.
+type InvalidARNError struct {
+	arn    string
+	reason string
+}
+
+func (e *InvalidARNError) Error() string {
+	return fmt.Sprintf("invalid ARN (%s): %s", e.arn, e.reason)
+}
+
+// ARNOptions configures ARN validation.
+type ARNOptions struct {
+	// Partitions are the known partitions.
+	// Defaults to the partitions returned by endpoints.Partitions.
+	Partitions []endpoints.Partition
+}
+
+var (
+	accountIDRegex = regexp.MustCompile(`^\d{12}$`)
+
+	// See https://docs.aws.amazon.com/IAM/latest/APIReference/API_Role.html.
+	iamRoleResourceRegex = regexp.MustCompile(`^role/(?:[\x21-\x7E]*/)?[\w+=,.@-]{1,64}$`)
+
+	// See https://docs.aws.amazon.com/IAM/latest/APIReference/API_Policy.html.
+	iamPolicyResourceRegex = regexp.MustCompile(`^policy/(?:[\x21-\x7E]*/)?[\w+=,.@-]{1,128}$`)
+)
+
+// AccountID checks if the given value is a valid AWS account ID.
+func AccountID(s string) error {
+	if !accountIDRegex.MatchString(s) {
+		return fmt.Errorf("invalid AWS account ID (%s): must be 12 digits", s)
+	}
+
+	return nil
+}
+
+// ARN checks if the given value is a valid ARN.
+// The ARN's partition must be known, and its Region and account ID, if set, must be valid.
+func ARN(s string, optFns ...func(*ARNOptions)) error {
+	_, err := parseARN(s, optFns...)
+	return err
+}
+
+// IAMRoleARN checks if the given value is a valid IAM role ARN.
+func IAMRoleARN(s string, optFns ...func(*ARNOptions)) error {
+	a, err := parseIAMARN(s, optFns...)
+	if err != nil {
+		return err
+	}
+
+	if !accountIDRegex.MatchString(a.AccountID) {
+		return &InvalidARNError{arn: s, reason: fmt.Sprintf("account ID (%s) must be 12 digits", a.AccountID)}
+	}
+	if !iamRoleResourceRegex.MatchString(a.Resource) {
+		return &InvalidARNError{arn: s, reason: fmt.Sprintf("resource (%s) is not a valid IAM role, expected \"role/[path/]name\"", a.Resource)}
+	}
+
+	return nil
+}
+
+// IAMPolicyARN checks if the given value is a valid IAM policy ARN.
+// AWS managed policies, with the account ID "aws", are valid.
+func IAMPolicyARN(s string, optFns ...func(*ARNOptions)) error {
+	a, err := parseIAMARN(s, optFns...)
+	if err != nil {
+		return err
+	}
+
+	if a.AccountID == "" {
+		return &InvalidARNError{arn: s, reason: "account ID is required"}
+	}
+	if !iamPolicyResourceRegex.MatchString(a.Resource) {
+		return &InvalidARNError{arn: s, reason: fmt.Sprintf("resource (%s) is not a valid IAM policy, expected \"policy/[path/]name\"", a.Resource)}
+	}
+
+	return nil
+}
+
+func parseIAMARN(s string, optFns ...func(*ARNOptions)) (arn.ARN, error) {
+	a, err := parseARN(s, optFns...)
+	if err != nil {
+		return arn.ARN{}, err
+	}
+
+	if a.Service != "iam" {
+		return arn.ARN{}, &InvalidARNError{arn: s, reason: fmt.Sprintf("service (%s) must be \"iam\"", a.Service)}
+	}
+	if a.Region != "" {
+		return arn.ARN{}, &InvalidARNError{arn: s, reason: "IAM ARNs must not include a Region"}
+	}
+
+	return a, nil
+}
+
+func parseARN(s string, optFns ...func(*ARNOptions)) (arn.ARN, error) {
+	a, err := arn.Parse(s)
+	if err != nil {
+		return arn.ARN{}, &InvalidARNError{arn: s, reason: err.Error()}
+	}
+
+	var opts ARNOptions
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+	if opts.Partitions == nil {
+		ps, err := endpoints.Partitions()
+		if err != nil {
+			return arn.ARN{}, err
+		}
+		opts.Partitions = ps
+	}
+
+	i := slices.IndexFunc(opts.Partitions, func(p endpoints.Partition) bool {
+		return p.ID() == a.Partition
+	})
+	if i < 0 {
+		return arn.ARN{}, &InvalidARNError{arn: s, reason: fmt.Sprintf("unknown partition (%s)", a.Partition)}
+	}
+	partition := opts.Partitions[i]
+
+	if a.Service == "" {
+		return arn.ARN{}, &InvalidARNError{arn: s, reason: "service is required"}
+	}
+	if a.Region != "" {
+		if _, ok := endpoints.PartitionForRegion([]endpoints.Partition{partition}, a.Region); !ok {
+			return arn.ARN{}, &InvalidARNError{arn: s, reason: fmt.Sprintf("Region (%s) is not valid for partition (%s)", a.Region, a.Partition)}
+		}
+	}
+	// AWS managed resources, such as IAM policies, use "aws" as the account ID.
+	if a.AccountID != "" && a.AccountID != "aws" && !accountIDRegex.MatchString(a.AccountID) {
+		return arn.ARN{}, &InvalidARNError{arn: s, reason: fmt.Sprintf("account ID (%s) must be 12 digits", a.AccountID)}
+	}
+	if a.Resource == "" {
+		return arn.ARN{}, &InvalidARNError{arn: s, reason: "resource is required"}
+	}
+
+	return a, nil
+}
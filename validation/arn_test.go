@@ -0,0 +1,212 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+)
+
+func TestAccountID(t *testing.T) {
+	var testCases = []struct {
+		AccountID   string
+		ExpectError bool
+	}{
+		{
+			AccountID: "123456789012",
+		},
+		{
+			AccountID:   "12345678901",
+			ExpectError: true,
+		},
+		{
+			AccountID:   "12345678901a",
+			ExpectError: true,
+		},
+		{
+			AccountID:   "",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.AccountID, func(t *testing.T) {
+			err := AccountID(testCase.AccountID)
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("Expected no error, received error: %s", err)
+			}
+			if err == nil && testCase.ExpectError {
+				t.Fatal("Expected error, received none")
+			}
+		})
+	}
+}
+
+func TestARN(t *testing.T) {
+	var testCases = []struct {
+		ARN         string
+		ExpectError bool
+	}{
+		{
+			ARN: "arn:aws:s3:::bucket",
+		},
+		{
+			ARN: "arn:aws:ec2:us-west-2:123456789012:instance/i-12345678",
+		},
+		{
+			ARN: "arn:aws-cn:ec2:cn-north-1:123456789012:instance/i-12345678",
+		},
+		{
+			// Matches the partition's Region pattern.
+			ARN: "arn:aws:ec2:us-east-17:123456789012:instance/i-12345678",
+		},
+		{
+			ARN:         "not-an-arn",
+			ExpectError: true,
+		},
+		{
+			ARN:         "arn:aws-unknown:ec2:us-west-2:123456789012:instance/i-12345678",
+			ExpectError: true,
+		},
+		{
+			ARN:         "arn:aws:ec2:cn-north-1:123456789012:instance/i-12345678",
+			ExpectError: true,
+		},
+		{
+			ARN:         "arn:aws:ec2:us-west-2:1234:instance/i-12345678",
+			ExpectError: true,
+		},
+		{
+			ARN:         "arn:aws::us-west-2:123456789012:instance/i-12345678",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.ARN, func(t *testing.T) {
+			err := ARN(testCase.ARN)
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("Expected no error, received error: %s", err)
+			}
+			if err == nil && testCase.ExpectError {
+				t.Fatal("Expected error, received none")
+			}
+
+			var arnErr *InvalidARNError
+			if err != nil && !errors.As(err, &arnErr) {
+				t.Errorf("Expected InvalidARNError, got %T", err)
+			}
+		})
+	}
+}
+
+func TestIAMRoleARN(t *testing.T) {
+	var testCases = []struct {
+		ARN         string
+		ExpectError bool
+	}{
+		{
+			ARN: "arn:aws:iam::123456789012:role/Role",
+		},
+		{
+			ARN: "arn:aws:iam::123456789012:role/path/to/Role+=,.@-_",
+		},
+		{
+			ARN: "arn:aws-us-gov:iam::123456789012:role/Role",
+		},
+		{
+			ARN:         "arn:aws:iam::123456789012:user/User",
+			ExpectError: true,
+		},
+		{
+			ARN:         "arn:aws:sts::123456789012:role/Role",
+			ExpectError: true,
+		},
+		{
+			ARN:         "arn:aws:iam:us-east-1:123456789012:role/Role",
+			ExpectError: true,
+		},
+		{
+			ARN:         "arn:aws:iam:::role/Role",
+			ExpectError: true,
+		},
+		{
+			ARN:         "arn:aws:iam::aws:role/Role",
+			ExpectError: true,
+		},
+		{
+			ARN:         "arn:aws:iam::123456789012:role/Role Name",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.ARN, func(t *testing.T) {
+			err := IAMRoleARN(testCase.ARN)
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("Expected no error, received error: %s", err)
+			}
+			if err == nil && testCase.ExpectError {
+				t.Fatal("Expected error, received none")
+			}
+		})
+	}
+}
+
+func TestIAMPolicyARN(t *testing.T) {
+	var testCases = []struct {
+		ARN         string
+		ExpectError bool
+	}{
+		{
+			ARN: "arn:aws:iam::123456789012:policy/Policy",
+		},
+		{
+			ARN: "arn:aws:iam::aws:policy/ReadOnlyAccess",
+		},
+		{
+			ARN: "arn:aws:iam::aws:policy/service-role/AWSLambdaBasicExecutionRole",
+		},
+		{
+			ARN:         "arn:aws:iam::123456789012:role/Role",
+			ExpectError: true,
+		},
+		{
+			ARN:         "arn:aws:iam::123456789012:policy/",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.ARN, func(t *testing.T) {
+			err := IAMPolicyARN(testCase.ARN)
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("Expected no error, received error: %s", err)
+			}
+			if err == nil && testCase.ExpectError {
+				t.Fatal("Expected error, received none")
+			}
+		})
+	}
+}
+
+func TestARN_partitions(t *testing.T) {
+	p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), "us-east-1")
+	if !ok {
+		t.Fatal("partition for Region \"us-east-1\" not found")
+	}
+
+	withPartitions := func(opts *ARNOptions) {
+		opts.Partitions = []endpoints.Partition{p}
+	}
+
+	if err := ARN("arn:aws:s3:::bucket", withPartitions); err != nil {
+		t.Errorf("Expected no error, received error: %s", err)
+	}
+	if err := ARN("arn:aws-cn:s3:::bucket", withPartitions); err == nil {
+		t.Error("Expected error, received none")
+	}
+}
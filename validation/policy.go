@@ -0,0 +1,377 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validation
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// SessionPolicyMaxLength is the maximum length, in characters, of an inline session policy
+// after whitespace outside of strings is removed.
+//
+// See https://docs.aws.amazon.com/STS/latest/APIReference/API_AssumeRole.html.
+const SessionPolicyMaxLength = 2048
+
+// PolicyElementError is returned when an element of an IAM policy document is not valid
+type PolicyElementError struct {
+	path   []string
+	reason string
+}
+
+func (e *PolicyElementError) Error() string {
+	if len(e.path) == 0 {
+		return fmt.Sprintf("invalid policy: %s", e.reason)
+	}
+	return fmt.Sprintf(`invalid policy element "%s": %s`, strings.Join(e.path, "."), e.reason)
+}
+
+// Path returns the path of the element, such as "Statement.0.Effect".
+func (e *PolicyElementError) Path() string {
+	return strings.Join(e.path, ".")
+}
+
+// PolicySizeError is returned when a session policy exceeds the maximum length
+type PolicySizeError struct {
+	length int
+}
+
+func (e *PolicySizeError) Error() string {
+	return fmt.Sprintf("session policy is %d characters after removing whitespace, maximum is %d", e.length, SessionPolicyMaxLength)
+}
+
+// Length returns the length of the policy after whitespace is removed.
+func (e *PolicySizeError) Length() int {
+	return e.length
+}
+
+var (
+	policyVersions = []string{"2012-10-17", "2008-10-17"}
+
+	// Service prefix and action name, either of which may contain wildcards.
+	policyActionRegex = regexp.MustCompile(`^(?:\*|[a-zA-Z0-9*?-]+:[a-zA-Z0-9*?]+)$`)
+
+	// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_condition_operators.html.
+	policyConditionOperators = []string{
+		"StringEquals", "StringNotEquals", "StringEqualsIgnoreCase", "StringNotEqualsIgnoreCase", "StringLike", "StringNotLike",
+		"NumericEquals", "NumericNotEquals", "NumericLessThan", "NumericLessThanEquals", "NumericGreaterThan", "NumericGreaterThanEquals",
+		"DateEquals", "DateNotEquals", "DateLessThan", "DateLessThanEquals", "DateGreaterThan", "DateGreaterThanEquals",
+		"Bool",
+		"BinaryEquals",
+		"IpAddress", "NotIpAddress",
+		"ArnEquals", "ArnLike", "ArnNotEquals", "ArnNotLike",
+		"Null",
+	}
+
+	policyPrincipalTypes = []string{"AWS", "CanonicalUser", "Federated", "Service"}
+)
+
+// IAMPolicyDocument checks that the given value is a well-formed IAM policy document.
+//
+// The policy's Version, and the structure of each statement's Effect, Action, Resource,
+// Principal, and Condition elements are checked, as are condition operator names.
+// Duplicate keys are reported as DuplicateKeyError, and other problems as PolicyElementError.
+func IAMPolicyDocument(s string) error {
+	return checkPolicyDocument(s, false)
+}
+
+// SessionPolicy checks that the given value is a well-formed inline session policy,
+// such as the policy passed when assuming an IAM role.
+//
+// In addition to the checks made by IAMPolicyDocument, session policies must not
+// contain Principal or NotPrincipal elements and must not exceed SessionPolicyMaxLength characters
+// once whitespace is removed.
+func SessionPolicy(s string) error {
+	if err := checkPolicyDocument(s, true); err != nil {
+		return err
+	}
+
+	return SessionPolicySize(s)
+}
+
+// SessionPolicySize checks that the given session policy does not exceed SessionPolicyMaxLength characters
+// once whitespace is removed, without checking the policy's grammar.
+func SessionPolicySize(s string) error {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, []byte(s)); err != nil {
+		return fmt.Errorf("unmarshaling input: %w", err)
+	}
+	if n := len([]rune(buf.String())); n > SessionPolicyMaxLength {
+		return &PolicySizeError{length: n}
+	}
+
+	return nil
+}
+
+func checkPolicyDocument(s string, session bool) error {
+	if err := JSONNoDuplicateKeys(s); err != nil {
+		return err
+	}
+
+	var doc map[string]any
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	if err := dec.Decode(&doc); err != nil {
+		return fmt.Errorf("unmarshaling input: %w", err)
+	}
+
+	var errs []error
+	for _, key := range sortedKeys(doc) {
+		switch key {
+		case "Version", "Id", "Statement":
+		default:
+			errs = append(errs, &PolicyElementError{path: []string{key}, reason: "unsupported element"})
+		}
+	}
+
+	if v, ok := doc["Version"]; ok {
+		if version, ok := v.(string); !ok || !slices.Contains(policyVersions, version) {
+			errs = append(errs, &PolicyElementError{path: []string{"Version"}, reason: fmt.Sprintf("must be one of %q", policyVersions)})
+		}
+	}
+	if v, ok := doc["Id"]; ok {
+		if _, ok := v.(string); !ok {
+			errs = append(errs, &PolicyElementError{path: []string{"Id"}, reason: "must be a string"})
+		}
+	}
+
+	switch v := doc["Statement"].(type) {
+	case nil:
+		errs = append(errs, &PolicyElementError{path: []string{"Statement"}, reason: "is required"})
+	case map[string]any:
+		errs = append(errs, checkPolicyStatement(v, []string{"Statement"}, session)...)
+	case []any:
+		if len(v) == 0 {
+			errs = append(errs, &PolicyElementError{path: []string{"Statement"}, reason: "must contain at least one statement"})
+		}
+		for i, stmt := range v {
+			path := []string{"Statement", strconv.Itoa(i)}
+			if stmt, ok := stmt.(map[string]any); ok {
+				errs = append(errs, checkPolicyStatement(stmt, path, session)...)
+			} else {
+				errs = append(errs, &PolicyElementError{path: path, reason: "must be an object"})
+			}
+		}
+	default:
+		errs = append(errs, &PolicyElementError{path: []string{"Statement"}, reason: "must be an object or an array of objects"})
+	}
+
+	return errors.Join(errs...)
+}
+
+func checkPolicyStatement(stmt map[string]any, path []string, session bool) []error {
+	var errs []error
+
+	elementPath := func(key string) []string {
+		return append(slices.Clone(path), key)
+	}
+
+	for _, key := range sortedKeys(stmt) {
+		switch key {
+		case "Sid", "Effect", "Action", "NotAction", "Resource", "NotResource", "Condition":
+		case "Principal", "NotPrincipal":
+			if session {
+				errs = append(errs, &PolicyElementError{path: elementPath(key), reason: "not allowed in session policies"})
+			}
+		default:
+			errs = append(errs, &PolicyElementError{path: elementPath(key), reason: "unsupported element"})
+		}
+	}
+
+	if v, ok := stmt["Sid"]; ok {
+		if _, ok := v.(string); !ok {
+			errs = append(errs, &PolicyElementError{path: elementPath("Sid"), reason: "must be a string"})
+		}
+	}
+
+	switch v, ok := stmt["Effect"]; {
+	case !ok:
+		errs = append(errs, &PolicyElementError{path: elementPath("Effect"), reason: "is required"})
+	case v != "Allow" && v != "Deny":
+		errs = append(errs, &PolicyElementError{path: elementPath("Effect"), reason: `must be "Allow" or "Deny"`})
+	}
+
+	errs = append(errs, checkPolicyElementPair(stmt, path, "Action", "NotAction", true, func(p []string, s string) error {
+		if !policyActionRegex.MatchString(s) {
+			return &PolicyElementError{path: p, reason: fmt.Sprintf(`action (%s) must be "*" or "service:action"`, s)}
+		}
+		return nil
+	})...)
+	errs = append(errs, checkPolicyElementPair(stmt, path, "Resource", "NotResource", false, func(p []string, s string) error {
+		if s != "*" && !strings.HasPrefix(s, "arn:") {
+			return &PolicyElementError{path: p, reason: fmt.Sprintf(`resource (%s) must be "*" or an ARN`, s)}
+		}
+		return nil
+	})...)
+
+	if _, ok := stmt["Principal"]; ok {
+		if _, ok := stmt["NotPrincipal"]; ok {
+			errs = append(errs, &PolicyElementError{path: path, reason: "only one of Principal or NotPrincipal may be set"})
+		}
+	}
+	if !session {
+		for _, key := range []string{"Principal", "NotPrincipal"} {
+			if v, ok := stmt[key]; ok {
+				errs = append(errs, checkPolicyPrincipal(v, elementPath(key))...)
+			}
+		}
+	}
+
+	if v, ok := stmt["Condition"]; ok {
+		errs = append(errs, checkPolicyCondition(v, elementPath("Condition"))...)
+	}
+
+	return errs
+}
+
+// checkPolicyElementPair checks mutually exclusive elements, such as Action and NotAction,
+// whose values are a string or an array of strings.
+func checkPolicyElementPair(stmt map[string]any, path []string, key, notKey string, required bool, check func([]string, string) error) []error {
+	var errs []error
+
+	v, ok := stmt[key]
+	notV, notOK := stmt[notKey]
+	switch {
+	case ok && notOK:
+		return []error{&PolicyElementError{path: path, reason: fmt.Sprintf("only one of %s or %s may be set", key, notKey)}}
+	case !ok && !notOK:
+		if required {
+			errs = append(errs, &PolicyElementError{path: path, reason: fmt.Sprintf("one of %s or %s is required", key, notKey)})
+		}
+		return errs
+	case notOK:
+		key, v = notKey, notV
+	}
+
+	path = append(slices.Clone(path), key)
+	values, err := policyStrings(v, path)
+	if err != nil {
+		return []error{err}
+	}
+	for i, s := range values {
+		p := path
+		if _, ok := v.([]any); ok {
+			p = append(slices.Clone(path), strconv.Itoa(i))
+		}
+		if err := check(p, s); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+func checkPolicyPrincipal(v any, path []string) []error {
+	if v == "*" {
+		return nil
+	}
+
+	principals, ok := v.(map[string]any)
+	if !ok {
+		return []error{&PolicyElementError{path: path, reason: `must be "*" or an object`}}
+	}
+
+	var errs []error
+	for _, typ := range sortedKeys(principals) {
+		p := append(slices.Clone(path), typ)
+		if !slices.Contains(policyPrincipalTypes, typ) {
+			errs = append(errs, &PolicyElementError{path: p, reason: fmt.Sprintf("principal type must be one of %q", policyPrincipalTypes)})
+			continue
+		}
+		if _, err := policyStrings(principals[typ], p); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+func checkPolicyCondition(v any, path []string) []error {
+	operators, ok := v.(map[string]any)
+	if !ok {
+		return []error{&PolicyElementError{path: path, reason: "must be an object"}}
+	}
+
+	var errs []error
+	for _, operator := range sortedKeys(operators) {
+		p := append(slices.Clone(path), operator)
+		if !validConditionOperator(operator) {
+			errs = append(errs, &PolicyElementError{path: p, reason: "unknown condition operator"})
+			continue
+		}
+
+		keys, ok := operators[operator].(map[string]any)
+		if !ok {
+			errs = append(errs, &PolicyElementError{path: p, reason: "must be an object"})
+			continue
+		}
+		for _, key := range sortedKeys(keys) {
+			switch value := keys[key].(type) {
+			case string, bool, json.Number:
+			case []any:
+				for i, item := range value {
+					switch item.(type) {
+					case string, bool, json.Number:
+					default:
+						errs = append(errs, &PolicyElementError{path: append(slices.Clone(p), key, strconv.Itoa(i)), reason: "must be a string, number, or boolean"})
+					}
+				}
+			default:
+				errs = append(errs, &PolicyElementError{path: append(slices.Clone(p), key), reason: "must be a string, number, boolean, or an array of them"})
+			}
+		}
+	}
+
+	return errs
+}
+
+// validConditionOperator returns whether the condition operator is known.
+// Operators may have a "ForAllValues:" or "ForAnyValue:" set operator prefix and an "IfExists" suffix.
+func validConditionOperator(operator string) bool {
+	if s, ok := strings.CutPrefix(operator, "ForAllValues:"); ok {
+		operator = s
+	} else if s, ok := strings.CutPrefix(operator, "ForAnyValue:"); ok {
+		operator = s
+	}
+	// "Null" does not support the IfExists suffix.
+	if s, ok := strings.CutSuffix(operator, "IfExists"); ok && s != "Null" {
+		operator = s
+	}
+
+	return slices.Contains(policyConditionOperators, operator)
+}
+
+// policyStrings returns the values of an element that is a string or an array of strings.
+func policyStrings(v any, path []string) ([]string, error) {
+	switch v := v.(type) {
+	case string:
+		return []string{v}, nil
+	case []any:
+		if len(v) == 0 {
+			return nil, &PolicyElementError{path: path, reason: "must not be empty"}
+		}
+		values := make([]string, 0, len(v))
+		for i, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, &PolicyElementError{path: append(slices.Clone(path), strconv.Itoa(i)), reason: "must be a string"}
+			}
+			values = append(values, s)
+		}
+		return values, nil
+	default:
+		return nil, &PolicyElementError{path: path, reason: "must be a string or an array of strings"}
+	}
+}
+
+func sortedKeys(m map[string]any) []string {
+	return slices.Sorted(maps.Keys(m))
+}
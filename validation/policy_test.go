@@ -0,0 +1,269 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validation
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestIAMPolicyDocument(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		wantErr string
+	}{
+		{
+			name: "valid",
+			s: `{
+  "Version": "2012-10-17",
+  "Id": "example",
+  "Statement": [
+    {
+      "Sid": "AllowPassRole",
+      "Effect": "Allow",
+      "Action": ["iam:PassRole", "iam:Get*"],
+      "Resource": "arn:aws:iam::123456789012:role/*",
+      "Condition": {
+        "StringEquals": {
+          "iam:PassedToService": ["cloudwatch.amazonaws.com", "ec2.amazonaws.com"]
+        },
+        "ForAnyValue:StringLikeIfExists": {
+          "aws:TagKeys": "env*"
+        },
+        "Bool": {
+          "aws:SecureTransport": true
+        },
+        "NumericLessThan": {
+          "s3:max-keys": 10
+        }
+      }
+    },
+    {
+      "Effect": "Deny",
+      "NotAction": "s3:*",
+      "NotResource": "*",
+      "Principal": {
+        "AWS": ["arn:aws:iam::123456789012:root"],
+        "Service": "ec2.amazonaws.com"
+      }
+    }
+  ]
+}`,
+		},
+		{
+			name: "single statement object",
+			s:    `{"Version": "2008-10-17", "Statement": {"Effect": "Allow", "Action": "*", "Resource": "*", "Principal": "*"}}`,
+		},
+		{
+			name:    "invalid JSON",
+			s:       "{{{",
+			wantErr: "unmarshaling input",
+		},
+		{
+			name:    "duplicate keys",
+			s:       `{"Version": "2012-10-17", "Version": "2012-10-17", "Statement": {"Effect": "Allow", "Action": "*"}}`,
+			wantErr: `duplicate key "Version"`,
+		},
+		{
+			name:    "invalid version",
+			s:       `{"Version": "2012-10-18", "Statement": {"Effect": "Allow", "Action": "*"}}`,
+			wantErr: `invalid policy element "Version": must be one of ["2012-10-17" "2008-10-17"]`,
+		},
+		{
+			name:    "unsupported element",
+			s:       `{"Statement": {"Effect": "Allow", "Action": "*", "Actions": "*"}}`,
+			wantErr: `invalid policy element "Statement.Actions": unsupported element`,
+		},
+		{
+			name:    "missing statement",
+			s:       `{"Version": "2012-10-17"}`,
+			wantErr: `invalid policy element "Statement": is required`,
+		},
+		{
+			name:    "statement not an object",
+			s:       `{"Statement": ["Allow"]}`,
+			wantErr: `invalid policy element "Statement.0": must be an object`,
+		},
+		{
+			name:    "invalid effect",
+			s:       `{"Statement": [{"Effect": "Allow", "Action": "*"}, {"Effect": "allow", "Action": "*"}]}`,
+			wantErr: `invalid policy element "Statement.1.Effect": must be "Allow" or "Deny"`,
+		},
+		{
+			name:    "missing action",
+			s:       `{"Statement": {"Effect": "Allow", "Resource": "*"}}`,
+			wantErr: `invalid policy element "Statement": one of Action or NotAction is required`,
+		},
+		{
+			name:    "action and not action",
+			s:       `{"Statement": {"Effect": "Allow", "Action": "*", "NotAction": "s3:*"}}`,
+			wantErr: `invalid policy element "Statement": only one of Action or NotAction may be set`,
+		},
+		{
+			name:    "invalid action",
+			s:       `{"Statement": {"Effect": "Allow", "Action": ["s3:GetObject", "GetObject"]}}`,
+			wantErr: `invalid policy element "Statement.Action.1": action (GetObject) must be "*" or "service:action"`,
+		},
+		{
+			name:    "action not a string",
+			s:       `{"Statement": {"Effect": "Allow", "Action": 1}}`,
+			wantErr: `invalid policy element "Statement.Action": must be a string or an array of strings`,
+		},
+		{
+			name:    "invalid resource",
+			s:       `{"Statement": {"Effect": "Allow", "Action": "*", "Resource": "my-bucket"}}`,
+			wantErr: `invalid policy element "Statement.Resource": resource (my-bucket) must be "*" or an ARN`,
+		},
+		{
+			name:    "invalid principal type",
+			s:       `{"Statement": {"Effect": "Allow", "Action": "*", "Principal": {"User": "alice"}}}`,
+			wantErr: `invalid policy element "Statement.Principal.User": principal type must be one of ["AWS" "CanonicalUser" "Federated" "Service"]`,
+		},
+		{
+			name:    "unknown condition operator",
+			s:       `{"Statement": {"Effect": "Allow", "Action": "*", "Condition": {"StringEqual": {"aws:username": "alice"}}}}`,
+			wantErr: `invalid policy element "Statement.Condition.StringEqual": unknown condition operator`,
+		},
+		{
+			name:    "null if exists",
+			s:       `{"Statement": {"Effect": "Allow", "Action": "*", "Condition": {"NullIfExists": {"aws:TokenIssueTime": "true"}}}}`,
+			wantErr: `invalid policy element "Statement.Condition.NullIfExists": unknown condition operator`,
+		},
+		{
+			name:    "invalid condition value",
+			s:       `{"Statement": {"Effect": "Allow", "Action": "*", "Condition": {"StringEquals": {"aws:username": {"name": "alice"}}}}}`,
+			wantErr: `invalid policy element "Statement.Condition.StringEquals.aws:username": must be a string, number, boolean, or an array of them`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := IAMPolicyDocument(tt.s)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Expected no error, received error: %s", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("Expected error containing %q, received no error", tt.wantErr)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, received error: %s", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestIAMPolicyDocument_multipleErrors(t *testing.T) {
+	err := IAMPolicyDocument(`{"Version": "1", "Statement": {"Effect": "Maybe"}}`)
+
+	var elementErrs []string
+	for _, err := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var elementErr *PolicyElementError
+		if !errors.As(err, &elementErr) {
+			t.Fatalf("Expected PolicyElementError, received error: %s", err)
+		}
+		elementErrs = append(elementErrs, elementErr.Path())
+	}
+
+	want := []string{"Version", "Statement.Effect", "Statement"}
+	if strings.Join(elementErrs, ",") != strings.Join(want, ",") {
+		t.Errorf("Expected errors for %q, received errors for %q", want, elementErrs)
+	}
+}
+
+func TestSessionPolicy(t *testing.T) {
+	statement := `{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::my-bucket/*"}`
+
+	tests := []struct {
+		name    string
+		s       string
+		wantErr string
+	}{
+		{
+			name: "valid",
+			s:    `{"Version": "2012-10-17", "Statement": [` + statement + `]}`,
+		},
+		{
+			name:    "principal",
+			s:       `{"Version": "2012-10-17", "Statement": {"Effect": "Allow", "Action": "*", "Principal": "*"}}`,
+			wantErr: `invalid policy element "Statement.Principal": not allowed in session policies`,
+		},
+		{
+			name: "whitespace not counted",
+			s:    `{"Version": "2012-10-17", "Statement": [` + statement + strings.Repeat(" ", SessionPolicyMaxLength) + `]}`,
+		},
+		{
+			name:    "too long",
+			s:       `{"Version": "2012-10-17", "Statement": [` + strings.Repeat(statement+",", 30) + statement + `]}`,
+			wantErr: "maximum is 2048",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := SessionPolicy(tt.s)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Expected no error, received error: %s", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("Expected error containing %q, received no error", tt.wantErr)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, received error: %s", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSessionPolicySize(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		wantErr string
+	}{
+		{
+			name: "empty document",
+			s:    `{}`,
+		},
+		{
+			name: "whitespace not counted",
+			s:    `{"Statement": "` + strings.Repeat("a", SessionPolicyMaxLength-20) + `"` + strings.Repeat(" ", SessionPolicyMaxLength) + `}`,
+		},
+		{
+			name:    "too long",
+			s:       `{"Statement": "` + strings.Repeat("a", SessionPolicyMaxLength) + `"}`,
+			wantErr: "maximum is 2048",
+		},
+		{
+			name:    "invalid JSON",
+			s:       `{`,
+			wantErr: "unmarshaling input",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := SessionPolicySize(tt.s)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Expected no error, received error: %s", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("Expected error containing %q, received no error", tt.wantErr)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, received error: %s", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -5,29 +5,198 @@ package validation
 
 import (
 	"fmt"
+	"maps"
+	"regexp"
 	"slices"
+	"strings"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 )
 
+// InvalidRegionError is returned when a Region is not part of any partition.
 type InvalidRegionError struct {
-	region string
+	region      string
+	partitions  []string
+	suggestions []string
 }
 
 func (e *InvalidRegionError) Error() string {
-	return fmt.Sprintf("invalid AWS Region: %s", e.region)
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "invalid AWS Region: %s", e.region)
+	writeSuggestions(&sb, e.suggestions)
+	if len(e.partitions) > 0 {
+		fmt.Fprintf(&sb, " (checked partitions: %s)", strings.Join(e.partitions, ", "))
+	}
+
+	return sb.String()
+}
+
+// Suggestions returns the known Regions closest to the invalid Region.
+func (e *InvalidRegionError) Suggestions() []string {
+	return slices.Clone(e.suggestions)
+}
+
+// Partitions returns the IDs of the partitions that were checked.
+func (e *InvalidRegionError) Partitions() []string {
+	return slices.Clone(e.partitions)
+}
+
+// UnknownRegionError is returned when a Region matches a partition's Region naming pattern
+// but is not yet a known Region, for example a newly launched Region.
+// Callers may choose to warn rather than fail.
+//
+// UnknownRegionError wraps an *InvalidRegionError, so callers checking for *InvalidRegionError also match it.
+type UnknownRegionError struct {
+	region      string
+	partition   string
+	suggestions []string
+	err         *InvalidRegionError
+}
+
+func (e *UnknownRegionError) Error() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "unknown AWS Region: %s matches the Region pattern of partition %s but is not a known Region", e.region, e.partition)
+	writeSuggestions(&sb, e.suggestions)
+
+	return sb.String()
+}
+
+// Suggestions returns the known Regions in the partition closest to the unknown Region.
+func (e *UnknownRegionError) Suggestions() []string {
+	return slices.Clone(e.suggestions)
+}
+
+// Partition returns the ID of the partition whose Region pattern matches the Region.
+func (e *UnknownRegionError) Partition() string {
+	return e.partition
+}
+
+func (e *UnknownRegionError) Unwrap() error {
+	return e.err
 }
 
 // SupportedRegion checks if the given region is a valid AWS region.
+// Partitions from the file named in the `TF_AWS_PARTITIONS_FILE` environment variable are included.
+//
+// Returns an *UnknownRegionError, which wraps an *InvalidRegionError, if the Region matches a partition's Region pattern but is not known,
+// and an *InvalidRegionError, with suggestions for similar Regions, otherwise.
 func SupportedRegion(region string) error {
-	if slices.ContainsFunc(endpoints.DefaultPartitions(), func(p endpoints.Partition) bool {
+	ps, err := endpoints.Partitions()
+	if err != nil {
+		return err
+	}
+
+	if slices.ContainsFunc(ps, func(p endpoints.Partition) bool {
 		_, ok := p.Regions()[region]
 		return ok
 	}) {
 		return nil
 	}
 
-	return &InvalidRegionError{
+	if p, ok := endpoints.PartitionForRegion(ps, region); ok {
+		return &UnknownRegionError{
+			region:      region,
+			partition:   p.ID(),
+			suggestions: suggestRegions(region, slices.Sorted(maps.Keys(p.Regions()))),
+			err:         newInvalidRegionError(region, ps),
+		}
+	}
+
+	return newInvalidRegionError(region, ps)
+}
+
+func newInvalidRegionError(region string, ps []endpoints.Partition) *InvalidRegionError {
+	e := &InvalidRegionError{
 		region: region,
 	}
+
+	var regions []string
+	for _, p := range ps {
+		e.partitions = append(e.partitions, p.ID())
+		regions = slices.AppendSeq(regions, maps.Keys(p.Regions()))
+	}
+	slices.Sort(e.partitions)
+	slices.Sort(regions)
+
+	e.suggestions = suggestRegions(region, regions)
+
+	return e
+}
+
+const (
+	maxRegionSuggestionDistance = 3
+	maxRegionSuggestions        = 3
+)
+
+// missingNumberHyphenRegex matches Region IDs missing the hyphen before the number, e.g. "us-east1".
+var missingNumberHyphenRegex = regexp.MustCompile(`([a-z])(\d+)$`)
+
+// suggestRegions returns the known Regions closest to the given Region.
+// Common typos, such as incorrect case, underscores, or a missing hyphen before the number, are corrected first.
+func suggestRegions(region string, regions []string) []string {
+	normalized := strings.ToLower(strings.TrimSpace(region))
+	normalized = strings.NewReplacer("_", "-", " ", "-").Replace(normalized)
+	normalized = missingNumberHyphenRegex.ReplaceAllString(normalized, "$1-$2")
+	if normalized != region && slices.Contains(regions, normalized) {
+		return []string{normalized}
+	}
+
+	var suggestions []string
+	minDistance := maxRegionSuggestionDistance + 1
+	for _, r := range regions {
+		d := levenshtein(normalized, r)
+		switch {
+		case d < minDistance:
+			minDistance = d
+			suggestions = []string{r}
+		case d == minDistance:
+			suggestions = append(suggestions, r)
+		}
+	}
+
+	// Too many equally close Regions are not useful suggestions.
+	if len(suggestions) > maxRegionSuggestions {
+		return nil
+	}
+
+	return suggestions
+}
+
+// levenshtein returns the edit distance between two strings.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
+
+func writeSuggestions(sb *strings.Builder, suggestions []string) {
+	switch len(suggestions) {
+	case 0:
+	case 1:
+		fmt.Fprintf(sb, ", did you mean %q?", suggestions[0])
+	default:
+		quoted := make([]string, len(suggestions))
+		for i, v := range suggestions {
+			quoted[i] = fmt.Sprintf("%q", v)
+		}
+		fmt.Fprintf(sb, ", did you mean one of %s?", strings.Join(quoted, ", "))
+	}
 }
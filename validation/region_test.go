@@ -4,7 +4,15 @@
 package validation
 
 import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 )
 
 func TestSupportedRegion(t *testing.T) {
@@ -46,3 +54,100 @@ func TestSupportedRegion(t *testing.T) {
 		})
 	}
 }
+
+func TestSupportedRegion_partitionsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "partitions.json")
+	data := `{"partitions": [{"partition": "aws-test", "regions": {"xx-test-1": {"description": "Test Region"}}}]}`
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatalf("writing partitions file: %s", err)
+	}
+	t.Setenv(endpoints.PartitionsFileEnvVar, path)
+
+	if err := SupportedRegion("xx-test-1"); err != nil {
+		t.Fatalf("Expected no error, received error: %s", err)
+	}
+	if err := SupportedRegion("us-east-1"); err != nil {
+		t.Fatalf("Expected no error, received error: %s", err)
+	}
+}
+
+func TestSupportedRegion_errors(t *testing.T) {
+	var testCases = []struct {
+		Region              string
+		ExpectedSuggestions []string
+		ExpectUnknownRegion bool
+		ExpectedPartition   string
+	}{
+		{
+			Region:              "us-east1",
+			ExpectedSuggestions: []string{"us-east-1"},
+		},
+		{
+			Region:              "US_WEST_2",
+			ExpectedSuggestions: []string{"us-west-2"},
+		},
+		{
+			Region:              "eu-wset-1",
+			ExpectedSuggestions: []string{"eu-west-1"},
+			ExpectUnknownRegion: true,
+			ExpectedPartition:   "aws",
+		},
+		{
+			Region:              "ap-southeast-99",
+			ExpectUnknownRegion: true,
+			ExpectedPartition:   "aws",
+		},
+		{
+			Region:              "cn-north-9",
+			ExpectedSuggestions: []string{"cn-north-1"},
+			ExpectUnknownRegion: true,
+			ExpectedPartition:   "aws-cn",
+		},
+		{
+			Region: "invalid",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Region, func(t *testing.T) {
+			err := SupportedRegion(testCase.Region)
+			if err == nil {
+				t.Fatal("Expected error, received none")
+			}
+
+			var suggestions []string
+			if testCase.ExpectUnknownRegion {
+				var unknownRegionErr *UnknownRegionError
+				if !errors.As(err, &unknownRegionErr) {
+					t.Fatalf("Expected UnknownRegionError, got %T: %s", err, err)
+				}
+				if got, want := unknownRegionErr.Partition(), testCase.ExpectedPartition; got != want {
+					t.Errorf("Expected partition %q, got %q", want, got)
+				}
+				var invalidRegionErr *InvalidRegionError
+				if !errors.As(err, &invalidRegionErr) {
+					t.Errorf("Expected UnknownRegionError to match InvalidRegionError, got %T: %s", err, err)
+				}
+				suggestions = unknownRegionErr.Suggestions()
+			} else {
+				var invalidRegionErr *InvalidRegionError
+				if !errors.As(err, &invalidRegionErr) {
+					t.Fatalf("Expected InvalidRegionError, got %T: %s", err, err)
+				}
+				if !slices.Contains(invalidRegionErr.Partitions(), "aws") {
+					t.Errorf("Expected checked partitions to include %q, got %v", "aws", invalidRegionErr.Partitions())
+				}
+				suggestions = invalidRegionErr.Suggestions()
+			}
+
+			if diff := cmp.Diff(suggestions, testCase.ExpectedSuggestions); diff != "" {
+				t.Errorf("Unexpected suggestions (+wanted, -got): %s", diff)
+			}
+			for _, s := range testCase.ExpectedSuggestions {
+				if !strings.Contains(err.Error(), s) {
+					t.Errorf("Expected error message to include suggestion %q, got %q", s, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validation
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+)
+
+// ServiceNotAvailableError is returned when an AWS service is not available in an AWS Region.
+type ServiceNotAvailableError struct {
+	service   string
+	region    string
+	partition string
+	regions   []string
+}
+
+func (e *ServiceNotAvailableError) Error() string {
+	if len(e.regions) == 0 {
+		return fmt.Sprintf("AWS service %s is not available in AWS Region %s (partition %s)", e.service, e.region, e.partition)
+	}
+	return fmt.Sprintf("AWS service %s is not available in AWS Region %s (partition %s), available Regions: %s", e.service, e.region, e.partition, strings.Join(e.regions, ", "))
+}
+
+// AvailableRegions returns the Regions in the partition in which the service is available.
+func (e *ServiceNotAvailableError) AvailableRegions() []string {
+	return slices.Clone(e.regions)
+}
+
+// ServiceAvailableInRegion checks if the AWS service with the given endpoints ID, such as "sts", is available in the given region.
+// Partitions from the file named in the `TF_AWS_PARTITIONS_FILE` environment variable are included.
+func ServiceAvailableInRegion(service, region string) error {
+	ps, err := endpoints.Partitions()
+	if err != nil {
+		return err
+	}
+
+	partition, ok := endpoints.PartitionForRegion(ps, region)
+	if !ok {
+		return newInvalidRegionError(region, ps)
+	}
+
+	if partition.IsServiceAvailable(service, region) {
+		return nil
+	}
+
+	notAvailableErr := &ServiceNotAvailableError{
+		service:   service,
+		region:    region,
+		partition: partition.ID(),
+	}
+	if s, ok := partition.Service(service); ok {
+		notAvailableErr.regions = s.Regions()
+	}
+
+	return notAvailableErr
+}
@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2015, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validation
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestServiceAvailableInRegion(t *testing.T) {
+	var testCases = []struct {
+		Service               string
+		Region                string
+		ExpectError           bool
+		ExpectInvalidRegion   bool
+		ExpectAvailableRegion string
+	}{
+		{
+			Service: "sts",
+			Region:  "us-west-2",
+		},
+		{
+			Service: "sts",
+			Region:  "cn-north-1",
+		},
+		{
+			Service: "iam",
+			Region:  "eu-west-1",
+		},
+		{
+			Service:             "sts",
+			Region:              "fips-us-east-1",
+			ExpectError:         true,
+			ExpectInvalidRegion: true,
+		},
+		{
+			Service:     "not-a-service",
+			Region:      "us-east-1",
+			ExpectError: true,
+		},
+		{
+			Service:               "datapipeline",
+			Region:                "us-west-1",
+			ExpectError:           true,
+			ExpectAvailableRegion: "us-west-2",
+		},
+		{
+			Service:             "sts",
+			Region:              "invalid",
+			ExpectError:         true,
+			ExpectInvalidRegion: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Service+"/"+testCase.Region, func(t *testing.T) {
+			err := ServiceAvailableInRegion(testCase.Service, testCase.Region)
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("Expected no error, received error: %s", err)
+			}
+			if err == nil && testCase.ExpectError {
+				t.Fatal("Expected error, received none")
+			}
+
+			var invalidRegionErr *InvalidRegionError
+			if got, want := errors.As(err, &invalidRegionErr), testCase.ExpectInvalidRegion; got != want {
+				t.Errorf("Expected InvalidRegionError %t, got %t", want, got)
+			}
+
+			if testCase.ExpectAvailableRegion != "" {
+				var notAvailableErr *ServiceNotAvailableError
+				if !errors.As(err, &notAvailableErr) {
+					t.Fatalf("Expected ServiceNotAvailableError, got %T", err)
+				}
+				if !slices.Contains(notAvailableErr.AvailableRegions(), testCase.ExpectAvailableRegion) {
+					t.Errorf("Expected available Regions to include %q, got %v", testCase.ExpectAvailableRegion, notAvailableErr.AvailableRegions())
+				}
+			}
+		})
+	}
+}